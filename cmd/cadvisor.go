@@ -23,13 +23,19 @@ import (
 	"os/signal"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"syscall"
+	"time"
 
+	"github.com/google/cadvisor/cmd/internal/api"
 	"github.com/google/cadvisor/cmd/internal/appmetrics"
+	"github.com/google/cadvisor/cmd/internal/config"
 	cadvisorhttp "github.com/google/cadvisor/cmd/internal/http"
 	"github.com/google/cadvisor/lib/container"
+	"github.com/google/cadvisor/lib/container/common"
 	"github.com/google/cadvisor/lib/manager"
 	"github.com/google/cadvisor/lib/metrics"
+	info "github.com/google/cadvisor/lib/model"
 	"github.com/google/cadvisor/lib/utils/sysfs"
 	"github.com/google/cadvisor/lib/version"
 
@@ -78,6 +84,10 @@ var perfEvents = flag.String("perf_events_config", "", "Path to a JSON file cont
 
 var resctrlInterval = flag.Duration("resctrl_interval", 0, "Resctrl mon groups updating interval. Zero value disables updating mon groups.")
 
+var configFile = flag.String("config_file", "", "Path to a JSON file overriding the reloadable flags (store_container_labels, whitelisted_container_labels, env_metadata_whitelist, raw_cgroup_prefix_whitelist, enable_metrics, disable_metrics and container_hints). It is re-read on SIGHUP and when it changes.")
+
+var configWatchInterval = flag.Duration("config_watch_interval", 10*time.Second, "How often to check config_file for changes. Zero disables watching; SIGHUP still reloads it.")
+
 var (
 	// Metrics to be ignored.
 	// Tcp metrics are ignored by default.
@@ -93,6 +103,7 @@ var (
 		container.CPUTopologyMetrics:             struct{}{},
 		container.ResctrlMetrics:                 struct{}{},
 		container.CPUSetMetrics:                  struct{}{},
+		container.ImageMetrics:                   struct{}{},
 	}
 
 	// Metrics to be enabled.  Used only if non-empty.
@@ -123,6 +134,27 @@ func main() {
 	} else {
 		includedMetrics = container.AllMetrics.Difference(ignoreMetrics)
 	}
+	whitelistedLabels := strings.Split(*whitelistedContainerLabels, ",")
+	// Trim spacing in labels
+	for i := range whitelistedLabels {
+		whitelistedLabels[i] = strings.TrimSpace(whitelistedLabels[i])
+	}
+	configLoader, err := config.NewLoader(config.Config{
+		StoreContainerLabels:       *storeContainerLabels,
+		WhitelistedContainerLabels: whitelistedLabels,
+		EnvMetadataWhiteList:       strings.Split(*envMetadataWhiteList, ","),
+		RawCgroupPrefixWhiteList:   strings.Split(*rawCgroupPrefixWhiteList, ","),
+		IncludedMetrics:            includedMetrics,
+		ContainerHints:             *common.ArgContainerHints,
+	}, *configFile)
+	if err != nil {
+		klog.Fatalf("Failed to load config file: %v", err)
+	}
+	cfg := configLoader.Current()
+	// Metrics can only be collected if they were enabled at startup, so a
+	// reload can only narrow the set chosen here.
+	includedMetrics = cfg.IncludedMetrics
+	common.SetContainerHintsFile(cfg.ContainerHints)
 	klog.V(1).Infof("enabled metrics: %s", includedMetrics.String())
 	setMaxProcs()
 
@@ -138,7 +170,7 @@ func main() {
 	// containers, which build collectors via the injected factory.
 	appmetrics.SetHTTPClient(*collectorCert, *collectorKey)
 
-	resourceManager, err := manager.New(memoryStorage, sysFs, manager.HousekeepingConfigFlags, includedMetrics, strings.Split(*rawCgroupPrefixWhiteList, ","), strings.Split(*envMetadataWhiteList, ","), *perfEvents, *resctrlInterval)
+	resourceManager, err := manager.New(memoryStorage, sysFs, manager.HousekeepingConfigFlags, includedMetrics, cfg.RawCgroupPrefixWhiteList, cfg.EnvMetadataWhiteList, *perfEvents, *resctrlInterval)
 	if err != nil {
 		klog.Fatalf("Failed to create a manager: %s", err)
 	}
@@ -158,26 +190,43 @@ func main() {
 		klog.Fatalf("Failed to register HTTP handlers: %v", err)
 	}
 
-	containerLabelFunc := metrics.DefaultContainerLabels
-	if !*storeContainerLabels {
-		whitelistedLabels := strings.Split(*whitelistedContainerLabels, ",")
-		// Trim spacing in labels
-		for i := range whitelistedLabels {
-			whitelistedLabels[i] = strings.TrimSpace(whitelistedLabels[i])
-		}
-		containerLabelFunc = metrics.BaseContainerLabels(whitelistedLabels)
+	var labelFunc atomic.Pointer[metrics.ContainerLabelsFunc]
+	labelFunc.Store(containerLabelsFunc(cfg))
+	containerLabelFunc := func(c *info.ContainerInfo) map[string]string {
+		return (*labelFunc.Load())(c)
 	}
+	var exportedMetrics atomic.Pointer[container.MetricSet]
+	exportedMetrics.Store(&includedMetrics)
+
+	configLoader.Subscribe(func(cfg *config.Config) {
+		resourceManager.SetAllowLists(cfg.RawCgroupPrefixWhiteList, cfg.EnvMetadataWhiteList)
+		common.SetContainerHintsFile(cfg.ContainerHints)
+		labelFunc.Store(containerLabelsFunc(cfg))
+		if extra := cfg.IncludedMetrics.Difference(includedMetrics); len(extra) > 0 {
+			klog.Warningf("Metrics %s were not enabled at startup and need a restart to be collected", extra.String())
+		}
+		metricSet := includedMetrics.Difference(includedMetrics.Difference(cfg.IncludedMetrics))
+		exportedMetrics.Store(&metricSet)
+		klog.Infof("Configuration reloaded, exported metrics: %s", metricSet.String())
+		reloadEventsStoragePolicy()
+	})
 
 	// Register Prometheus collector to gather information about containers, Go runtime, processes, and machine
-	cadvisorhttp.RegisterPrometheusHandler(mux, resourceManager, *prometheusEndpoint, containerLabelFunc, includedMetrics)
+	cadvisorhttp.RegisterPrometheusHandler(mux, resourceManager, *prometheusEndpoint, containerLabelFunc, func() container.MetricSet {
+		return *exportedMetrics.Load()
+	})
 
 	// Start the manager.
 	if err := resourceManager.Start(); err != nil {
 		klog.Fatalf("Failed to start manager: %v", err)
 	}
 
-	// Install signal handler.
-	installSignalHandler(resourceManager)
+	// Install signal handler, which closes stop on termination.
+	stop := make(chan struct{})
+	installSignalHandler(resourceManager, configLoader, stop)
+	if *configWatchInterval > 0 {
+		go configLoader.Watch(*configWatchInterval, stop)
+	}
 
 	klog.V(1).Infof("Starting cAdvisor version: %s-%s on port %d", version.Info["version"], version.Info["revision"], *argPort)
 
@@ -206,17 +255,49 @@ func setMaxProcs() {
 	}
 }
 
-func installSignalHandler(containerManager manager.Manager) {
+// containerLabelsFunc returns the function turning containers into Prometheus
+// labels under cfg.
+func containerLabelsFunc(cfg *config.Config) *metrics.ContainerLabelsFunc {
+	var f metrics.ContainerLabelsFunc = metrics.DefaultContainerLabels
+	if !cfg.StoreContainerLabels {
+		f = metrics.BaseContainerLabels(cfg.WhitelistedContainerLabels)
+	}
+	return &f
+}
+
+// reloadEventsStoragePolicy re-reads the events storage policy, which
+// --event_storage_config keeps apart from the config file, along with every
+// reload of the configuration.
+func reloadEventsStoragePolicy() {
+	if err := api.ReloadEventsStoragePolicy(); err != nil {
+		klog.Errorf("Failed to reload event storage policy: %v", err)
+	}
+}
+
+func installSignalHandler(containerManager manager.Manager, configLoader *config.Loader, stop chan<- struct{}) {
 	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
 
-	// Block until a signal is received.
+	// Block until a termination signal is received; SIGHUP reloads
+	// configuration.
 	go func() {
-		sig := <-c
-		if err := containerManager.Stop(); err != nil {
-			klog.Errorf("Failed to stop container manager: %v", err)
+		for sig := range c {
+			if sig == syscall.SIGHUP {
+				klog.Infof("Reloading configuration given signal: %v", sig)
+				if err := configLoader.Reload(); err != nil {
+					klog.Errorf("Failed to reload config file: %v", err)
+					// Subscribers, which reload the events storage policy,
+					// are only notified of successful reloads.
+					reloadEventsStoragePolicy()
+				}
+				continue
+			}
+			close(stop)
+			if err := containerManager.Stop(); err != nil {
+				klog.Errorf("Failed to stop container manager: %v", err)
+			}
+			klog.Infof("Exiting given signal: %v", sig)
+			os.Exit(0)
 		}
-		klog.Infof("Exiting given signal: %v", sig)
-		os.Exit(0)
 	}()
 }
@@ -113,6 +113,7 @@ func TestToIncludedMetrics(t *testing.T) {
 			container.CPUSetMetrics:                  struct{}{},
 			container.OOMMetrics:                     struct{}{},
 			container.PressureMetrics:                struct{}{},
+			container.ImageMetrics:                   struct{}{},
 		},
 		container.AllMetrics,
 		{},
@@ -18,6 +18,7 @@ package api
 import (
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"net/http"
@@ -48,10 +49,27 @@ const (
 // enough — no need to thread an *events.EventManager through every handler.
 var eventManager events.EventManager
 
+var (
+	restartLoopThreshold = flag.Int("restart_loop_threshold", 5, "Number of restarts of the same container within --restart_loop_window that emits a restartLoop event. Zero disables restart loop events.")
+	restartLoopWindow    = flag.Duration("restart_loop_window", 10*time.Minute, "Window over which container restarts are counted towards --restart_loop_threshold.")
+	eventStorageConfig   = flag.String("event_storage_config", "", "Path to a JSON file with the event storage policy, applied on top of --event_storage_age_limit and --event_storage_event_limit. It can also set per-container-prefix retention and exclude event types, and is re-read on SIGHUP.")
+	eventsFormat         = flag.String("events_format", eventsFormatRaw, "Default encoding of events served by the events API: \"raw\" or \"cloudevents\" (CloudEvents 1.0 structured JSON). Can be overridden per request with the format query parameter.")
+)
+
+// Event encodings served by the events API.
+const (
+	eventsFormatRaw         = "raw"
+	eventsFormatCloudEvents = "cloudevents"
+)
+
 func RegisterHandlers(mux httpmux.Mux, m manager.Manager) error {
-	eventManager = events.NewEventManager(parseEventsStoragePolicy())
-	m.SetEventSink(eventManager)
-	startOOMWatcher(eventManager)
+	policy, err := loadEventsStoragePolicy()
+	if err != nil {
+		return err
+	}
+	eventManager = events.NewEventManager(policy)
+	m.SetEventSink(startOOMWatcher(eventManager))
+	m.SetRestartLoopPolicy(*restartLoopThreshold, *restartLoopWindow)
 
 	apiVersions := getAPIVersions()
 	supportedAPIVersions := make(map[string]ApiVersion, len(apiVersions))
@@ -68,6 +86,32 @@ func RegisterHandlers(mux httpmux.Mux, m manager.Manager) error {
 	return nil
 }
 
+// loadEventsStoragePolicy builds the events storage policy from the
+// event_storage_* flags and, if set, the --event_storage_config file.
+func loadEventsStoragePolicy() (events.StoragePolicy, error) {
+	policy := parseEventsStoragePolicy()
+	if *eventStorageConfig == "" {
+		return policy, nil
+	}
+	return events.ReadStorageConfig(*eventStorageConfig, policy)
+}
+
+// ReloadEventsStoragePolicy re-reads the events storage policy and applies it
+// to the running event manager. Stored events are kept unless the new policy
+// excludes them or they exceed its limits. On error the current policy stays
+// in effect.
+func ReloadEventsStoragePolicy() error {
+	if eventManager == nil {
+		return errors.New("events API is not registered")
+	}
+	policy, err := loadEventsStoragePolicy()
+	if err != nil {
+		return err
+	}
+	eventManager.SetStoragePolicy(policy)
+	return nil
+}
+
 // parseEventsStoragePolicy builds the events storage policy from the
 // event_storage_* flag values, which the library manager registers (for kubelet
 // flag-compatibility) and exposes. Defining it here, reading the exposed
@@ -196,11 +240,60 @@ func writeResult(res interface{}, w http.ResponseWriter) error {
 
 }
 
-func streamResults(eventChannel *events.EventChannel, w http.ResponseWriter, r *http.Request, m manager.Manager) error {
+// getEventsFormat returns the event encoding requested with the format query
+// parameter, or the --events_format default.
+func getEventsFormat(r *http.Request) (string, error) {
+	format := *eventsFormat
+	if val := r.URL.Query().Get("format"); val != "" {
+		format = val
+	}
+	switch format {
+	case eventsFormatRaw, eventsFormatCloudEvents:
+		return format, nil
+	}
+	return "", fmt.Errorf("unknown events format %q, must be %q or %q", format, eventsFormatRaw, eventsFormatCloudEvents)
+}
+
+// cloudEventSource returns the CloudEvents source identifying this machine.
+func cloudEventSource(m manager.Manager) (string, error) {
+	machineInfo, err := m.GetMachineInfo()
+	if err != nil {
+		return "", err
+	}
+	return events.CloudEventSource(machineInfo), nil
+}
+
+// writeEvents writes past events in the requested format.
+func writeEvents(pastEvents []*info.Event, format string, w http.ResponseWriter, m manager.Manager) error {
+	if format != eventsFormatCloudEvents {
+		return writeResult(pastEvents, w)
+	}
+	source, err := cloudEventSource(m)
+	if err != nil {
+		return err
+	}
+	out, err := json.Marshal(events.ToCloudEvents(pastEvents, source))
+	if err != nil {
+		return fmt.Errorf("failed to marshall events with error: %s", err)
+	}
+	w.Header().Set("Content-Type", events.CloudEventBatchContentType)
+	_, err = w.Write(out)
+	return err
+}
+
+func streamResults(eventChannel *events.EventChannel, format string, w http.ResponseWriter, r *http.Request, m manager.Manager) error {
 	flusher, ok := w.(http.Flusher)
 	if !ok {
 		return errors.New("could not access http.Flusher")
 	}
+	var source string
+	if format == eventsFormatCloudEvents {
+		var err error
+		if source, err = cloudEventSource(m); err != nil {
+			return err
+		}
+		w.Header().Set("Content-Type", events.CloudEventContentType)
+	}
 
 	w.Header().Set("Transfer-Encoding", "chunked")
 	w.WriteHeader(http.StatusOK)
@@ -213,7 +306,12 @@ func streamResults(eventChannel *events.EventChannel, w http.ResponseWriter, r *
 			eventManager.StopWatch(eventChannel.GetWatchId())
 			return nil
 		case ev := <-eventChannel.GetChannel():
-			err := enc.Encode(ev)
+			var err error
+			if format == eventsFormatCloudEvents {
+				err = enc.Encode(events.ToCloudEvent(ev, source))
+			} else {
+				err = enc.Encode(ev)
+			}
 			if err != nil {
 				klog.Errorf("error encoding message %+v for result stream: %v", ev, err)
 			}
@@ -237,8 +335,9 @@ func getContainerInfoRequest(body io.ReadCloser) (*info.ContainerInfoRequest, er
 // with any twice defined arguments being assigned the first value.
 // If the value type for the argument is wrong the field will be assumed to be
 // unassigned
-// bools: stream, subcontainers, oom_events, creation_events, deletion_events
+// bools: stream, subcontainers, oom_events, creation_events, deletion_events, restart_loop_events
 // ints: max_events, start_time (unix timestamp), end_time (unix timestamp)
+// The format argument (see getEventsFormat) is read separately.
 // example r.URL: http://localhost:8080/api/v1.3/events?oom_events=true&stream=true
 func getEventRequest(r *http.Request) (*events.Request, bool, error) {
 	query := events.NewRequest()
@@ -259,10 +358,11 @@ func getEventRequest(r *http.Request) (*events.Request, bool, error) {
 		}
 	}
 	eventTypes := map[string]info.EventType{
-		"oom_events":      info.EventOom,
-		"oom_kill_events": info.EventOomKill,
-		"creation_events": info.EventContainerCreation,
-		"deletion_events": info.EventContainerDeletion,
+		"oom_events":          info.EventOom,
+		"oom_kill_events":     info.EventOomKill,
+		"creation_events":     info.EventContainerCreation,
+		"deletion_events":     info.EventContainerDeletion,
+		"restart_loop_events": info.EventRestartLoop,
 	}
 	allEventTypes := false
 	if val, ok := urlMap["all_events"]; ok {
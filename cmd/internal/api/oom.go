@@ -15,6 +15,8 @@
 package api
 
 import (
+	"flag"
+	"fmt"
 	"sync"
 
 	"github.com/google/cadvisor/events"
@@ -35,18 +37,45 @@ var oomCounts = struct {
 	byContainer map[string]uint64
 }{byContainer: map[string]uint64{}}
 
-// startOOMWatcher reads OOM kills from the kernel log via oomparser and (a)
-// emits OOM and OOM-kill events to the event manager and (b) maintains the
-// per-container OOM counter. It is best-effort: if the kernel log cannot be
-// read, OOM events and the metric are disabled with a warning.
-func startOOMWatcher(em events.EventManager) {
-	oomLog, err := oomparser.New()
+var oomEventSource = flag.String("oom_event_source", "auto", "Where to detect OOM kills: \"kmsg\" reads the kernel log, \"memory_events\" watches each container's cgroup v2 memory.events file, and \"auto\" uses the kernel log when it can be read and memory.events otherwise.")
+
+// oomSource is a stream of OOM kills: the kernel log (oomparser.OomParser) or
+// cgroup v2 memory.events files (oomparser.MemoryEventsWatcher).
+type oomSource interface {
+	StreamOoms(outStream chan<- *oomparser.OomInstance)
+}
+
+// startOOMWatcher reads OOM kills from the source selected by
+// --oom_event_source and (a) emits OOM and OOM-kill events to the event
+// manager and (b) maintains the per-container OOM counter. It returns the sink
+// the manager should deliver its events to: the event manager itself or, for
+// memory.events, a wrapper that also keeps the set of watched containers in
+// step with the manager's. It is best-effort: if no source can be configured,
+// OOM events and the metric are disabled with a warning.
+func startOOMWatcher(em events.EventManager) manager.EventSink {
+	var source oomSource
+	var sink manager.EventSink = em
+	var err error
+	switch *oomEventSource {
+	case "kmsg":
+		source, err = oomparser.New()
+	case "memory_events":
+		source, sink, err = newMemoryEventsSource(em)
+	case "auto":
+		source, err = oomparser.New()
+		if err != nil {
+			klog.V(2).Infof("Cannot read the kernel log for OOM detection (%v), falling back to memory.events", err)
+			source, sink, err = newMemoryEventsSource(em)
+		}
+	default:
+		err = fmt.Errorf("unknown --oom_event_source %q", *oomEventSource)
+	}
 	if err != nil {
 		klog.Warningf("Could not configure a source for OOM detection, disabling OOM events: %v", err)
-		return
+		return em
 	}
 	outStream := make(chan *oomparser.OomInstance, 10)
-	go oomLog.StreamOoms(outStream)
+	go source.StreamOoms(outStream)
 
 	go func() {
 		for oomInstance := range outStream {
@@ -64,15 +93,49 @@ func startOOMWatcher(em events.EventManager) {
 						Pid:         oomInstance.Pid,
 						ProcessName: oomInstance.ProcessName,
 						Constraint:  oomInstance.Constraint,
+						Count:       oomInstance.Count,
 					},
 				},
 			})
 
 			oomCounts.Lock()
-			oomCounts.byContainer[oomInstance.ContainerName]++
+			oomCounts.byContainer[oomInstance.ContainerName] += uint64(oomInstance.Kills())
 			oomCounts.Unlock()
 		}
 	}()
+	return sink
+}
+
+// newMemoryEventsSource builds a memory.events OOM source along with the event
+// sink that tells it which containers to watch.
+func newMemoryEventsSource(em events.EventManager) (oomSource, manager.EventSink, error) {
+	w, err := oomparser.NewMemoryEventsWatcher(cgroupRoot)
+	if err != nil {
+		return nil, em, err
+	}
+	return w, &memoryEventsSink{EventSink: em, watcher: w}, nil
+}
+
+// cgroupRoot is where the cgroup v2 hierarchy is mounted.
+const cgroupRoot = "/sys/fs/cgroup"
+
+// memoryEventsSink forwards the manager's events and watches memory.events of
+// every container the manager creates, until it is deleted.
+type memoryEventsSink struct {
+	manager.EventSink
+	watcher *oomparser.MemoryEventsWatcher
+}
+
+func (s *memoryEventsSink) AddEvent(e *info.Event) error {
+	switch e.EventType {
+	case info.EventContainerCreation:
+		if err := s.watcher.AddContainer(e.ContainerName); err != nil {
+			klog.V(4).Infof("Not watching %q for OOM kills: %v", e.ContainerName, err)
+		}
+	case info.EventContainerDeletion:
+		s.watcher.RemoveContainer(e.ContainerName)
+	}
+	return s.EventSink.AddEvent(e)
 }
 
 // WrapManagerForOOM wraps a manager so the prometheus collector reports the
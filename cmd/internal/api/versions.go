@@ -43,6 +43,8 @@ const (
 	versionAPI       = "version"
 	psAPI            = "ps"
 	customMetricsAPI = "appmetrics"
+	podsAPI          = "pods"
+	imagesAPI        = "images"
 )
 
 // Interface for a cAdvisor API version
@@ -279,6 +281,10 @@ func handleEventRequest(request []string, m manager.Manager, w http.ResponseWrit
 	if err != nil {
 		return err
 	}
+	format, err := getEventsFormat(r)
+	if err != nil {
+		return err
+	}
 	query.ContainerName = path.Join("/", getContainerName(request))
 	klog.V(4).Infof("Api - Events(%v)", query)
 	if !stream {
@@ -286,13 +292,13 @@ func handleEventRequest(request []string, m manager.Manager, w http.ResponseWrit
 		if err != nil {
 			return err
 		}
-		return writeResult(pastEvents, w)
+		return writeEvents(pastEvents, format, w, m)
 	}
 	eventChannel, err := eventManager.WatchEvents(query)
 	if err != nil {
 		return err
 	}
-	return streamResults(eventChannel, w, r, m)
+	return streamResults(eventChannel, format, w, r, m)
 
 }
 
@@ -485,7 +491,7 @@ func (api *version2_1) Version() string {
 }
 
 func (api *version2_1) SupportedRequestTypes() []string {
-	return append([]string{machineStatsAPI}, api.baseVersion.SupportedRequestTypes()...)
+	return append([]string{machineStatsAPI, podsAPI, imagesAPI}, api.baseVersion.SupportedRequestTypes()...)
 }
 
 func (api *version2_1) HandleRequest(requestType string, request []string, m manager.Manager, w http.ResponseWriter, r *http.Request) error {
@@ -528,6 +534,28 @@ func (api *version2_1) HandleRequest(requestType string, request []string, m man
 			}
 		}
 		return writeResult(contStats, w)
+	case podsAPI:
+		klog.V(4).Infof("Api - Pods(%v)", request)
+		query := &info.ContainerInfoRequest{NumStats: opt.Count}
+		if len(request) > 0 && request[0] != "" {
+			pod, err := m.Pod(request[0], query)
+			if err != nil {
+				return err
+			}
+			return writeResult(pod, w)
+		}
+		pods, err := m.AllPods(query)
+		if err != nil {
+			return err
+		}
+		return writeResult(pods, w)
+	case imagesAPI:
+		klog.V(4).Infof("Api - Images(%v)", request)
+		stores, err := m.ImageStores()
+		if err != nil {
+			return err
+		}
+		return writeResult(stores, w)
 	default:
 		return api.baseVersion.HandleRequest(requestType, request, m, w, r)
 	}
@@ -79,3 +79,16 @@ func TestGetEventRequestDoubleArgument(t *testing.T) {
 	assert.True(t, stream)
 	assert.Nil(t, err)
 }
+
+func TestGetEventsFormat(t *testing.T) {
+	format, err := getEventsFormat(makeHTTPRequest("http://localhost:8080/api/v1.3/events", t))
+	assert.NoError(t, err)
+	assert.Equal(t, eventsFormatRaw, format)
+
+	format, err = getEventsFormat(makeHTTPRequest("http://localhost:8080/api/v1.3/events?format=cloudevents", t))
+	assert.NoError(t, err)
+	assert.Equal(t, eventsFormatCloudEvents, format)
+
+	_, err = getEventsFormat(makeHTTPRequest("http://localhost:8080/api/v1.3/events?format=xml", t))
+	assert.Error(t, err)
+}
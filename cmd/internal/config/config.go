@@ -0,0 +1,236 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config holds the part of the full cAdvisor binary's configuration
+// that can change while it runs. The values start out as the command-line
+// flags, are overlaid with an optional JSON config file, and are re-read from
+// that file on SIGHUP or when the file changes; subscribers are then told so
+// they can re-apply the new values to the running manager and collectors.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/cadvisor/lib/container"
+
+	"k8s.io/klog/v2"
+)
+
+// Config is the reloadable configuration.
+type Config struct {
+	// Whether to convert all container labels and envs into Prometheus labels.
+	StoreContainerLabels bool
+	// Container labels converted into Prometheus labels when
+	// StoreContainerLabels is false.
+	WhitelistedContainerLabels []string
+	// Prefixes of the container envs collected as metadata.
+	EnvMetadataWhiteList []string
+	// Cgroup path prefixes collected even with --docker_only.
+	RawCgroupPrefixWhiteList []string
+	// Metrics to expose.
+	IncludedMetrics container.MetricSet
+	// Location of the container hints file.
+	ContainerHints string
+}
+
+// File is the on-disk form of a Config, e.g.:
+//
+//	{
+//	  "store_container_labels": false,
+//	  "whitelisted_container_labels": ["io.kubernetes.pod.name"],
+//	  "env_metadata_whitelist": ["APP_"],
+//	  "raw_cgroup_prefix_whitelist": ["/system.slice/"],
+//	  "disable_metrics": ["tcp", "udp"],
+//	  "container_hints": "/etc/cadvisor/container_hints.json"
+//	}
+//
+// Fields left out keep their command-line values. enable_metrics, if set,
+// overrides disable_metrics, as the flags of the same names do.
+type File struct {
+	StoreContainerLabels       *bool    `json:"store_container_labels,omitempty"`
+	WhitelistedContainerLabels []string `json:"whitelisted_container_labels,omitempty"`
+	EnvMetadataWhiteList       []string `json:"env_metadata_whitelist,omitempty"`
+	RawCgroupPrefixWhiteList   []string `json:"raw_cgroup_prefix_whitelist,omitempty"`
+	EnableMetrics              []string `json:"enable_metrics,omitempty"`
+	DisableMetrics             []string `json:"disable_metrics,omitempty"`
+	ContainerHints             *string  `json:"container_hints,omitempty"`
+}
+
+// Parse parses a JSON config file and returns base with it applied.
+func Parse(data []byte, base Config) (Config, error) {
+	var file File
+	if err := json.Unmarshal(data, &file); err != nil {
+		return base, fmt.Errorf("unable to parse config file: %v", err)
+	}
+
+	config := base
+	if file.StoreContainerLabels != nil {
+		config.StoreContainerLabels = *file.StoreContainerLabels
+	}
+	if file.WhitelistedContainerLabels != nil {
+		config.WhitelistedContainerLabels = trimAll(file.WhitelistedContainerLabels)
+	}
+	if file.EnvMetadataWhiteList != nil {
+		config.EnvMetadataWhiteList = file.EnvMetadataWhiteList
+	}
+	if file.RawCgroupPrefixWhiteList != nil {
+		config.RawCgroupPrefixWhiteList = file.RawCgroupPrefixWhiteList
+	}
+	if file.ContainerHints != nil {
+		config.ContainerHints = *file.ContainerHints
+	}
+	switch {
+	case len(file.EnableMetrics) > 0:
+		var enabled container.MetricSet
+		if err := enabled.Set(strings.Join(file.EnableMetrics, ",")); err != nil {
+			return base, fmt.Errorf("enable_metrics: %v", err)
+		}
+		config.IncludedMetrics = enabled
+	case file.DisableMetrics != nil:
+		var disabled container.MetricSet
+		if err := disabled.Set(strings.Join(file.DisableMetrics, ",")); err != nil {
+			return base, fmt.Errorf("disable_metrics: %v", err)
+		}
+		config.IncludedMetrics = container.AllMetrics.Difference(disabled)
+	}
+	return config, nil
+}
+
+func trimAll(values []string) []string {
+	trimmed := make([]string, len(values))
+	for i, v := range values {
+		trimmed[i] = strings.TrimSpace(v)
+	}
+	return trimmed
+}
+
+// Loader holds the current Config. It is safe for concurrent use.
+type Loader struct {
+	base Config
+	file string
+
+	current atomic.Pointer[Config]
+
+	// Serializes reloads and guards the fields below.
+	mu          sync.Mutex
+	fileModTime time.Time
+	subscribers []func(*Config)
+}
+
+// NewLoader returns a Loader whose configuration is base (the flag values)
+// overlaid with the config file, if file is not empty.
+func NewLoader(base Config, file string) (*Loader, error) {
+	l := &Loader{base: base, file: file}
+	config, modTime, err := l.load()
+	if err != nil {
+		return nil, err
+	}
+	l.fileModTime = modTime
+	l.current.Store(&config)
+	return l, nil
+}
+
+// Current returns the configuration in effect. It must not be modified.
+func (l *Loader) Current() *Config {
+	return l.current.Load()
+}
+
+// Subscribe registers f to be called with the new configuration after every
+// reload.
+func (l *Loader) Subscribe(f func(*Config)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.subscribers = append(l.subscribers, f)
+}
+
+// Reload re-reads the config file and, if it is valid, makes it the
+// configuration in effect and notifies subscribers. On error the current
+// configuration is kept.
+func (l *Loader) Reload() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	config, modTime, err := l.load()
+	if err != nil {
+		return err
+	}
+	l.fileModTime = modTime
+	l.current.Store(&config)
+	for _, f := range l.subscribers {
+		f(&config)
+	}
+	return nil
+}
+
+// Watch reloads the configuration whenever the config file's modification
+// time changes, checking every interval until stop is closed. It blocks and
+// should be called from a goroutine.
+func (l *Loader) Watch(interval time.Duration, stop <-chan struct{}) {
+	if l.file == "" {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			fi, err := os.Stat(l.file)
+			if err != nil {
+				klog.V(4).Infof("Cannot check config file %q: %v", l.file, err)
+				continue
+			}
+			l.mu.Lock()
+			changed := !fi.ModTime().Equal(l.fileModTime)
+			// Remember the new time even if the reload fails, so a broken
+			// file is reported once rather than on every check.
+			l.fileModTime = fi.ModTime()
+			l.mu.Unlock()
+			if !changed {
+				continue
+			}
+			klog.Infof("Config file %q changed, reloading", l.file)
+			if err := l.Reload(); err != nil {
+				klog.Errorf("Failed to reload config file %q: %v", l.file, err)
+			}
+		}
+	}
+}
+
+// load returns the base configuration overlaid with the config file, and the
+// file's modification time. Must be called with mu held, or before l is shared.
+func (l *Loader) load() (Config, time.Time, error) {
+	if l.file == "" {
+		return l.base, time.Time{}, nil
+	}
+	fi, err := os.Stat(l.file)
+	if err != nil {
+		return l.base, time.Time{}, err
+	}
+	data, err := os.ReadFile(l.file)
+	if err != nil {
+		return l.base, time.Time{}, err
+	}
+	config, err := Parse(data, l.base)
+	if err != nil {
+		return l.base, time.Time{}, fmt.Errorf("%s: %v", l.file, err)
+	}
+	return config, fi.ModTime(), nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/lib/container"
+)
+
+func testBase() Config {
+	return Config{
+		StoreContainerLabels:     true,
+		EnvMetadataWhiteList:     []string{""},
+		RawCgroupPrefixWhiteList: []string{""},
+		IncludedMetrics:          container.AllMetrics,
+		ContainerHints:           "/etc/cadvisor/container_hints.json",
+	}
+}
+
+func TestParse(t *testing.T) {
+	config, err := Parse([]byte(`{
+		"store_container_labels": false,
+		"whitelisted_container_labels": [" io.kubernetes.pod.name "],
+		"raw_cgroup_prefix_whitelist": ["/system.slice/"],
+		"disable_metrics": ["tcp", "udp"]
+	}`), testBase())
+	require.NoError(t, err)
+	assert.False(t, config.StoreContainerLabels)
+	assert.Equal(t, []string{"io.kubernetes.pod.name"}, config.WhitelistedContainerLabels)
+	assert.Equal(t, []string{"/system.slice/"}, config.RawCgroupPrefixWhiteList)
+	assert.Equal(t, []string{""}, config.EnvMetadataWhiteList)
+	assert.Equal(t, "/etc/cadvisor/container_hints.json", config.ContainerHints)
+	assert.False(t, config.IncludedMetrics.Has(container.NetworkTcpUsageMetrics))
+	assert.False(t, config.IncludedMetrics.Has(container.NetworkUdpUsageMetrics))
+	assert.True(t, config.IncludedMetrics.Has(container.CpuUsageMetrics))
+
+	config, err = Parse([]byte(`{"enable_metrics": ["cpu"], "disable_metrics": ["memory"]}`), testBase())
+	require.NoError(t, err)
+	assert.Equal(t, container.MetricSet{container.CpuUsageMetrics: struct{}{}}, config.IncludedMetrics)
+
+	_, err = Parse([]byte(`{"disable_metrics": ["bogus"]}`), testBase())
+	assert.Error(t, err)
+	_, err = Parse([]byte(`{`), testBase())
+	assert.Error(t, err)
+}
+
+func TestLoaderReload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"env_metadata_whitelist": ["APP_"]}`), 0o644))
+
+	l, err := NewLoader(testBase(), file)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"APP_"}, l.Current().EnvMetadataWhiteList)
+
+	var notified *Config
+	l.Subscribe(func(c *Config) { notified = c })
+
+	require.NoError(t, os.WriteFile(file, []byte(`{"container_hints": "/tmp/hints.json"}`), 0o644))
+	require.NoError(t, l.Reload())
+	require.NotNil(t, notified)
+	assert.Equal(t, "/tmp/hints.json", notified.ContainerHints)
+	// Fields no longer in the file go back to their flag values.
+	assert.Equal(t, []string{""}, notified.EnvMetadataWhiteList)
+	assert.Equal(t, notified, l.Current())
+
+	// An invalid file keeps the current configuration.
+	notified = nil
+	require.NoError(t, os.WriteFile(file, []byte(`{`), 0o644))
+	assert.Error(t, l.Reload())
+	assert.Nil(t, notified)
+	assert.Equal(t, "/tmp/hints.json", l.Current().ContainerHints)
+}
+
+func TestLoaderWithoutFile(t *testing.T) {
+	l, err := NewLoader(testBase(), "")
+	require.NoError(t, err)
+	assert.Equal(t, testBase(), *l.Current())
+	assert.NoError(t, l.Reload())
+
+	_, err = NewLoader(testBase(), filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
@@ -20,7 +20,9 @@ import (
 	_ "github.com/google/cadvisor/container/docker/install"
 	_ "github.com/google/cadvisor/container/podman/install"
 	_ "github.com/google/cadvisor/lib/container/containerd/install"
+	_ "github.com/google/cadvisor/lib/container/cri/install"
 	_ "github.com/google/cadvisor/lib/container/crio/install"
+	_ "github.com/google/cadvisor/lib/container/lxc/install"
 	_ "github.com/google/cadvisor/lib/container/systemd/install"
 
 	// Register all filesystem plugins.
@@ -95,12 +95,15 @@ func RegisterHandlers(mux httpmux.Mux, containerManager manager.Manager, httpAut
 }
 
 // RegisterPrometheusHandler creates a new PrometheusCollector and configures
-// the provided HTTP mux to handle the given Prometheus endpoint.
+// the provided HTTP mux to handle the given Prometheus endpoint. The metrics
+// exposed are those includedMetrics returns at the time of each request.
 func RegisterPrometheusHandler(mux httpmux.Mux, resourceManager manager.Manager, prometheusEndpoint string,
-	f metrics.ContainerLabelsFunc, includedMetrics container.MetricSet) {
+	f metrics.ContainerLabelsFunc, includedMetrics func() container.MetricSet) {
 	goCollector := collectors.NewGoCollector()
 	processCollector := collectors.NewProcessCollector(collectors.ProcessCollectorOpts{})
-	machineCollector := metrics.NewPrometheusMachineCollector(resourceManager, includedMetrics)
+	// Shared by all requests so that the images it lists are reused across
+	// scrapes.
+	imageCollector := metrics.NewPrometheusImageCollector(resourceManager)
 
 	mux.Handle(prometheusEndpoint, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		opts, err := api.GetRequestOptions(req)
@@ -111,13 +114,20 @@ func RegisterPrometheusHandler(mux httpmux.Mux, resourceManager manager.Manager,
 		opts.Count = 1        // we only want the latest datapoint
 		opts.Recursive = true // get all child containers
 
+		metricSet := includedMetrics()
 		r := prometheus.NewRegistry()
 		r.MustRegister(
-			metrics.NewPrometheusCollector(api.WrapManagerForOOM(resourceManager), f, includedMetrics, clock.RealClock{}, opts),
-			machineCollector,
+			metrics.NewPrometheusCollector(api.WrapManagerForOOM(resourceManager), f, metricSet, clock.RealClock{}, opts),
+			metrics.NewPrometheusMachineCollector(resourceManager, metricSet),
 			goCollector,
 			processCollector,
 		)
+		if metricSet.Has(container.DiskUsageMetrics) {
+			r.MustRegister(metrics.NewPrometheusScanCollector(resourceManager))
+		}
+		if metricSet.Has(container.ImageMetrics) {
+			r.MustRegister(imageCollector)
+		}
 		promhttp.HandlerFor(r, promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError}).ServeHTTP(w, req)
 	}))
 }
@@ -0,0 +1,72 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/lib/container"
+	"github.com/google/cadvisor/lib/manager"
+	"github.com/google/cadvisor/lib/metrics"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// imageManager is a manager with no containers and one image.
+type imageManager struct {
+	manager.Manager
+	imageStoresCalls int
+}
+
+func (m *imageManager) GetRequestedContainersInfo(string, info.RequestOptions) (map[string]*info.ContainerInfo, error) {
+	return map[string]*info.ContainerInfo{}, nil
+}
+
+func (m *imageManager) GetVersionInfo() (*info.VersionInfo, error) {
+	return &info.VersionInfo{}, nil
+}
+
+func (m *imageManager) GetMachineInfo() (*info.MachineInfo, error) {
+	return &info.MachineInfo{}, nil
+}
+
+func (m *imageManager) ImageStores() ([]info.ImageStore, error) {
+	m.imageStoresCalls++
+	return []info.ImageStore{{
+		Runtime: "docker",
+		Usage:   1000,
+		Images:  []info.ImageInfo{{ID: "sha256:1", RepoTags: []string{"nginx:1.27"}, Size: 1000}},
+	}}, nil
+}
+
+func TestPrometheusHandlerCachesImages(t *testing.T) {
+	m := &imageManager{}
+	mux := http.NewServeMux()
+	includedMetrics := func() container.MetricSet { return container.MetricSet{container.ImageMetrics: struct{}{}} }
+	RegisterPrometheusHandler(mux, m, "/metrics", metrics.DefaultContainerLabels, includedMetrics)
+
+	for range 2 {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `container_image_size_bytes{id="sha256:1",image="nginx:1.27",runtime="docker"} 1000`)
+	}
+	// The images listed by the first scrape are reused by the second one.
+	assert.Equal(t, 1, m.imageStoresCalls)
+}
@@ -34,6 +34,17 @@ function humanizeMetric(num) {
   return ret[0].toFixed(2) + ' ' + ret[1];
 }
 
+// Formats a number of seconds in the largest unit it has at least one of.
+function humanizeDuration(seconds) {
+  var units = [['d', 86400], ['h', 3600], ['m', 60]];
+  for (var i = 0; i < units.length; i++) {
+    if (seconds >= units[i][1]) {
+      return (seconds / units[i][1]).toFixed(1) + units[i][0];
+    }
+  }
+  return seconds.toFixed(0) + 's';
+}
+
 // Draw a table.
 function drawTable(
     seriesTitles, titleTypes, data, elementId, numPages, sortIndex) {
@@ -563,9 +574,13 @@ function drawFileSystemUsage(machineInfo, stats) {
     // Update DOM elements.
     var els = window.cadvisor.fsUsage.elements[data.device];
     els.progressElement.width(totalUsage + '%');
-    els.textElement.text(
-        humanizeMetric(data.usage) + ' / ' + humanizeMetric(data.capacity) +
-        ' (' + totalUsage + '%)');
+    var text = humanizeMetric(data.usage) + ' / ' +
+        humanizeMetric(data.capacity) + ' (' + totalUsage + '%)';
+    if (data.forecast && data.forecast.time_to_full != null) {
+      text += ', growing ' + humanizeMetric(data.forecast.fill_rate) +
+          '/s, full in ' + humanizeDuration(data.forecast.time_to_full);
+    }
+    els.textElement.text(text);
   }
 }
 
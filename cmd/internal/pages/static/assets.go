@@ -16,7 +16,7 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
 // cmd/internal/pages/assets/js/bootstrap-4.0.0-beta.2.min.js (50.564kB)
-// cmd/internal/pages/assets/js/containers.js (34.605kB)
+// cmd/internal/pages/assets/js/containers.js (35.184kB)
 // cmd/internal/pages/assets/js/jquery-3.5.1.min.js (89.475kB)
 // cmd/internal/pages/assets/js/loader.js (65.121kB)
 // cmd/internal/pages/assets/js/popper.min.js (19.188kB)
@@ -110,7 +110,7 @@ func cmdInternalPagesAssetsJsBootstrap400Beta2MinJs() (*asset, error) {
 	return a, nil
 }
 
-var _cmdInternalPagesAssetsJsContainersJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x69\x73\x1b\xb9\x11\xe8\xe7\xa7\x5f\xd1\xeb\x6c\x76\xc8\x88\x1c\x52\xde\xe3\xd5\x52\xa6\xab\x64\xd9\xde\x28\xf1\x55\x92\x9c\x54\x4a\x56\xa9\xa0\x19\x90\x84\x3d\x1c\x4c\x00\x8c\x28\xae\x57\xff\xfd\x15\xae\x01\x30\x07\x49\x69\xbd\x9b\xe4\x25\xfe\x60\x91\x33\x8d\x46\xa3\xd1\xe8\x0b\x0d\x70\x34\x82\x63\x5a\xac\x19\x99\x2f\x04\x3c\x1e\x1f\x7c\x07\x3f\x51\x3a\xcf\x30\x9c\xe4\x49\x0c\x47\x59\x06\xa7\xf2\x15\x87\x53\xcc\x31\xbb\xc1\x69\xbc\x37\x1a\xed\x8d\x46\xf0\x8a\x24\x38\xe7\x38\x85\x32\x4f\x31\x03\xb1\xc0\x70\x54\xa0\x64\x81\xed\x9b\x01\xfc\x0d\x33\x4e\x68\x0e\x8f\xe3\x31\xf4\x24\xc0\x23\xf3\xea\x51\xff\x50\xa2\x58\xd3\x12\x96\x68\x0d\x39\x15\x50\x72\x0c\x62\x41\x38\xcc\x48\x86\x01\xdf\x26\xb8\x10\x40\x72\x48\xe8\xb2\xc8\x08\xca\x13\x0c\x2b\x22\x16\xaa\x1f\x83\x45\x52\x02\xff\x30\x38\xe8\xb5\x40\x24\x07\x04\x09\x2d\xd6\x40\x67\x3e\x20\x20\x61\x88\x96\xff\x16\x42\x14\x93\xd1\x68\xb5\x5a\xc5\x48\x11\x1c\x53\x36\x1f\x65\x1a\x94\x8f\x5e\x9d\x1c\xbf\x78\x73\xf6\x62\xf8\x38\x1e\x9b\x46\xef\xf3\x0c\x73\x0e\x0c\xff\xb3\x24\x0c\xa7\x70\xbd\x06\x54\x14\x19\x49\xd0\x75\x86\x21\x43\x2b\xa0\x0c\xd0\x9c\x61\x9c\x82\xa0\x92\xe8\x15\x23\x82\xe4\xf3\x01\x70\x3a\x13\x2b\xc4\xb0\x44\x93\x12\x2e\x18\xb9\x2e\x45\xc0\x33\x4b\x22\xe1\x01\x00\xcd\x01\xe5\xf0\xe8\xe8\x0c\x4e\xce\x1e\xc1\xb3\xa3\xb3\x93\xb3\x81\x44\xf2\xf7\x93\xf3\x3f\xbf\x7d\x7f\x0e\x7f\x3f\x3a\x3d\x3d\x7a\x73\x7e\xf2\xe2\x0c\xde\x9e\xc2\xf1\xdb\x37\xcf\x4f\xce\x4f\xde\xbe\x39\x83\xb7\x2f\xe1\xe8\xcd\x3f\xe0\xaf\x27\x6f\x9e\x0f\x00\x13\xb1\xc0\x0c\xf0\x6d\xc1\xe4\x08\x28\x03\x22\xb9\xa9\x27\x11\xce\x30\x0e\x48\x98\x51\x4d\x12\x2f\x70\x42\x66\x24\x81\x0c\xe5\xf3\x12\xcd\x31\xcc\xe9\x0d\x66\x39\xc9\xe7\x50\x60\xb6\x24\x5c\xce\x2a\x07\x94\xa7\x12\x4d\x46\x96\x44\x20\xa1\x1e\x35\xc6\x15\xef\xed\xcd\x95\x3c\xc5\xc9\x02\x31\xc1\xe3\x8c\xa2\xb4\x17\x25\x25\x63\x38\x17\xd1\x00\x3e\x17\x28\xf9\x84\xe6\x98\x4f\xe0\x22\x4a\x28\xc3\x0a\x2e\x1a\x40\x34\x47\xe5\x1c\xcb\x0f\x29\x9e\xa1\x32\x53\xcf\x66\x94\x2d\x91\xfa\x54\x12\xf9\xbf\x90\x53\x10\x5d\xde\xf5\x0f\xf7\xf6\x66\x65\x9e\x48\x2a\x60\x51\x2e\x51\x4e\x7e\xc6\xbd\xbc\x5c\x0e\x80\x93\x9f\xf1\x00\xca\x9c\x08\xde\x87\xcf\x7b\x00\x37\x88\xa9\xaf\x87\x7b\xa0\x86\xdc\x93\x5f\x60\xaa\x41\xe2\x82\x16\xbd\xfe\xa1\xf9\x92\xe1\x7c\x2e\x16\xf0\xcd\x37\x90\x97\x4b\x78\x3a\x55\xc8\xf4\xcb\xb0\x81\xc6\x0c\x0a\x6c\x64\xc0\xf6\x00\xee\xf6\x00\x18\x16\x25\xcb\xe1\x42\x11\x23\x9b\x5c\x1e\xee\xdd\xed\x49\xc6\xbd\xa4\x59\x46\x57\x92\xab\x92\x61\x27\x2f\x8e\x21\x47\x4b\xf9\x35\xa1\xf9\x0d\xce\xe5\x58\x9a\x83\x3a\x79\x71\x2c\xc7\xe5\x86\xc2\xb0\xa4\x25\x1c\xf3\xc1\xf8\xf1\x77\x03\xb8\x88\xce\xc9\x33\xc9\xa5\x9f\xf4\x9f\xd7\xfa\xcf\x5f\xf5\x9f\x67\xd1\x65\xff\xd0\xd1\xc7\xb0\xb8\x18\x5f\xc6\x82\xbe\x24\xb7\x38\xed\x3d\xee\xc3\x3e\x44\x10\xc1\xbe\x7a\x73\xa0\x88\x6e\xd0\xfc\x1a\x0b\x46\x92\x16\xb2\x9b\x74\x6b\xd0\x5d\x48\x1f\x8f\x15\xe9\x9a\x72\x4d\xb8\xa6\x5b\x93\xbd\x16\x98\xdf\x9f\x74\x49\xfb\x73\x86\x56\x80\x40\xc9\x4c\xec\x28\x4c\x19\x5a\x9d\xcb\x67\x3d\x35\x85\x1c\x33\x82\xf9\x39\x11\x19\xe6\x03\x10\xf2\xef\xf9\xba\x90\x9f\x53\x24\xd0\x00\x70\x86\x97\x38\x17\x27\xe9\x40\xce\xf6\x3b\x29\xba\x72\x9d\x33\x71\x92\xa7\xf8\xd6\x0d\x4e\x42\x2b\xb4\x30\x85\x1c\xaf\xc0\x2c\x83\x1b\xc2\x4b\x94\x91\x9f\xd5\x82\x89\x9f\x5b\xa0\x5e\xbf\x12\x47\xd9\x98\xc0\x14\xc6\x87\x40\xe0\x49\x40\x8f\x11\xc8\x43\x20\xfb\xfb\x56\xe4\xaa\x7e\x62\x94\xa6\xc7\x34\x2b\x97\x79\xcf\x51\x7d\x41\x2e\x07\x01\x8a\x0b\xa2\x79\x27\x45\x33\x68\x7a\x4a\x57\xbc\x27\x9f\xa8\xd7\x64\x06\xbd\xaf\x7a\xd5\x58\x95\x52\x23\x79\x4a\x57\x66\x1d\x57\x12\x1f\x3c\xbd\xa8\x1a\x5c\xc2\x54\xbd\x56\x8b\xa2\x6b\xf4\x7a\xe4\x29\x4d\x4a\xd9\x28\x9e\x63\xf1\x42\xb7\x7f\xb6\x3e\x49\x5d\xe7\x7d\x43\xb0\x61\x6c\xc2\xf9\x71\x86\x38\x7f\x83\x96\x98\xc3\xd4\xd0\x11\x2d\x30\x4a\x31\x3b\xa5\xab\x68\x02\x51\x34\xd0\x0f\xd5\x5c\x9b\x67\xea\xf3\x90\xd1\x95\x7d\x49\xd3\xf4\xbc\xf5\xbd\xec\xed\xd0\xf4\x46\x0b\xe1\x3a\x41\x99\xc0\x2c\x47\x52\xb7\x9f\xd2\xd5\x99\x58\x67\x78\x02\x82\x95\x58\x63\x2c\xd0\x1c\x4f\x20\xc2\xb9\xd2\x4a\xee\xd9\x19\xf9\x19\x4f\x9c\xb4\x18\x54\x19\x5d\xfd\x59\x2c\x33\x1f\x81\x14\x23\x3d\x85\x13\x27\x52\xee\xd5\x11\x4f\x70\x9e\x92\x7c\x3e\x81\x19\xca\xb8\x69\x14\xf0\x63\x12\x7e\xb5\x23\xe9\x9a\xa5\x58\x0a\x7f\xaf\x92\x83\x81\x1a\x6e\xbf\xb6\x60\x32\x92\x63\x50\x4d\x6b\xab\xe6\x15\xc9\xf1\xb1\x7c\xde\x0b\x17\x4d\x63\xa1\x48\xb5\xe7\x56\xc6\x92\xe4\x30\x85\x93\x7c\x46\x72\x22\xd6\x96\xd1\x4b\x74\x0b\x53\x18\xfa\x8f\xdb\x96\x83\xc4\xdd\xb6\x0c\x94\x1f\x93\xdf\x60\x26\x94\x66\x9a\x11\xc6\x05\x24\x8a\x97\xd2\x28\x23\x78\x8e\x04\x8e\x15\xa8\x94\x6d\x89\xe6\x82\x5c\xc2\x57\x53\xc8\xcb\x2c\xb3\x58\xf4\x9a\xb8\x20\x97\x17\xe3\x4b\xb3\x6e\x65\xbb\x9e\x7b\xaa\x64\xd1\x48\xa3\xea\xf5\x25\xc9\x53\x39\xa4\x81\x1c\x81\xee\xa0\xa2\xfb\x23\x4c\xe1\xe0\x10\x3e\x1a\xba\x2f\xc8\x65\x45\xfa\x47\x47\xba\x1e\xff\x0d\xca\x60\x5a\x75\xff\xf1\xf2\xd0\xbc\x93\xd4\xca\x77\x4f\x64\x27\xae\x09\x18\x36\xde\xa0\xcc\x42\xde\xd5\x5a\x3c\x95\x14\x05\x2d\x14\x8b\x1b\x2d\xee\xec\xea\x92\xfe\x05\x86\x94\xe6\x91\x80\x15\xca\x85\x64\x1c\x5f\xd0\x15\xa0\x7c\x2d\x9b\x95\x98\x83\x72\x85\xc4\x02\xe5\x30\x06\x4e\x21\x41\x85\xe2\xb7\x24\x46\x41\x00\x92\x13\x80\x44\xac\xf1\x1d\xe9\xe9\xe0\x68\x89\x41\x90\x25\x1e\x68\x84\x07\xe3\x3f\x5a\x1f\x6d\xce\x50\xb1\x80\x6b\x9c\xd1\x55\x0d\x13\x99\xc1\x0a\x43\x82\xf2\xd8\x09\xce\xdf\x95\x20\xc3\x54\x81\x0d\xa1\x27\x87\x34\xd4\x9c\x19\xc1\xc1\xd8\xaa\x2e\x07\xf9\x04\xc6\x96\x05\x7e\xf3\xf1\xa1\x37\xe8\xa3\x34\x55\x5d\xa7\x58\xc9\x9e\x14\x6f\x3a\x03\x8c\x92\x85\x95\x20\x94\x6b\x88\x1c\x27\x98\x73\xc4\xd6\x5a\x0e\x7f\x85\xaa\x6f\x53\xdb\x51\x8a\x04\x96\x5c\x8a\x6a\x3a\xdb\x88\x5d\xb0\x1e\x0e\x1e\x6e\x1e\xa2\xbc\x5c\x5e\x63\x56\xef\x65\x17\xcb\xa0\x19\x76\xcc\x30\x12\x58\x71\x45\xea\x01\xc5\x9a\x70\xb4\xbf\x97\x09\x71\x2a\xe8\x3e\x66\x64\x34\x82\xf3\xb7\xcf\xdf\xf6\x6e\x96\x88\x2d\x69\xd6\x9f\xc0\x2b\x4a\x3f\x01\xc9\x05\x95\x8a\x2e\x9f\x5b\x07\xe7\x86\xe0\x95\xa1\x4f\x2e\x86\x39\x16\x80\x80\x2f\x29\x95\x7e\xb5\x46\x84\x72\xb2\xac\xc6\xdc\xb0\x18\x49\xc9\x6e\x94\x25\x9e\x40\x64\x75\xa7\xb1\x0c\x0b\x2c\x03\xab\x09\x7c\x3b\x1e\xeb\x07\x19\x9e\xe3\x3c\x9d\xc0\xe7\x82\x72\x25\x85\x13\x88\x72\x9a\xe3\xe8\x6e\x60\xd4\x4a\x52\xf2\x73\xc4\xe6\x58\x4c\x20\x4a\x90\xc0\x73\xca\xd6\x06\xdb\xcd\xd1\x2d\xe1\x93\x6a\xb1\x2b\x0f\x60\xa2\x14\xef\xc0\x2a\x19\x82\x57\x5a\xfe\x27\xa1\x16\x99\xb8\x95\x31\x08\x15\x43\x8d\x2e\xf3\xd2\x23\xef\x9a\x0a\x41\x97\x91\x53\x23\x87\x9a\x29\x27\x7a\x6d\xaf\x16\x34\xc3\x4a\x98\x8c\xa4\xc1\x02\x71\xa7\x10\xd4\x32\x1f\x80\x60\x6b\xc9\xdc\x04\xe7\x02\x33\x20\x2a\xec\x93\x30\xc6\xe4\x54\x2b\x1a\xa6\x53\x5f\xa3\x49\x3e\xc7\x6a\xd8\xb1\x1b\x5a\xac\x75\xdc\x41\x7c\x00\x7f\x92\xc0\x87\x9b\x40\x95\x02\x1d\xc7\x3f\x3a\x50\x25\x1d\x0f\x33\x96\x3f\x61\xa1\x87\x66\x82\x06\xa3\xde\x88\x1c\x94\xd4\xc6\x24\x87\x1c\xe5\x94\xe3\x84\xe6\x29\xf7\x2c\xe9\x1c\x8b\x13\x03\xd4\x33\x71\xd1\x00\x0a\x86\x6f\x08\x2d\xbd\x90\x25\x29\x99\x6f\x91\x0c\x64\xdf\x9a\x4f\xd9\xc0\x7f\x5f\x21\xb0\x6b\x76\xc9\x61\xf8\x14\x72\x1e\x3b\xc7\x59\x22\x91\xcb\xe5\x9c\x2c\x71\xaf\x0f\x43\x85\xc4\x3d\xe8\xc3\x9f\x94\x3b\x3e\x1e\x8f\xed\x20\x8f\x17\x38\xf9\xc4\xe5\x84\x78\x81\x22\x4e\x81\x0b\x24\x38\x90\x3c\xc9\xca\x14\xd7\xde\x31\xcc\x69\xc9\x12\xdf\xe5\x5e\x20\x7e\x6a\x9e\xf6\x54\xd3\x41\x05\xa5\x07\x6c\x08\x54\xef\x62\xfd\xbf\x61\xeb\x53\x18\xcb\x78\xcc\x7b\x73\x31\xbe\xbc\xb0\xad\x2f\x9b\x84\xa2\x2c\x93\x91\x89\x40\x24\xc7\x4c\xd2\x08\x05\xa3\x37\x24\xc5\x29\x64\x84\x8b\x07\x11\xfd\x92\xb2\xa3\x2c\xeb\x55\x68\x4f\xf2\x19\x6d\x8c\x41\x4a\x6d\x08\x61\xc7\x30\x9d\x4e\x9d\x55\x32\x43\x55\x0e\x9d\x55\xbf\x6d\x8e\x4f\x2b\xaa\x40\xd5\x2b\x85\xeb\xb3\x36\x6c\xa2\x42\x81\x8a\x44\xe7\x17\xd4\x09\xb0\x0e\x41\xf5\x46\xfa\xa7\x35\x97\x90\x63\x21\x05\x5c\x85\xe8\x3c\x96\x12\x87\x80\x70\x95\xac\x61\x84\xe3\x54\xbe\x44\x39\x20\xc6\x90\x4a\xc6\xa8\x0f\xdc\x64\x70\x56\x54\x62\x32\xeb\x8a\x4f\xe4\x17\x04\x5c\x30\xa9\x74\x33\x74\x8d\x33\x65\x58\x90\x74\x98\xb1\x0c\x2f\xb5\x37\x60\xb3\x13\xaa\xcf\x9a\x23\xfa\x93\xa2\xa3\xe7\x79\x9c\x9a\x32\x3d\x48\x43\x65\x99\xf3\x05\x99\x89\xde\x45\xf4\x4a\x76\x22\x83\xc9\xbf\x49\xcc\x2a\x98\x6c\xd8\xb5\x82\x16\x65\x26\xbf\x28\xc7\x40\x8e\xcf\xc4\x8d\xce\xe4\xc3\xb4\xdd\x26\xa9\xc1\x9e\x53\x67\xf0\x0d\x31\xf7\xb2\x9e\xc6\x92\xa8\xac\x8a\x35\x26\xd6\x62\x1c\x58\x8b\xc1\x70\xfa\x92\xd1\xe5\x04\x7e\x74\x0f\xce\xa9\x07\xb0\xc6\x32\xcc\xd0\x30\xff\xf7\x7b\xff\x99\x04\xb3\xad\x96\x24\xa7\xec\x9c\x24\x9f\xf8\x04\x0c\x50\x65\xd5\x26\xf0\x39\x2d\x99\xf9\xf8\xa3\x8c\xcd\x31\xe2\x2a\x04\x89\x64\x5c\x80\x58\x74\xe7\x87\x4b\x4a\x69\x56\x86\xbb\xd3\x6c\xab\x09\xdb\xd5\x64\x6b\x3b\x50\x29\xdf\x81\xe5\x8b\xaf\x7a\xb5\xeb\x88\x92\x85\x8c\x55\x48\x3e\xa3\xa1\x82\x7d\xad\xdf\xc8\x75\xd0\x63\x94\x8a\xe7\x84\x0d\x20\x41\x59\x76\x8d\x92\x4f\x5a\x4a\xbe\x96\x54\xfc\xe5\xec\xed\x1b\x0b\x00\xfb\x10\xa1\x82\x8c\x6e\x0e\xe2\xf1\xc8\xa0\x8e\x06\x60\xd1\x6a\x8f\x08\x3e\x57\x68\x8c\x8b\x04\x77\x01\x5d\x05\x6f\x21\xe7\x1d\xa3\xd2\x8f\xac\x91\x63\x57\xab\x8c\xdc\x76\xa7\xee\x71\x3c\x1e\x15\x3c\x82\xfd\x10\x41\xdf\x4c\x41\x9c\xd2\x1c\xf7\x76\x20\xda\xc2\xcf\x10\xc9\x1c\xfc\xc7\x7f\x2e\x6e\xd9\x00\x04\xbe\x15\x67\x02\x89\x92\x0f\x00\x33\x46\x59\x80\xe3\xe2\xb2\x31\x6c\x65\xbf\x2d\x3d\xc6\x3c\xd4\xf2\x8b\x38\x75\x10\x21\x7b\x64\x4f\x7c\x47\xc6\x8c\x46\x70\x8a\xff\x59\x62\x2e\xe0\x87\x31\x97\xba\xc6\x75\xbb\x20\x5c\x50\xb6\x56\x2b\x2d\xa7\xd2\xe7\x28\xa4\x97\x5c\x65\x9f\x74\xb3\x29\x48\xbe\xc6\x5a\x01\x91\xd9\xba\x57\x05\x94\xef\x0b\xe9\x90\xc3\x12\x91\x5c\x9b\x1a\xd3\x13\x4e\x9f\xad\xdf\x9f\xc0\x6a\x41\x32\x0c\xa5\x04\x92\xaa\xeb\x51\x5e\x2e\xaf\x14\xd8\x23\x58\x60\x66\x82\xcd\xa8\x7a\x1a\x4d\xe0\x07\xb3\xe0\xf4\x43\x4d\x4e\x34\x81\xb1\x5c\x41\x5a\x3d\x7c\x1d\xaf\x16\x38\xef\x59\xf7\xec\xeb\xb8\xa0\x5c\xb4\x4a\xa4\xb3\x69\x8d\xb9\x1f\xd8\xb1\xf5\x07\x5b\x11\x1d\x8c\x78\x79\xbd\x13\xae\x0e\x89\x72\x6d\x4f\x31\x2f\x06\x10\xa0\x93\x8f\xfc\x08\xb4\x12\x99\x10\xe4\x62\x7c\xd9\xd2\xd0\x45\xdb\xe0\x49\xd7\x73\xab\x32\x75\xe0\x28\x85\xea\xf8\xdd\x7b\x28\x39\x6a\x98\x85\xe3\xa2\x3c\xa7\x02\x65\xef\xe5\x3b\xdf\x3a\x2c\x9d\x3a\x18\x68\xe1\x74\x26\xdb\x78\x16\x05\x4e\xe2\x05\xe2\x57\x49\x51\x4a\x7f\xe3\xab\x16\x97\x25\x4a\x8a\x32\xea\x87\x76\x3c\xc8\x54\x29\x27\x5c\xaa\xef\x8b\xe8\x5c\x47\x75\x91\xa2\x27\xba\x3c\x0c\xcd\xc8\xc5\x65\x67\x78\xd7\xf0\x80\x02\x93\xef\x1c\x43\xdf\x21\x22\x26\x79\xe0\xf9\x85\xc1\x6b\x18\xc2\x81\x07\x62\x5d\xd4\x37\x92\xd4\x9a\x37\x1a\xcb\x70\x94\x0b\xb4\x2c\xb4\x4f\xea\xbe\x6b\x79\xd5\x18\xac\x2d\xaf\x86\x02\xd5\xa3\xb8\x28\xf9\x22\xc4\xd4\x6f\x83\x50\x20\x49\x51\xc6\x7a\x22\x85\xe4\x93\xf5\x48\x6b\x8f\x65\xa8\xef\x68\x36\xd8\x54\x3c\xae\x30\x59\xbc\x2e\x98\x0d\x52\x55\xa2\x2b\x49\x15\x1d\x53\x86\x79\xb4\x4d\xd0\x32\x8a\xd2\xa6\x9c\xbd\xa2\x28\xdd\x45\xc2\x3a\xc4\xe2\xe8\x06\x33\x34\xc7\xbf\x87\x60\x7c\xc9\x49\xb3\x73\x26\x79\x72\x85\xf4\x18\x54\x1e\x66\x3c\xfe\x72\xd3\x72\x5a\xe6\x2a\xa1\x0a\x62\xc1\x30\x4a\x37\xcf\x50\x81\xd9\x30\xa1\x0c\x6f\xd2\x09\xef\x30\x93\x53\xfd\xaf\xd0\x0a\x26\xd9\x84\xb4\x0c\x28\x8a\x4d\x9a\x89\x55\xae\x65\x5d\x3c\x9a\x22\x60\x22\x02\x8f\xde\x58\x1a\x14\x89\x84\x07\x52\xa0\x51\x69\xfe\x2b\xf1\x56\xbb\x22\xa4\x9a\x82\xff\x4f\x54\x90\x8a\xb4\x02\xf5\x51\x60\x26\xe7\xe8\x4a\x7d\x83\xe9\x54\x6d\x4c\xce\x48\x8e\x53\xdf\x1a\xb9\xc9\xa9\x12\xba\x0f\x5e\x18\x41\xce\x77\xac\x73\xbe\x1d\x13\x14\xa4\x7e\x43\xcc\x15\x69\xb0\x71\x44\x17\x1f\x2f\x9b\xba\xb1\x0e\xd1\x87\x91\x87\xae\xa1\x30\xef\x7e\x5f\xb5\xa9\x67\xe2\x9a\x61\xf4\x29\xa5\xab\xbc\xb9\x2a\xd5\x72\x7c\x66\xdf\x77\xae\xcb\x20\xa6\xed\x08\xb4\x37\xaf\xd3\x00\xf4\x61\x56\xfc\x3d\x57\xd9\xd3\xe8\xaf\x98\xe5\xf8\x3e\xe6\xbc\x46\xe6\xf6\x35\xd5\xd2\xa0\x6d\x6d\xb5\x82\xfd\x07\x98\xf9\x92\x63\xd6\x94\x64\xf9\xb4\xd5\xc8\x77\x2c\x96\x1a\x52\xbe\xe6\x02\x2f\x9b\x68\xf5\xf3\xdf\xc9\x7b\x38\xd5\xb9\x13\x1d\x0f\x1b\x11\x52\x11\xbf\x14\x91\x19\xa3\xcb\x20\x3f\xe2\xfb\xbe\x26\x99\x54\x72\x93\x84\x96\xd8\x0a\xc4\x39\xd6\x8d\x5f\xaa\xfd\x2c\x9b\x9a\x51\x19\xc6\x94\xdc\x90\xb4\x44\x99\x46\x5e\x50\x22\x59\x14\x46\x54\x1e\x7e\x35\x90\xe7\x48\xa0\x5e\x4b\xaf\xba\x87\x6e\x5f\x65\x07\x51\xb7\xfb\xd6\x75\xe4\x6d\x82\xee\x1b\xa8\x46\x83\x0b\x72\x19\xe7\x32\x94\x6d\xd1\xb0\x66\x57\xad\xb5\x4d\xb8\xac\x1a\x1b\x6d\xc6\x58\x75\xb6\xf4\xf6\xde\x7c\xeb\xb5\x01\xde\x2c\x34\xd3\x48\x45\xc0\x39\x66\x2a\xc7\x03\xbc\x40\x8c\x63\x33\xd3\x3a\xff\x65\x17\x08\x20\x21\x27\x0f\xdf\xc2\xcf\x98\x51\x27\x1d\x6a\x02\x01\x09\x87\x4f\x43\x91\xfd\x83\x81\x9c\xfb\x6b\x0c\xa5\x94\x06\xc4\xf5\xb6\xa6\xd9\x7b\x62\x74\x15\x7b\x74\xfb\x8b\x35\x58\x97\xd5\xe8\x9a\x33\x34\xa3\xec\x05\x4a\x16\x2e\xb8\xf3\xad\x65\xb8\xf8\xd4\xae\xa9\x1f\x9d\x85\x40\x17\x64\xff\xe0\xd2\xec\x67\xbe\xcc\xe5\x02\xd5\x8a\xa5\x02\xec\x58\x71\x8d\xe4\xa3\x2f\x27\x13\xf3\x77\x50\xad\xd9\x89\x5e\x88\xaa\xc9\x46\x9f\xd0\x1f\xeb\x16\xdf\xd0\x5f\x2b\x0d\x1f\xb1\xc1\x33\x67\x82\xbe\x6a\x26\x88\x5b\x16\xd8\x56\x73\x93\xd8\xe5\xa9\xb5\xf4\xae\x2b\xd7\xb0\xd5\x85\xe5\x15\xc7\x3d\x9b\xf2\x60\x13\xe0\x68\xad\x2b\xdb\xdd\x03\xb5\xc3\x3d\x2b\x28\xa1\x4a\xad\x06\x1c\x5b\xe5\xea\x9e\x3c\xc4\xdb\x68\x4c\xf7\x12\x2f\x29\x5b\xb7\xce\xf8\x6b\xf5\xea\xb7\x9f\x74\x4d\xc2\xbf\x64\xde\xcd\xb4\xc9\x59\xd3\x54\xe8\x19\x82\x11\xd0\x1c\xbf\xc6\x73\x74\xbd\x16\xf8\xcb\xcc\x8d\xc5\x66\xe7\x27\x9c\x20\x95\x04\x57\x33\x44\x65\xa4\x98\x65\xd5\x66\x44\xeb\xd4\xbc\xd5\x40\x9b\xa3\xb4\x16\x6f\x70\xb3\xef\xd4\xed\x80\x55\xde\x92\x44\x60\x88\xd5\xf6\xcd\x22\x35\x3e\xaa\xad\x1e\xd8\xee\x76\x6e\xe8\xec\xe9\x14\x1e\xfb\x2b\x73\x83\x1f\xb7\x91\xe4\xc7\x9e\x83\xc7\xd0\xca\x12\xb8\xfb\x1a\xfd\x52\xfe\xa1\x5f\x7f\x43\x61\x49\xb2\x8c\xa8\x70\x47\x97\x4e\xa0\x4f\x7a\x23\xa5\xc0\x2c\xc1\xb9\x40\x73\xac\x77\xc7\x2b\x96\x56\x56\xe6\x35\x12\x8b\x98\xd1\x32\x4f\x7b\xbd\x5e\x35\xa2\xc0\x65\x83\x51\x7b\x64\x65\xf6\x2b\x8d\xba\x52\xd3\x63\xf1\x3f\x55\x2f\x2a\x63\xe6\x4d\xe5\xc1\x78\xec\xc7\x43\x66\xaf\x48\x19\xa6\x8b\xe8\xf8\xdd\xfb\x68\x50\x41\x5f\x86\x75\x68\x7a\x35\xed\x2a\x12\x1a\xda\xab\x56\x3a\x43\xa2\x54\x3e\x82\xa0\xc1\xe6\x05\x27\x3f\x9b\xd4\xb1\xec\x44\x15\xd7\x36\x05\x43\x62\x35\xab\x59\x41\xb8\x21\xeb\x06\x4f\x03\x0e\x69\xc8\xab\x04\x15\x28\x21\x62\xed\xf8\x60\xb1\x6f\x00\x0e\xa2\xe3\x70\xc8\xfe\x54\xb5\xa8\x17\x85\x3c\x9c\x93\x90\xbb\x5a\xf9\x46\x03\x1f\x6d\x8d\xc7\x79\xb9\xfc\xc9\x2e\x45\xd3\xd8\xf8\x75\x7b\x2e\xec\x9f\x91\x0c\x5b\xdf\xfe\x73\xe8\x2a\xfa\xfb\xa7\x01\x64\x9b\x33\x1a\x38\xb6\x21\x78\x15\x73\x19\xaf\xb8\xca\x2a\x5b\x36\xcc\x32\x4a\x59\x4f\xed\xa6\x18\x06\xa8\x71\xc7\x63\x29\xad\xea\x69\xc5\xfd\xc3\xc0\x49\x93\x23\xb3\x05\x07\x28\xbd\x21\x9c\xb2\x78\xc6\x15\xee\xb8\x72\xa6\x14\x82\x14\xdf\x10\xb5\xc3\xed\xfc\x42\xb3\x41\xe1\xa9\x57\x53\x3a\xa1\xcb\xf9\x29\x4b\x31\xb3\x3e\xa1\x06\xb8\x70\x1c\xdd\x97\xbd\xc7\xca\xb5\xbc\x54\x0e\xfe\xcb\x33\xf8\x43\x04\xfb\xd0\xab\x9e\xc3\x3e\x1c\xf4\x07\xde\x70\x2f\xeb\xd5\x6f\xaf\x94\x04\xa9\xd2\x28\x55\x53\x24\x83\x19\xc7\x36\x4b\x55\x4a\x78\x91\xa1\xb5\xae\x8f\xff\x3e\xb6\x8d\xa3\x97\x0e\x32\xc5\x02\x91\x8c\x47\xc0\xb1\xb6\x01\x5c\x90\x2c\x53\xd5\x62\x7a\x07\x8d\xe9\xfd\x48\x35\xb7\xd2\x78\xb8\x5e\xb8\x5b\x2e\x4b\x74\x7b\x55\xe9\x6e\x7f\xa8\xdf\xbb\x15\x12\xc8\x91\x2e\x92\xbb\xf2\xb7\x8b\x1d\xb3\x9c\xd0\xf1\x8c\x24\xb8\x37\x1e\xf8\xc0\xbe\xbb\x6a\x0c\x67\xe7\x3e\xb4\x32\x87\x6a\x4b\xd7\xd9\x5c\xa5\x7c\x1e\x7f\xa7\x04\xe5\xf1\x77\x87\xf6\xf5\x4f\xa4\xfe\x3a\xb0\xd3\x6d\xfe\xcb\xbd\x6d\xe4\x56\x3d\xb5\x35\x69\xb2\x83\x43\xd3\xb9\xfb\x31\x80\xe8\xcf\x54\xdc\x23\x94\xfc\x62\x59\x93\x2f\x9d\xfb\xee\x76\xa8\xb6\x35\x59\x51\xf6\x89\xe4\xf3\x2b\x8e\x45\x6b\xc3\xce\x84\xc4\x9e\x09\x30\xcd\x8e\xb7\x9e\x2d\xa5\x6a\x07\xc0\xb7\x98\x14\x67\xb5\xae\x76\xd4\xfc\x1d\x82\xe2\x9b\x1e\xf8\xe6\x9b\x3d\x9b\x86\xd9\x02\xf9\x24\xe8\xbd\x92\x9d\x1a\x49\x3b\x98\x3a\xcb\x86\xf7\x76\xef\x55\xf9\x16\x8c\xce\xd5\x31\x97\x6b\xc4\xe2\x2f\xe5\x08\x2e\xa8\xd0\x6b\xac\xa6\xe8\x3b\xa6\xd2\x53\xfa\xc1\x50\x2d\x3a\xa5\x49\xb7\x21\x6c\xd8\x8f\x56\x54\x09\xcd\xd2\x0a\x93\x8f\x77\xe8\x88\x96\xb0\x5f\xf7\xa2\x3f\x58\xd6\x0c\x17\x54\x0c\xed\xd2\x8d\x57\x24\x15\x8b\x9e\x1b\xe1\x3e\x44\x7f\x8c\xfa\x8d\x36\xb2\xa3\x7a\x23\xaf\xf3\xb0\x95\x86\x1b\x0a\x7c\x2b\xa2\x6a\xc3\x58\x7e\xf3\x53\xdb\xfe\x59\x96\xfa\xb8\xf5\xe1\x8d\x91\xda\xa8\xf0\xe1\x02\x1e\xc0\xbe\x87\x2d\x82\x9e\x04\xf6\x59\x20\x69\xea\x47\xda\x35\xdd\x35\x7f\x57\x0f\x5e\xbc\x55\xa6\x6d\xa1\x5f\xd0\x37\x43\xfe\x79\x34\x57\xd0\x90\xa3\x25\x0e\x33\x6f\x6f\xb0\x90\x02\x72\x62\x5b\xa9\x92\xfe\x5e\x85\x44\x6f\xb1\x57\x5f\x8d\x09\x6a\x53\x82\x0e\xa6\xab\xf6\xcb\x41\xd8\xcc\x19\x4c\xa7\x10\x74\xd5\xa8\xfa\x22\xad\x49\x97\xe1\xc1\x86\xf8\x3a\xd7\x23\x02\x71\x3b\x62\xb7\xa0\x58\x56\x0b\xdd\xcc\x98\xd5\x51\x9d\x07\x6e\xb0\xd9\x4e\xba\x36\xd9\xcc\xfb\x4d\x1b\x6d\x72\xf6\xdc\x64\xa9\x39\xb4\x6a\x81\x04\xb3\x01\x53\x35\xe0\x80\x8e\x5a\xc9\x61\xc5\xe6\x7a\xc3\xce\x19\xae\x04\xb4\xee\xdc\x19\xca\xe3\x0a\xd5\xa0\x56\xcc\xd8\x84\x70\xc9\xe8\x60\x9a\x35\x0d\x5e\x81\x7b\x42\x73\x4e\x33\x1c\x67\x74\xee\xfa\x8f\xde\x9b\xdd\x53\x0a\x33\x92\xa7\x6e\x08\x8f\xa2\x01\xd4\xe4\x30\x7a\x24\x1d\xc8\xa8\xaa\x1a\x09\xf6\xfe\x0c\x59\x41\x36\x68\xab\xc1\x37\x02\x22\x3f\x9f\xda\xcf\xff\x9e\xd5\x0f\x27\xf9\x19\x4e\xee\x15\xf9\x9a\x9d\x6e\x5b\x21\xfb\x25\x9d\x8b\x70\x6b\xa3\x29\x10\x17\xa1\x10\x5c\xc6\xe2\xf6\x4a\x31\x17\x86\x4e\x33\x2a\x72\xef\xd1\xd6\xdf\x30\x0c\xb8\xf2\x65\x48\x64\xbf\x82\x44\xb6\x23\x89\x5f\x60\x1f\x47\x69\x2d\x28\x30\x03\x5d\xaa\xbd\x31\xd7\x68\xd5\x94\xaa\x89\xe3\xad\x5a\xf0\x85\x7a\xf5\x3f\x35\xf8\xdf\xad\x06\xb5\x02\xfc\x9f\xea\xfb\x6d\x54\x9f\x5e\x7e\x0f\xd4\x7d\xba\xf1\x6f\xaf\xfc\x1e\x4e\x24\xdb\x95\xc8\x2f\xa0\xfe\xb4\xba\x6a\xd5\x7f\x5e\xb6\xc9\x4b\xf1\xe8\x68\x45\x9f\x0e\xac\xf9\x81\x2f\x49\x86\xcf\x14\x94\xce\x50\x6c\x2a\x8a\x6b\x0a\x73\x8b\x0a\xb2\xe2\xab\x76\x5e\xda\x53\x7f\x2d\x0b\x12\x67\x30\x95\xc1\xc9\x93\x94\xdc\x3c\x8d\x3a\x0f\x5a\x6f\x4f\x10\x6e\x4f\x0f\x7e\x81\xe4\xa0\x4d\x8e\x19\x6e\x3f\x7f\xfb\xda\xc9\x9e\xb7\xce\x1e\x90\x37\xd4\x62\xcc\x63\x1b\xd9\x99\x92\x7b\x13\xd2\x79\x64\xbb\x90\x4e\x37\x90\xf1\x9b\x05\x0e\x63\xb9\xda\xe9\x7e\x37\xc2\xb6\x30\xce\x07\x72\xb9\x68\x17\xca\xf9\x81\x9c\x23\xa4\x1f\x19\x19\xbe\xab\xe5\xbe\x4e\x96\x68\x8e\x79\x8f\xa8\x3f\xce\x82\xea\xef\x32\xf2\xc9\xcb\x2c\x83\x5f\x7e\x01\xfd\xc4\x1d\xbe\xa9\x9f\xbd\xb1\x4b\x24\x38\xf7\x05\x53\xf8\x5c\x9d\x6a\xf0\x54\xfa\x29\x56\xc7\xdf\x74\xe2\x3a\x3a\x47\x73\xe5\xdb\x9e\x3c\x57\xc7\x49\x08\x13\x25\xca\xe0\x8c\xfc\xac\xd4\xbe\x3a\xe8\x21\xc9\x0d\x4b\x27\xdc\xe9\x7c\x85\x51\x17\x9e\x4b\xf8\xb6\x4f\xd5\x51\x4d\xfb\xa9\x42\x53\x1d\x10\xb7\xbb\x0f\x3b\xe5\xd2\x02\x66\x34\xa4\xbb\x45\x7f\x2b\x8a\xd1\xbc\xfe\x88\x49\x3e\xc0\xd4\xe0\x93\x01\xa7\x7c\x72\x25\x21\xa5\xf1\xe6\x45\x46\x44\x2f\x9a\x44\x95\x9d\x2c\x28\x57\x4f\x13\xdc\x1b\x1e\x0c\xe0\x60\x43\xdd\x5c\x0b\xce\xee\x72\x0e\xd5\x53\x17\x25\x1f\x9b\x94\x18\xff\x46\xb5\x72\xae\xcd\x81\x5f\xe9\xa0\x3a\xd4\x35\x29\x0a\xec\x22\x84\x96\x5a\xc8\x95\x3e\x78\x9b\x47\xa1\x8d\xd0\x43\xfe\x48\x49\xae\x7a\x6f\xb5\x23\xaa\x27\x0d\x32\x80\x0e\x18\x37\x2e\x92\xc6\xbc\xbc\xe6\x82\xf5\xc6\x03\x78\xfc\x5d\x3b\x78\x35\x8a\xcf\x37\x13\x8f\x27\x37\x5a\x36\xaf\xf4\x75\x28\xb3\x49\x90\x5c\x69\x07\xeb\xdb\xb2\x0e\x25\x58\xfe\xe1\x42\x07\x9f\xa8\xb3\x4c\xa9\x39\x29\xd8\x4a\x50\x48\x87\x69\xa0\x48\x48\x63\x41\x5f\xd1\x04\x65\xf8\x4c\xc9\x7b\xaf\xea\x71\x8b\x21\xd3\xc7\xaa\x44\xe7\x1d\x1d\x51\x4a\x93\x4f\x98\x0d\x75\xb7\xd1\x00\xbe\x1d\xfb\x77\x74\x1c\x36\x74\x89\x39\x90\x23\xd5\x09\x3f\xa5\x54\x0c\xa0\x3a\x7d\x52\xb8\xb3\x3a\x4e\xc9\x78\x0f\xdb\xf4\x8a\xc9\x9f\x69\x94\x43\x41\x8b\xa8\xaf\x15\x67\xf4\x86\x42\xf5\x02\x66\xb4\xd4\xf6\xb5\xa9\x8b\x42\xad\xb3\xa7\x3d\x58\x53\x75\xf8\x4e\x6b\x9b\x77\xe6\xef\x99\x40\x4c\x80\x75\x35\x8f\xdf\xbd\x87\x3f\xaa\x4b\x53\x5e\xbc\xd6\x1f\x4e\xcf\xce\xec\x8d\x17\x75\x05\xa5\x8f\xf4\x44\xa6\xc8\x9a\xe4\x73\x87\x86\x2e\x97\x28\x4f\x55\x3f\x67\xa7\xd1\x1e\x40\x87\xfa\xd2\x88\x37\xe8\xab\xcd\xda\xcc\xfb\xa4\x31\xb5\xb5\xda\xa0\x17\x7d\xc2\x7c\x85\xf8\x9d\x75\x13\xf4\x7c\x76\x14\x44\x9b\x14\xb0\x9d\x02\x37\x32\x03\x61\xba\xdb\xb1\x5e\xda\x68\xd8\xa6\x6c\xec\xa2\x66\xc3\x35\xe3\xe1\x90\x8b\x46\x15\x44\xee\x00\x57\x90\x74\x27\x30\xc4\x70\x2e\xae\x76\x84\xe6\x52\xbe\xae\xa4\xd3\xde\xbe\xbc\xad\x2e\x9e\x40\xbd\x1b\xbd\xd9\x7f\x95\x14\x65\x55\xa6\xb2\x09\xc8\xbb\xc1\x47\x2b\xd5\x07\xf6\xb7\xc4\xcb\xed\xfd\x2d\xf1\x72\xc7\xfe\x9a\x1d\x31\xce\x1b\x2a\xb4\x09\xd2\xbf\x2f\xfd\x81\x8a\x76\x03\xd8\xd0\x4b\xa0\xad\x37\x8c\xa1\x39\xa3\xa2\x6c\xaf\xa9\xad\x0f\x43\xab\x85\xee\xd9\xaf\xc1\x27\xcb\xdd\x04\x90\x33\xaf\x4a\x23\x5c\xa2\x26\x1c\x98\x33\x5a\x16\x30\xad\xf3\x48\x3f\xbf\x2a\x90\x2e\x01\xb0\xbe\x32\xd7\x61\x09\x43\x2b\xdb\x32\x23\xf9\x27\x40\x1c\x88\x00\x19\x5e\xf1\x6a\xdf\xd8\x9d\x31\x8b\x1b\xfd\xbd\x92\x8d\xa6\x10\x3d\x41\xb0\x60\x78\x36\x7d\xa4\x2e\x91\x72\x67\xe6\x5c\xdb\x91\x3a\x28\xa7\xbb\xda\x87\xe8\xd1\xd3\x28\xd8\x93\xd0\x6f\x3c\x6b\xfd\xed\x58\x7b\xc4\x4f\x46\xe8\x69\x54\x2f\x96\x74\x82\xa6\xdb\x29\xe1\x72\x14\xdd\xdd\xa7\x62\x7f\xab\x69\x0c\xed\xd2\x00\x1e\x7f\xdf\x30\x8d\x7e\xae\xab\x11\xe9\xe5\x34\x0d\x02\x3d\xa5\x1e\xea\x91\xde\x0e\xd9\xae\x8e\xe0\xc5\xf8\xdd\xe6\xac\x0c\x2c\x51\x01\x74\x06\x3a\x86\x51\xdb\x2b\x20\x68\x23\x28\xda\x16\x08\x39\xa4\xf7\x0e\x35\x3b\x02\xc8\x1d\x23\xd0\xdf\x2e\xd2\xc4\x59\x8c\x8a\x02\xe7\xa9\x73\xf8\x1c\x85\x9e\x24\x02\xa8\x8b\x5f\x32\xc4\x79\x2f\x62\x74\x05\x09\xcd\x86\x7c\x39\x3c\x78\xdc\x00\xd3\xe8\x24\x96\xc5\x77\x4f\x2b\x8f\xa5\xaa\x0a\x21\xaa\x1a\x44\x4a\xf1\x44\x85\x75\x5e\x70\xd9\xef\xfb\xc7\x04\x6a\xf1\xa5\x57\xdc\xe5\x28\xf4\x88\xaa\x36\x1a\xaf\xbd\xb6\xf2\xcb\x30\x45\xf9\xdc\x59\xe7\x07\x8d\xd8\x8c\xf6\xc7\x0d\x83\xed\x24\x48\x3e\xd4\x60\xb5\x11\x85\xc3\xf5\xa2\xe3\x40\x4c\x9a\x54\x7c\xdb\x1c\x8a\xd7\xd8\xe2\xbc\x57\x54\x5f\xdd\x0d\x00\x10\xd5\xa8\x8c\x26\xf5\x99\xb0\x46\x25\xf2\x7a\x8d\x26\xfe\x00\x2a\x08\x95\x27\x8e\x26\x40\xf4\x93\x3b\x2b\xce\xd2\xb3\x8d\x54\xe9\x90\x3d\xa2\x1f\xe3\x65\x21\xd6\xbd\x8a\x57\x38\x73\xfb\xae\x3b\x24\x80\xac\xc2\x79\x71\x5b\xe0\x44\xf0\xe0\x54\x44\x92\x51\x5e\x32\xcc\xd5\xed\x30\x28\xcb\x62\x38\x9a\x09\x6c\x8e\xcc\xe1\x5b\x9c\x94\x4a\x03\x49\x35\xf5\x97\x33\x60\x65\x2e\xcd\x14\x10\x2e\xf1\xcd\xc9\x0d\xce\x95\xb2\x67\x34\x83\x6b\x94\x7c\x82\x6b\x3c\xa3\x4c\x1f\x4b\x27\x79\x49\xf2\xb9\xba\x05\xf3\x5c\x5d\x3a\x6a\xb5\x99\x5e\xbc\x1c\x10\x5f\xe7\xc9\x82\xd1\x9c\x96\x3c\x5b\xfb\xda\x0e\x17\x2f\x54\xcf\xb8\x27\x3f\xf3\xea\x14\xfa\x1b\xaa\x5e\x72\x39\x30\x5a\xc4\x55\x1e\x1d\x17\x5b\x53\x0f\x2e\x51\x8f\x14\x0e\x55\x33\xa9\xc7\x87\x81\x08\x9b\xae\x57\xaf\xa6\xba\x97\x58\xdf\x65\xa1\xe4\x49\x3e\xe8\x55\xb7\x4b\x9c\x25\x0b\x9c\x96\x19\x36\xf7\x55\xdd\x0a\xf5\x5e\xe2\xe0\xfa\x62\x17\x5a\x8a\xa0\xc0\xbf\x65\x4c\x87\x70\x37\x80\x71\xed\x1e\xb8\x2c\x73\xb7\xf2\x70\x30\x7c\x2f\x5a\xaa\xe8\x15\x40\xaf\xbb\x06\xa9\x76\xd4\xdb\xe5\x00\x55\xe7\xae\xe8\x76\x4b\x81\xed\x2f\xbf\xb4\x95\x8a\x34\x6b\x2d\x35\xbf\x94\xc5\x6c\x39\xd7\xd0\x28\x35\x8e\x94\x99\x1b\xda\xdb\x47\x37\x94\x52\x1d\x7a\x1e\x97\x9d\xc4\xe3\x77\xef\xe3\xad\xa4\xef\x4e\x59\x78\x82\x3d\x4a\x8a\x72\xa8\xd2\x63\x43\x4d\xa4\xbd\x2b\x75\x47\x22\xdd\xc5\x57\xec\x63\x8e\xe6\x28\xeb\x4f\xe0\x14\x0f\xf5\x7d\x85\xea\x54\xc4\x2b\x8a\x52\x40\x6a\x91\xa9\xab\x5c\xb9\x40\xea\xc2\xc1\x46\xa9\xb6\x41\xb6\x69\x04\xa3\x11\xfc\x1f\xff\x74\xf4\x23\x49\x7d\x46\x51\xaa\xc9\x7e\xb4\x03\xd9\xa3\x51\x45\xf9\x4e\xbc\x0a\x4e\xf6\x7a\x0a\x5f\x31\xce\x1e\x0d\x7e\x28\xef\x76\xa2\xa0\x76\x8a\xb1\x4e\x83\xee\xba\x3a\x05\x79\x5f\x22\xac\x94\xe9\x32\x97\x2d\x82\x76\xdf\x55\xe0\xd7\x12\x9a\xfa\xbe\x87\xb1\xca\x52\x69\x76\x04\xb7\x90\x69\xf7\xd3\x76\xa6\x33\xa8\x2e\xb1\x3b\xa0\x43\xb5\x3d\xfc\x5b\x4c\x6a\xb8\x8d\x5b\xf5\xa7\x37\x64\x1e\xca\x1a\x57\xf8\xba\x85\x3b\x4d\xf7\xb2\x83\xe2\xed\xf6\xb6\x46\x55\x5d\x71\x95\x5c\xd0\xa5\xb9\xd1\x97\x6f\x51\x61\x0a\xf6\x6a\xa9\x61\x77\x9b\xb9\x39\x16\xba\x0b\xd3\x83\xbf\x34\xea\x1e\x4f\x95\x7b\xab\xbf\x08\x6f\x26\xf1\x30\x54\x1d\x1a\x9a\x5c\xb6\xce\xfd\x53\x2b\xb4\x8b\x04\xbd\x42\xd5\xdb\xa1\xc1\x51\xcd\x6d\xcd\x78\xf9\x5d\x1c\x7a\x28\xee\x5a\x67\xda\x3f\xc7\x53\x5d\x3d\xd3\x38\xc6\x03\x53\x19\x68\x89\xf0\x1c\x12\xaf\x57\xdd\x86\x66\xb3\xda\xaa\xef\x38\x68\x19\x6c\xd8\xcf\xa0\xf7\x80\xe3\x69\xdb\x26\xd5\x04\x2a\xba\xba\xfb\x98\x96\xd6\x03\xfe\x83\xd5\xb7\x7e\x0f\x43\x03\x37\x4c\x24\x60\xd4\x8f\x6f\x50\xd6\xf3\x38\xb8\xe9\x04\xde\x5e\x38\x4f\x6d\xd8\x03\x55\x15\xc0\xab\xe2\xed\x67\xeb\xe3\xa2\x6c\x3d\xf1\xea\x51\xdf\x6f\x9c\x68\xbc\xdb\xbb\x27\xff\xea\x85\xd1\x0f\x66\xa1\xd1\xc0\x0f\xe1\xe2\x86\x53\x6d\x21\x23\xbb\xfa\xd8\xca\x4b\xdd\xc3\x43\xd8\x69\x58\xda\xe2\x73\x86\x07\xa7\x51\xae\xfb\xaa\x9f\x8e\xe6\x2a\x59\xa1\x6f\xe1\x3f\x7e\xf7\x7e\xa0\xab\x30\x91\x80\x25\xe5\x02\x22\xcd\x15\xc0\xb9\x60\x24\x4c\x53\x6c\x14\x02\xd5\x4c\x4f\x4a\x73\x39\xc9\x0e\xdd\xd4\xa1\x01\x5c\xfb\xcb\x0a\xc5\xe6\xe2\x13\x1e\x4b\xaf\xf4\x29\x5c\x07\x0f\x1a\x15\x97\xba\xc6\x06\xe0\x0e\x70\xc6\x71\x1b\x8a\x27\xdb\x50\x84\x18\x6a\x2f\x13\xba\x2c\x10\xc3\xcf\xd6\x52\x47\x6a\x6a\x3d\xde\xfb\xf7\xa6\xb7\x8c\xd4\x1e\x73\x50\xdb\xd6\x4b\x92\x77\x2a\x17\xcb\xb2\xaa\xc6\x57\x31\x29\xe8\xfb\x21\x33\xaa\x05\xb2\x7d\x52\xd5\xa5\x81\x5d\xf3\xda\x2d\x90\x5f\x66\x6a\x4d\xa1\x7d\x30\xbb\xa1\x83\xb5\xe3\x04\x1b\x44\x4f\x76\x40\xf4\x9f\x39\xcd\x12\xc2\x50\x47\x04\x65\x70\x8d\xb8\xfe\x3d\x0b\xd3\x07\xa3\x59\x86\x59\xbd\x52\x3a\x1c\x0e\x2f\xaf\x8f\x94\xb9\x7b\xe6\x55\xc6\x95\xd7\x47\xba\xa8\xf9\xa9\x7a\xa3\xaf\x06\x08\x0f\xb9\x2a\x8e\x79\x7c\x77\x6d\x9e\x74\xb6\x19\xfa\x8d\x82\x37\xe3\x43\xef\x5c\xb9\x15\x62\x9b\x91\x94\xc1\xaf\x9d\x40\xfb\x3d\x38\x75\xac\xb3\x89\x9d\xb7\x30\x98\x1b\x9f\x78\xc0\x7a\xaf\x1c\xa6\x28\xcf\xca\xa5\xbf\xb3\xaf\x85\xc4\x7b\xe8\x37\x34\xb9\xcb\xc6\xf9\x7d\xf9\xb8\x2a\x8b\xd3\x28\xf7\x75\xea\xb3\xfd\x1c\xa8\xeb\xc4\x82\xed\x72\x66\x38\x38\xaa\x9f\x14\xe5\xc4\xf6\x35\x6a\x23\xd2\x48\x96\xd7\xdf\xc4\xeb\x77\x4b\x93\xc6\x74\xa8\x3b\x5c\xe9\x0c\xc8\x72\x89\x53\x82\x44\x38\x0b\x7c\x60\xee\x77\x95\x31\xac\x76\xdc\xaa\x59\xf3\xe6\xe6\xde\xbe\x97\x3b\xff\x5d\x73\x91\x03\xbd\xf6\xcb\x2f\x66\xd9\x6c\x00\x0a\xee\x84\x75\x2d\xbe\x6a\xe9\xcf\xfd\x96\xc8\x65\x50\xdf\xe4\x83\x56\x3f\x8a\xa0\x32\x76\x9b\xfa\x6d\xca\x4a\x43\x10\xa1\x89\xfa\x22\x98\x1d\xb9\x9c\x2e\x6b\x97\x63\xa8\x87\x87\xee\x46\xc3\x2e\x7f\x57\x0f\xe2\x01\x34\xd5\x7d\xde\x4e\xba\xbe\x6a\xbf\xf7\x29\x80\xac\x96\x70\xe3\xd0\x7d\xfb\x02\x3d\x6c\x41\xa2\x15\x66\xe3\x0e\x93\x9a\x3e\xde\xa0\x90\xc3\x7a\x04\x5e\xd5\xd2\xf6\x5a\xce\x6e\x48\xd7\xd0\xc6\xa0\x1c\x67\xfa\xb0\x64\xed\xbc\x8d\xc9\xc8\xda\xaf\x5e\xae\x9b\x17\x28\x77\xa9\xfd\xaa\x56\x77\x02\x51\xbf\x0d\xfc\xba\x82\x0d\x29\x51\x43\xdb\x5a\xd1\x0b\xb5\xc3\x27\x36\x43\x59\xed\x2d\xe5\x78\x65\xd5\xa4\x64\xce\x8c\x61\xbe\xd0\x77\x7f\x56\x46\x45\xdf\x95\xcb\x0d\xb8\x2e\x86\xae\x86\x0d\x29\xa3\x45\xed\x36\x29\xb5\x1d\x65\xf9\x57\x41\xda\xf4\x76\xd7\x61\xc8\x8d\x67\x1d\xab\x22\xeb\xce\x05\xed\x6f\x1c\xd5\xd6\x71\xdb\xd9\xb3\xd6\xe2\xe6\x8d\xc8\xdb\x9b\xec\x90\x57\xee\x98\xa4\x4a\x43\xec\x32\x89\x51\x74\xe8\xdf\x5d\x97\x65\xde\x59\x24\x7b\xd4\xd0\xcd\x44\xb8\x65\xa6\x36\x0b\xea\xd3\xd0\x5d\x82\x79\xcf\x91\x37\xf6\xcc\x02\x79\xeb\x38\xf9\xd7\x8a\xd0\x9e\x98\xaa\xef\xcf\xc8\x55\x90\x91\xfa\x06\x93\x5d\x29\x42\xb0\x5e\xc4\x68\xa6\x4a\x66\x0a\x86\x39\xce\xf5\x2f\x61\x75\xc0\x3b\x9c\xa8\x1d\xe5\x06\xf4\x4b\x9c\x97\x44\xe0\xe5\xae\xed\x04\xba\xd6\x9b\x38\x03\x18\x1e\x6c\x6d\x93\x64\x24\x91\xeb\xc5\x2e\x9d\x58\x36\x56\x97\xfc\xd4\xaa\xe3\xfb\x5b\x51\xb5\xe9\x8b\x6a\x8b\xda\x53\x6e\xbb\xcf\xcd\xb8\xba\x07\xca\x1a\x7f\xa5\x2a\xb4\xcf\xa5\xae\x16\x6e\xdf\x8d\x08\x55\x4a\xe5\x5b\x79\x59\xb1\x96\x22\x5a\xef\xad\x24\xba\xba\x8e\xd8\x0c\xfb\xd7\xe5\xaa\x9a\xb7\xe6\x76\x7a\x17\xfa\x9f\x54\x4e\x8d\x0d\x41\xc2\xb8\x38\x2d\xf3\x30\xc1\xd5\x05\x05\x53\x7b\xb5\xbb\x07\x7c\xaf\x8c\xa3\xfd\xd7\xba\xd3\xdf\x90\x86\xc8\x21\xd0\x79\x84\x5d\xb2\xa3\xfa\xdf\xdd\x6e\x14\xd6\x32\xc6\x01\x79\x56\xb8\xa2\x86\x8d\xac\x27\xf2\x1e\xd2\x73\x7b\xe2\x33\x20\x20\xcc\x30\xee\x94\x52\xec\xa0\xe4\x2e\xcc\x8c\xdd\x77\x57\xcd\x4f\xbd\xf8\xee\x05\x4a\xd3\xa3\x2c\x53\x57\xe0\x37\x9c\xdc\x46\xf6\x54\xfd\xf2\x86\x7b\xb8\xbd\x86\x5a\x47\x2a\xb2\xc1\x59\xa1\xce\x94\xb4\x70\x32\xe4\x62\x60\x0b\x82\x45\x03\x24\x6f\x52\x64\x0f\x3f\xdb\x30\x6b\xea\x83\x5c\x04\xed\x2f\xbd\x0a\x63\xff\xda\xd4\x8a\xbc\xf6\x4b\x40\xf4\x7b\x63\x40\x1c\x70\x68\x21\x2c\x98\xe2\xe3\xdf\xd4\x4f\x37\x55\x7d\x5f\x38\x0c\xd5\xad\x21\x6a\x35\x2a\x60\x98\x9a\x1f\x54\x30\x2f\xec\xd0\xf5\x2f\x1f\x54\x43\xb6\x88\xeb\xca\x40\x83\x4d\xa5\x55\x0e\xe5\x4f\x1a\xdb\xeb\x52\x08\x9a\x0f\xa5\xcd\x75\x34\xf4\xe3\x05\x49\xb1\x9f\x23\xbc\x73\xaa\xc0\x9a\x68\x6f\xd0\xfb\x10\x5d\xa9\x5e\x78\x47\xd1\x44\x63\xc9\x6f\x32\x92\xf7\x37\x93\xf7\x36\x94\x0f\x37\x95\xbe\xe1\x53\x0c\xf7\xcd\x9e\x63\xc9\x40\x4f\xce\x56\xd3\x67\x8d\x9f\x81\xf6\x38\x2e\x67\xce\x89\x40\x38\x71\x81\x68\x78\xbf\xc2\xa1\xff\x59\xca\x7a\x4d\x72\x0e\x1b\xaa\xc2\xff\x3d\x2f\x7f\xcd\xcf\xb1\xd0\x1a\x49\x1f\xca\xf3\x64\xc3\x1d\x37\xf7\x56\x48\xc3\x24\x3a\x89\x7c\x87\x08\xab\x96\xcd\xfe\x3e\xf1\x83\xb2\x2d\xcd\xec\xaf\xaa\x4d\x21\xe8\xdf\x9d\x44\xef\x38\x85\xee\x07\x46\x3e\x2b\x2c\x1b\xaa\xb0\xc8\xc9\xb1\x94\xe1\x5f\x1d\x1d\xa9\xbe\xb6\x47\x46\x66\xb2\x75\xc5\x82\x3b\x0e\xd9\xe4\x77\xb5\x61\x63\xa0\xa6\xd2\x2f\x33\xec\xdb\xc6\x71\x7d\x63\x52\x30\x6e\x73\x53\x92\x9f\xb7\xda\x3a\x01\xea\x38\xd9\x85\xba\x98\x51\xe1\xa8\xc2\x84\x96\xe0\x2b\x48\x5c\x29\x56\xe2\xb4\x2e\x89\xc6\x48\x6c\x1a\xf5\x1b\x7b\x81\x67\x73\xe4\x76\x9e\xa3\xe8\xd0\x9f\xf6\x9d\x47\x51\x93\x8e\xa6\xf5\xf5\xaa\x10\x3b\x2e\x8a\xbb\xbf\xa9\xf2\x5a\x29\xcf\xb3\x35\xc6\xe8\x68\xd7\xa8\x23\x0c\x2c\xac\x67\x91\xda\xd6\x20\xba\x0d\x06\xd7\x29\x39\x35\xb8\xc0\x86\x79\x97\x67\xed\xb0\xea\x6b\x98\x1e\x66\x26\xf5\xee\xd6\xcd\x39\xbe\xf5\x0b\x01\x01\x14\x13\x20\xc9\x10\xe7\xd3\x0f\x91\x0d\x1f\x3f\x44\x4f\xe1\x89\xb6\x62\xd5\xbb\x6b\x91\xc3\xb5\xc8\x87\xf6\x67\x80\x6b\x97\x7f\xd8\xa6\x43\x41\xe7\xf3\x0c\x7f\x88\x40\xac\x0b\x2c\xdb\x29\x34\x1f\x22\x20\x69\xf5\xad\x66\x1a\x2d\x91\x96\xc0\xfd\x80\xc2\x0f\x91\xaa\x71\x34\x88\x03\x2a\x01\x31\x82\x86\x0b\xc4\x0b\x5a\x94\xc5\xf4\x43\x24\x4d\xfa\x87\xa8\x4e\x9b\x82\xc2\xb7\x05\xca\x53\x2c\x89\x50\xda\xfd\x43\xe4\x2a\x80\x5d\xc7\xa0\xd5\x8f\x26\xb6\x6e\x91\x7d\xa4\x35\xbd\xf6\x21\x7a\xfa\x64\xa4\x14\x17\x68\x04\x96\x6d\x09\x62\x38\x78\x3b\xd2\x2c\xe8\xe8\xbc\xcc\xb6\x77\x6d\xdc\x82\x0f\x51\x63\xde\x86\xd2\xe4\x7e\x88\x40\x5a\xe0\xe9\x87\x48\x7f\x6b\xe5\x86\x42\x91\xe1\xf4\x7a\xdd\x35\x29\x52\x79\x2b\x39\x18\x95\x99\xfc\x5f\x2d\x96\x56\x9a\xa5\x04\x55\x44\xbb\x1f\x1a\x94\xca\xbf\x0b\x65\x80\xcc\x0f\xf3\x0d\xe2\x7e\xed\x16\xda\x30\x13\xa0\x9b\x1b\x65\xdf\x52\x0a\xe9\x97\x40\xd6\x54\x68\xa8\x99\xbe\xc4\x8f\xfe\xa0\xa2\x30\xfa\x65\xf4\x6f\xf3\xeb\x3f\x61\x21\xe0\x4e\xca\xf8\xbf\x24\xea\xf8\x6d\xb4\xab\x7e\xf3\x3e\xd7\x27\x20\x43\x38\xf5\xf3\xe4\xbe\x1a\x6e\x5e\x4c\xd0\x12\xa8\xdc\x2f\xac\xe9\x4c\x4f\xe8\xb1\x1d\xd3\xcc\xe8\x2a\xdf\xe5\xdd\xa9\x41\xd3\x17\xde\x35\x70\x3d\xdc\x0b\x9d\xe2\xc6\xa1\x2d\x50\x6e\xb5\xe7\xd1\x98\xe4\x7f\x97\x93\x13\xb2\x46\x73\x25\x64\xd3\x45\x80\xee\xb2\x11\xdb\x99\xfb\xbb\x73\x87\xc2\xe7\x87\xf1\x59\xa7\xee\xad\xf1\x71\xdc\xd8\x3b\x0e\x8d\x05\x9d\xc8\x18\x88\x5c\xab\x92\x61\xdb\x51\x18\x68\xe8\xdf\xd1\xb3\xdd\x5c\x54\xf0\x97\x7e\xbc\x11\x9e\x49\x51\x4d\x5a\x02\x0d\x3d\xf3\x15\x6c\xe5\xb5\x3c\x0e\x7b\x0c\x91\x8d\x5b\x11\x75\x5e\x04\xee\x80\x76\xba\xbb\xa0\x45\xed\x0f\xfc\xf5\x11\x28\x76\x5b\x73\xae\x0a\x50\x52\x58\x2d\xb0\x4b\x20\xc2\x8c\xe4\x84\x2f\x30\x57\x3f\xf5\xa3\xca\xc4\x43\x27\xf3\x1d\x9a\x7b\x9b\x04\xba\xa3\x05\xe2\xc7\x45\xa9\xfe\xbe\x36\x35\x0c\x95\x8e\xf7\xcf\x37\x8d\x46\xf0\x5c\xfd\xbe\xf2\x0c\x8b\x64\xa1\xe5\x52\xff\xce\xb1\xfe\xd9\xe5\x05\xba\xc1\xea\xe7\x96\xdd\x0f\x50\xba\x1d\x8a\x63\xf7\x6b\x16\xaf\x83\x92\x81\x8e\xa4\x7f\x78\x90\xbd\xb6\xec\x3a\xb7\x00\xbc\xc4\xa1\x4d\x54\x74\x24\x3d\x61\x6a\x07\xd9\x06\x14\x6a\xd5\x69\xa8\x65\x0f\xdb\x36\x27\xba\x14\x41\x17\x19\xb5\xc8\xa0\x5a\x18\x3b\xf8\xc4\x07\xe3\x60\x37\xca\x1c\x8a\x0a\x2e\x1a\x45\x42\x4f\xb7\xaa\xc6\x37\x01\x12\xe0\x1b\xcc\xd6\xf0\xc3\x58\x6d\x59\xed\xf8\x4b\x7e\x95\x39\x6d\x1c\x25\x86\xfb\x1c\x3e\x76\xfb\xed\xdc\xbb\xa7\xa5\x51\x52\xf6\x05\x88\xba\x37\x59\x55\xd1\xe3\x00\x7e\x18\xeb\x73\xe0\xee\x4c\x83\xff\xab\x8c\x03\xb9\xca\x94\x3f\xe4\x25\xef\x35\x4f\x0f\x2c\x4b\x5b\x7f\xab\xd1\x15\x78\xba\xb7\xdd\x21\x90\x9f\xdf\xaf\xe5\xf3\xbb\xb9\x57\x0f\x82\xe5\x68\xaa\x43\xed\xda\xbf\xf9\x7f\x01\x00\x00\xff\xff\x09\xd4\x28\x41\x2d\x87\x00\x00")
+var _cmdInternalPagesAssetsJsContainersJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x3d\x6b\x73\xdc\xc6\x91\x9f\x8f\xbf\x62\x24\xdb\xc1\xee\x71\x17\x5c\xca\xb2\xef\xb2\x2b\xb2\x8a\xa2\x24\x87\x89\x5e\x45\x52\x49\xa5\x68\x16\x0b\x5c\x0c\xb9\x90\xb0\x00\x82\x07\x1f\xb1\xf9\xdf\xaf\xbb\xe7\x8d\xc7\x2e\x48\xd3\x4e\x72\x49\xee\x12\x71\x81\x9e\x9e\x9e\x9e\x9e\x7e\x4d\xcf\x60\x6b\x8b\xed\xa7\xd9\x6d\x1e\x5d\x2e\x4a\xf6\x6c\xb2\xfd\x9c\xfd\x90\xa6\x97\x31\x67\x07\xc9\xdc\x67\x7b\x71\xcc\x0e\xf1\x55\xc1\x0e\x79\xc1\xf3\x2b\x1e\xfa\x1b\x5b\x5b\xf0\xff\xec\x6d\x34\xe7\x49\xc1\x43\x56\x25\x21\xcf\x59\xb9\xe0\x6c\x2f\x0b\xe6\xf0\x8f\x7c\x33\x62\x7f\xe6\x79\x11\xa5\x09\x7b\xe6\x4f\xd8\x00\x01\x9e\xca\x57\x4f\x87\x33\x44\x71\x9b\x56\x6c\x19\xdc\xb2\x24\x2d\x59\x55\x70\xc0\x11\x15\xec\x22\x82\xce\xf9\xcd\x9c\x67\x25\x8b\x12\x36\x4f\x97\x59\x1c\x05\xc9\x9c\xb3\xeb\xa8\x5c\x50\x3f\x12\x0b\x52\xc2\xfe\x2a\x71\xa4\xe7\x65\x00\xe0\x01\x34\xc8\xe0\xd7\x85\x0d\xc8\x82\x52\x12\x8d\xff\x59\x94\x65\x36\xdd\xda\xba\xbe\xbe\xf6\x03\x22\xd8\x4f\xf3\xcb\xad\x58\x80\x16\x5b\x6f\x0f\xf6\x5f\xbf\x3f\x7a\x3d\x06\xa2\x65\xa3\x4f\x49\xcc\x8b\x82\xe5\xfc\x6f\x55\x94\xc3\x80\xcf\x6f\x59\x90\x01\x51\xf3\xe0\x1c\x48\x8d\x83\x6b\x96\xe6\x2c\xb8\xcc\x39\xbc\x2b\x53\x24\xfa\x3a\x8f\xca\x28\xb9\x1c\xb1\x22\xbd\x28\xaf\x83\x9c\x23\x9a\x30\x2a\xca\x3c\x3a\xaf\x4a\x87\x67\x8a\x44\x18\xb9\x0d\x00\x5c\x0b\x12\xf6\x74\xef\x88\x1d\x1c\x3d\x65\x2f\xf7\x8e\x0e\x8e\x46\x88\xe4\x2f\x07\xc7\x7f\xf8\xf0\xe9\x98\xfd\x65\xef\xf0\x70\xef\xfd\xf1\xc1\xeb\x23\xf6\xe1\x90\xed\x7f\x78\xff\xea\xe0\xf8\xe0\xc3\x7b\xf8\xf5\x86\xed\xbd\xff\x2b\xfb\xd3\xc1\xfb\x57\x23\xc6\x81\x63\xd0\x0f\xbf\xc9\x72\x1c\x01\x90\x19\x21\x37\xc5\x24\xb2\x23\xce\x1d\x12\x2e\x52\x41\x52\x91\xf1\x79\x74\x11\xcd\x61\x68\xc9\x65\x15\x5c\x72\x76\x99\x5e\xf1\x3c\x81\x11\xb1\x8c\xe7\xcb\xa8\xc0\x59\x2d\x80\xc0\x10\xd1\xc4\xd1\x32\x2a\x83\x92\x1e\x35\xc6\xe5\x6f\x6c\x5c\x92\x3c\xf9\xf3\x45\x90\x97\x85\x1f\xa7\x41\x38\xf0\xe6\x55\x9e\xf3\xa4\xf4\x46\xec\x27\x98\x82\x2f\xd0\x47\x31\x65\x27\xde\x3c\xcd\x39\xc1\xc1\x0b\xef\x32\xa8\x2e\x39\xfe\x11\xf2\x8b\xa0\x8a\xe9\x19\x90\xb8\x0c\xe8\xaf\x2a\xc2\xff\x2d\x71\x0a\xbc\xd3\x3b\x90\xa7\x8d\x8b\x2a\x99\x23\x15\x6c\x51\x2d\x83\x24\xfa\x3b\x1f\x24\xd5\x12\xa6\x00\xfe\x1a\x01\x61\x51\x59\x0c\xd9\x4f\x1b\x8c\x5d\x05\x39\xfd\x9c\xc1\xdf\x38\xe4\x01\xfe\x60\x3b\x02\xc4\xcf\xd2\x6c\x30\x9c\xc9\x1f\x31\x4f\x2e\x41\xe6\x7e\xf7\x3b\x06\xa8\xd8\xee\x0e\x21\x13\x2f\xdd\x06\x02\x33\x23\xb0\x2d\x09\x06\x0f\xee\xe0\xbf\x39\x2f\xab\x3c\x61\x27\x44\x0c\x36\x39\x9d\x6d\xdc\x6d\x20\xe3\xde\xa4\x71\x9c\x5e\x23\x57\x91\x61\x07\xaf\xf7\x59\x12\x2c\xf1\xe7\x3c\x4d\xae\x80\x3b\x30\x96\xe6\xa0\x00\x0c\xc7\x65\x86\x02\xf8\x81\x16\x77\xcc\xdb\x93\x67\xcf\x47\xc0\xcf\xe3\xe8\x25\x72\xe9\x07\xf1\xcf\x3b\xf1\xcf\x9f\xc4\x3f\x2f\xbd\xd3\xe1\xcc\xd0\x07\xff\x9c\x4c\x4e\xfd\x32\x7d\x13\xdd\xf0\x70\xf0\x6c\xc8\x36\x99\x07\xff\xb7\x49\x6f\xb6\x89\xe8\x06\xcd\xef\x38\x08\xec\xbc\x85\xec\x26\xdd\x02\xb4\x0f\xe9\x93\x09\x91\x2e\x28\x17\x84\x0b\xba\x05\xd9\xb7\x25\x2f\xee\x4f\xba\xa0\x1d\x85\x07\xe4\x16\xa7\xe9\x1c\xe4\x14\xb4\x44\xc1\x81\xea\xb0\xc0\x45\x8b\x03\x8a\x83\x1c\x44\xb1\x14\x33\x0c\xff\xbf\x08\x00\xbc\x64\x31\x0f\xe0\x61\x9a\x70\x68\xe2\x37\xc7\xf6\xaa\xca\x49\xfe\x07\x12\x9b\x2b\x67\x05\x8c\xf1\xe4\xc4\x0b\x81\xfa\xff\xfd\xfe\xf9\x64\x72\x8a\xc3\x5b\xc0\xaf\x6f\xbf\x97\x3f\x96\xf0\xe3\xfb\xc9\xe9\xa9\x96\x48\x6c\x1a\x41\xb3\xc9\x0c\xfe\x79\xe1\x08\x23\x3c\xd9\xdc\x54\xe2\x16\x5d\x30\xd5\x27\x4a\x27\xc1\x9d\x44\xa7\x30\x66\x05\xa1\x99\xa4\xe1\xb6\x1c\x30\xcd\xb5\x6d\xe4\x9a\x7e\x33\x21\x5a\x84\x00\x5b\x42\x2c\x71\xe8\x46\x13\x62\x75\xe1\x29\x0e\xbf\xca\x41\x19\x06\x8c\x56\xa5\xc5\xa7\x10\x1e\x1f\xe3\xb3\x01\x21\x05\x3b\x12\xf1\xe2\x38\x2a\x41\xad\x8e\x58\x89\xff\x1e\xdf\x66\xf8\x77\x18\x94\x01\x68\xad\x98\x2f\x41\x8e\x0e\xc2\x11\x4e\xd4\x47\x54\x0e\xa8\x49\xf3\xf2\x00\xb4\xcb\x8d\x61\x2e\x42\x13\x5a\xe0\x54\xc2\xaf\x99\x54\x34\x57\x51\x51\x05\x71\xf4\x77\x9a\x12\xff\x95\x02\x1a\x0c\xbb\xd8\x6b\xd3\xd3\xc6\x65\xdd\x8f\x1f\x84\xe1\x7e\x1a\x57\xcb\x64\x60\xa8\x06\x76\x8d\x1c\x14\xf0\x60\xa8\x16\xbf\xd3\xf4\x30\xbd\x2e\x06\xf8\x84\x5e\xe3\xdc\x3d\x19\xe8\xb1\x92\xd9\x88\x92\x30\xbd\x96\x9a\x52\xeb\x14\xe7\xe9\x89\x6e\x70\xca\x76\xe4\x0c\xb3\xee\xd1\x8b\x91\x87\xe9\xbc\xc2\x46\xfe\x25\x2f\x5f\x8b\xf6\x2f\x6f\x0f\x42\xd3\xf9\x50\x12\x2c\x19\x3b\x2f\x8a\xfd\x38\x28\x8a\xf7\xc1\x92\xa3\xf4\x0a\x3a\xbc\x05\x0f\x40\xbb\xc3\x28\xbc\x29\xf3\xbc\x91\x78\x48\x73\x2d\x9f\xd1\xdf\xe3\x1c\x7e\xc8\x97\x69\x18\x1e\xb7\xbe\xc7\xde\x66\xb2\xb7\x34\x2b\x4d\x27\x41\x5c\x82\xa5\x09\xd0\x7a\x42\xa3\xa3\xf2\x36\xe6\x53\x56\xe6\x15\x17\x18\x33\x10\x06\x40\xc4\x13\xd2\xfb\xe6\xd9\x11\xac\xc2\xa9\x91\x16\x89\x0a\x34\xd5\x1f\xca\x65\x6c\x23\x40\x31\x12\x53\x38\x35\x22\x65\x5e\xed\x15\x60\xb5\x42\xe8\x7c\xca\x2e\x82\xb8\x90\x8d\x1c\x7e\x4c\xdd\x9f\x6a\x24\x5d\xb3\xe4\xa3\xf0\x0f\xb4\x1c\x8c\x68\xb8\xc3\xda\x82\x89\x23\x50\x2d\xd4\xb4\xb6\x6a\xde\xc2\x8b\x7d\x7c\x3e\x70\x17\x4d\x63\xa1\xe0\xca\x35\x2b\x03\xd4\x31\x70\xf4\x20\xb9\x88\xe0\xf1\xad\x62\xf4\x32\xb8\x81\xa7\x63\xfb\x71\xdb\x72\x40\xdc\x6d\xcb\x60\x0b\x3d\x45\xd0\xf0\x79\x49\xaa\xf2\x22\xca\x41\x27\xce\x89\x97\xe8\xf6\x04\x0c\x96\x1a\xac\x7a\xa5\x97\x10\x0d\x2c\x05\xf6\x04\x16\x67\x15\xc7\x46\x21\xc9\x17\xa0\x63\xe4\xba\xc5\x76\x03\xf3\x74\xa8\x54\x8f\xea\xf5\x0d\xb0\x16\x87\x34\xc2\x11\x88\x0e\x34\xdd\x9f\x01\xc7\xf6\x0c\xfe\x79\xa1\xf0\x6a\xd2\x3f\x1b\xd2\xc5\xf8\xaf\x82\x18\xa0\x55\x47\x9f\xa5\x8a\x13\xd4\xe2\xbb\x17\xd8\x89\x69\xc2\x24\x1b\xe1\x95\x82\xbc\xab\xb5\xd8\x45\x8a\x9c\x16\xc4\xe2\x46\x8b\x3b\xb5\xba\xd0\x83\xe3\x2c\x4c\x13\xaf\x64\xd7\x41\x52\x22\xe3\x8a\x45\x0a\x32\x90\xdc\x62\xb3\x0a\xd6\x1b\x39\x9b\xe5\x02\xdc\xbf\x09\xc8\x24\x9b\x07\x19\xf1\x1b\x89\x21\x08\x34\x48\xf0\xba\xf4\x05\xbe\x3d\x31\x1d\x05\x08\x23\xe8\xd2\x25\x48\x18\x21\xdc\x9e\x7c\xa3\xbc\xe0\xcb\x3c\xc8\x16\xec\x9c\xc3\x72\xa8\x61\x82\x71\x5c\x83\xe0\x05\x89\x6f\x04\xe7\x2f\x24\xc8\x30\x0a\x04\x1b\xb3\x01\x0e\x69\x2c\x38\xb3\x05\x58\x95\xea\x32\x90\x2f\xd8\x44\xb1\xc0\x6e\x3e\x99\x59\x83\xde\x0b\x43\xea\x1a\x5c\x39\x94\x3d\x14\x6f\x20\x8e\x83\xf7\xad\x24\x08\x9c\x49\x82\x48\xf8\x1c\xc6\x1f\xe4\xb7\x42\x0e\x7f\x81\xaa\x6f\x53\xdb\x1e\x3c\xe4\xc8\x25\xaf\xa6\xb3\xa5\xd8\x39\xeb\x61\xfb\xe1\xe6\xc1\x13\x1e\x86\xf7\x00\xcb\x20\x18\xb6\x9f\x73\xa0\x94\xb8\x82\x7a\x80\x58\xe3\x8e\xf6\xb7\x32\x21\x46\x05\xdd\xc7\x8c\xc0\x10\x8e\x3f\xbc\xfa\x30\xb8\x5a\x06\xf9\x32\x8d\x87\x53\xf6\x36\x4d\xbf\x00\x85\x20\xf0\x40\x46\x72\xa9\x5c\xc8\xab\x08\x3a\x16\xf4\xe1\x62\x00\xd4\xa0\x49\x8a\x65\x9a\x62\xe4\x22\x10\x81\x8f\xb5\xd4\x63\x6e\x58\x0c\x08\x25\xae\xc8\x12\x83\x5d\x50\xba\x53\x5a\x86\x05\xc7\xd0\x75\xca\xbe\x05\x97\x92\x1e\xc4\xfc\x12\xd4\xfb\x14\x82\x8e\xb4\x20\x29\x84\x36\x09\xb8\x75\xde\xdd\x48\xaa\x95\x79\x55\x1c\xa3\x0f\x08\xad\xbc\x39\xcc\xc0\x65\x9a\xdf\x4a\x6c\x57\x7b\x37\x11\x68\x7f\xb5\xd8\xc9\x03\x98\x92\xe2\x1d\x29\x25\x03\x63\x11\xf2\x3f\x75\xb5\xc8\xd4\xac\x8c\x91\xab\x18\x6a\x74\xc9\x97\x16\x79\xe7\x69\x59\xa6\x4b\xcf\xa8\x91\x99\x60\xca\x81\x58\xdb\xd7\x8b\x14\x16\x05\x8a\x8e\x94\x34\x72\x59\xb5\x42\xa0\x65\x0e\x3e\x16\x2c\x27\x64\x3c\xcc\x12\xb8\xbd\x51\xa9\xdc\x5d\x69\x72\xf4\x8a\x66\x3b\x3b\xb6\x46\x43\x3e\xfb\x34\x6c\xdf\x0c\xcd\x17\x3a\x6e\xdb\xdf\x66\xff\x8d\xc0\xb3\x55\xa0\xa4\x40\x27\xfe\xef\x0d\x28\x49\xc7\xc3\x8c\xe5\x0f\xbc\x14\x43\x93\x61\x99\x54\x6f\x11\x0e\x0a\xb5\x31\x74\x96\x04\x49\xaa\x9c\x54\x63\x49\x61\x3a\x0f\x24\xd0\x40\x46\x9e\x23\x06\x21\xf1\x55\x94\x56\x96\xb3\x0e\xaf\x6c\x8b\x24\x21\x87\xca\x7c\x62\x03\xfb\xbd\x46\xa0\xd6\xec\xb2\x60\xe3\x5d\x96\x14\xbe\x71\x98\x11\x09\x2e\x97\x63\x50\x39\x83\x21\xe8\x51\x6c\x64\x1e\x0c\x81\x2f\x18\xf0\xc0\x7f\xd4\x20\xf7\x17\x7c\xfe\xa5\xc0\x09\xb1\x42\x71\x1e\xb2\xa2\xc4\xd0\x25\x4a\xe6\x71\x15\xf2\xda\x3b\x08\xee\xd3\x2a\x9f\xdb\x2e\x37\x48\xc1\xa1\x7c\x3a\xa0\xa6\x23\x0d\x25\x06\xac\x3c\x7a\x7c\xe7\x8b\xff\x95\x6c\xdd\x05\xbb\x03\x11\xaf\xf5\x06\xb4\xe3\x89\x6a\x7d\xda\x24\x14\x1c\x2d\x8c\xfd\x30\xff\xc2\x73\x0a\xa5\xb2\x3c\xbd\x8a\x42\xa0\x2c\x8e\x8a\xf2\x41\x44\x43\xac\xb6\x17\xc3\x6c\x29\xb4\xe0\xb4\xa4\x8d\x31\xa0\xd4\xba\x10\x6a\x0c\x3b\x20\xc8\xda\x2a\xc9\xa1\x92\x43\xa7\xd4\x6f\x9b\xe3\xd3\x8a\xaa\x11\x6f\x3d\xb1\x59\xeb\x36\xa1\x50\x40\x93\xd8\x08\xc1\x34\x01\x8d\xb8\x0a\xfd\xd3\x9a\x4b\x58\x80\x22\x04\x01\xa7\x24\x48\xe1\xa3\xc4\x05\x98\x23\xc2\x74\x58\x1e\x61\xda\x0d\x5e\x82\x8b\x10\xe4\x79\x40\xe9\x2e\xfa\xa3\x90\x39\xb2\xeb\x14\x31\xc9\x75\x55\x4c\xf1\x07\x60\x84\x00\x1c\x94\x6e\x1c\x80\x27\x40\x86\x85\xe2\x60\x8e\x01\xbc\xf0\x06\x54\xfe\x87\xfa\xac\x39\xa2\x3f\x10\x1d\x03\xcb\xe3\x14\x94\x89\x41\x4a\x2a\xab\xa4\x58\x44\x17\xe5\xe0\xc4\x7b\x8b\x9d\x60\xb8\xfe\x67\xc4\x4c\xe1\x7a\xc3\xae\x65\x69\x56\xc5\xf8\x83\x1c\x03\x1c\x9f\x8c\x1b\x8d\xc9\x87\xb9\x69\xb5\x49\x34\xd8\xe3\xd4\x18\x7c\x49\xcc\xbd\xac\xa7\xb4\x24\x94\xb7\x52\xc6\x44\x59\x8c\x6d\x65\x31\x72\x1e\xbe\xc9\xd3\xe5\x94\xfd\xde\x3c\x38\x4e\x2d\x80\x5b\x8e\x61\x86\x80\xf9\x9f\xef\xec\x67\x08\xa6\x5a\x81\x22\x4c\xf3\xe3\x08\x16\xcc\x94\x49\x20\x6d\xd5\x40\xed\x87\x32\x81\x80\x0d\x26\xe0\xd7\x07\x05\x85\x20\x1e\xc6\x05\x41\xee\xdd\xd9\xe1\x12\x29\x4d\x6d\xb8\x3b\xcd\x36\x4d\x58\x5f\x93\x2d\xec\x80\x56\xbe\x23\xc5\x17\x5b\xf5\x0a\xd7\x11\x3c\x36\x8c\x55\x22\x10\x77\x57\xc1\xbe\x13\x6f\x70\x1d\x0c\x72\xb0\xde\xaf\xa2\x7c\x04\x9e\x65\x1c\x9f\x07\xf3\x2f\x42\x4a\xbe\x46\x2a\xfe\x78\xf4\xe1\xbd\x02\xc0\xe4\x41\x90\x45\x5b\x57\xdb\xfe\x64\x4b\xa2\x06\xa1\x51\x68\x85\x47\xc4\x7e\xd2\x68\xa4\x8b\xc4\xee\x1c\xba\xb2\xa2\x85\x9c\x8f\x79\x8a\x7e\x64\x8d\x1c\xb5\x5a\x31\x72\xeb\x4f\xdd\x33\xa0\x2e\x2b\x30\x9f\xe4\x20\x18\xca\x29\xf0\xc1\x9d\xe7\x83\x1e\x44\x2b\xf8\x8b\x20\x8a\x0d\xfc\xe7\xbf\x2d\x6e\x80\xb6\x92\xdf\x94\x47\xa0\x6c\x2b\xd0\x72\x3c\xcf\xd3\xdc\xc1\x71\x72\xda\x18\x36\xd9\x6f\x45\x8f\x34\x0f\xb5\x0c\x2e\x68\x09\x0d\xe1\xb2\x07\x7b\x2a\x7a\x32\x06\xfa\x3b\xe4\x7f\xab\x30\x2d\xf6\xfd\xa4\x40\x5d\x63\xba\x5d\x80\x7e\x07\x0f\x89\x56\x5a\x92\xa2\xcf\x91\xa1\x97\xac\xf3\x7b\xa2\xd9\x0e\x43\xbe\xfa\x42\x01\x45\x17\xb7\x03\x1d\x50\x7e\xca\xd0\x21\x07\xb1\x8a\x12\x61\x6a\x64\x4f\x3c\x7c\x79\xfb\xe9\x00\xbc\x1b\xdc\x0c\xa8\x10\x08\x55\xd7\x53\xd0\x57\x67\x04\xf6\x14\x56\x6a\x2e\x83\x4d\x4f\x3f\xf5\xa6\x40\xe1\xc8\x7a\x28\xc8\x81\xc7\x13\x5c\x41\x42\x3d\x7c\xed\x5f\x2f\x78\x32\x50\xee\xd9\xd7\x3e\x38\x5b\x65\xab\x44\x1a\x9b\xd6\x98\xfb\x91\x1a\xdb\x70\xb4\x16\xd1\xf6\x56\x51\x9d\xf7\xc2\xd5\x21\x51\xa6\x2d\xd8\x9d\x0c\x42\x0a\x1b\x1d\x3e\xb2\x23\x50\x2d\x32\x2e\xc8\x09\x66\x1a\x1b\x0d\x4d\xb4\xcd\x2c\xe9\x7a\xa5\x54\xa6\x08\x1c\x51\xa8\xf6\x3f\x7e\x62\x55\x11\x34\xcc\xc2\x7e\x56\x1d\xa7\x65\x10\x7f\xc2\x77\xb6\x75\x58\x1a\x75\x30\x12\xc2\x69\x4c\xb6\xf4\x2c\x40\x46\x7d\xb0\xa5\x67\xf3\xac\x42\x7f\xe3\x49\x8b\xcb\xe2\xc1\x3b\x6f\xe8\xda\x71\x27\x53\x45\x4e\x38\x25\x58\xbd\x63\x11\xd5\x79\x44\x8f\x77\x3a\x73\xcd\xc8\xc9\x69\x67\x78\xd7\xf0\x80\x1c\x93\x6f\x1c\x43\xdb\x21\x8a\x64\xf2\xc0\xf2\x0b\x9d\xd7\xe0\xe9\x6d\x5b\x20\xca\x45\x7d\x8f\xa4\xd6\xbc\x51\x1f\xc3\x51\x68\xb6\xcc\x84\x4f\x6a\x7e\x0b\x79\x15\x18\x94\x2d\xd7\x43\x61\xfa\x91\x9f\x55\xc5\xc2\xc5\x34\x6c\x83\x20\x10\x60\xa8\x2f\x26\xb2\x44\x3e\x29\x8f\xb4\xf6\x18\x43\x7d\x43\xb3\xc4\x46\xf1\x38\x61\x52\x78\x4d\x30\xeb\xa4\xaa\xca\xae\x24\x95\xb7\x9f\x82\x77\xe4\xad\x13\x34\xdc\x22\x6a\xca\xd9\x5b\xdc\x38\xea\x21\x61\x1d\x62\xb1\x77\xc5\x73\x18\xdf\x6f\x21\x18\x8f\x39\x69\x6a\xce\x90\x27\x67\x81\x18\x03\xe5\x61\x26\x93\xc7\x9b\x96\xc3\x2a\xa1\x84\x2a\xcc\x05\x38\x4e\xe1\xea\x19\xca\x78\x3e\xc6\x7d\xba\x55\x3a\xe1\x23\xcf\x71\xaa\xff\x11\x5a\x41\x26\x9b\x02\x21\x03\x44\xb1\x4c\x33\xe5\xda\xb5\xac\x8b\x47\xe7\xc6\x8b\x45\xaf\x8f\x06\x05\x91\x14\x8e\x14\x08\x54\x82\xff\x24\xde\xb4\xef\x14\xe9\x29\xf8\x7f\xa2\x82\x28\xd2\x72\xd4\x07\x88\x01\xce\xd1\x19\xfd\xc2\xbc\x01\x6e\xfd\x5e\x00\xb3\x42\xdb\x1a\x99\xc9\xd1\x09\xdd\x07\x2f\x0c\x27\xe7\x3b\x11\x39\xdf\x8e\x09\x72\x52\xbf\x2e\x66\x4d\x1a\x5b\x39\xa2\x93\xcf\xa7\x4d\xdd\x58\x87\x00\x35\x69\xa1\x6b\x28\xcc\xbb\xdf\x56\x6d\x8a\x99\x38\x87\x15\xfc\x25\x4c\xaf\x93\xe6\xaa\xa4\xe5\xf8\x52\xbd\xef\x5c\x97\x4e\x4c\xdb\x11\x68\xaf\x5e\xa7\x0e\xe8\xc3\xac\xf8\xa7\x82\xb2\xa7\xde\x9f\x78\x9e\xf0\xfb\x98\xf3\x1a\x99\xeb\xd7\x54\x4b\x83\xb6\xb5\xd5\x0a\xf6\x2f\x60\xe6\x2b\x60\x64\x53\x92\xf1\x69\xab\x91\xef\x58\x2c\x35\xa4\xc5\x2d\xb8\xe8\xcb\x26\x5a\xf1\xfc\x37\xf2\x1e\x0e\x45\xee\x44\xc4\xc3\x52\x84\x28\xe2\x47\x11\xb9\x80\x80\xdc\xc9\x8f\xd8\xbe\xaf\x4c\x26\x55\x85\x4c\x42\x23\xb6\x2c\x28\x30\xab\x82\x8d\xdf\xd0\x7e\x96\x4a\xcd\x50\x86\x31\x8c\xae\xa2\x10\xa2\x6b\x81\x3c\x4b\x23\x64\x91\x1b\x51\x59\xf8\x69\x20\x98\x9c\x18\xb4\xf4\x2a\x7a\xe8\xf6\x55\x7a\x88\xba\xda\xb7\xae\x23\x6f\x13\x74\xdb\x40\x35\x1a\xe0\x76\x59\x82\xa1\xec\xac\x73\x57\xad\xb5\x8d\xbb\xac\x1a\x1b\x6d\xd2\x58\x75\xb6\xb4\xf6\xde\x6c\xeb\xb5\x02\x5e\x2e\x34\xd9\x88\x22\x60\x80\xa2\x1c\x0f\x04\xbb\x41\x8e\x35\x5e\x34\xd3\x22\xff\xa5\x16\x08\x6e\x95\x45\xb8\xc3\xcb\xfe\xce\xf3\xd4\x48\x07\x4d\x20\x56\x85\x69\x7c\x02\x2a\xda\xdc\x1e\xe1\xdc\x9f\x73\xac\x47\x03\x2f\xa2\x10\xdb\x9a\x72\xef\x29\x4f\xaf\x7d\x8b\x6e\x7b\xb1\x3a\xeb\x52\x8f\xae\x39\x43\xc0\xe2\xd7\xa0\x6c\x4d\x70\x67\x5b\x4b\x77\xf1\xd1\xae\xa9\x1d\x9d\xb9\x40\x27\x40\xec\xa9\xdc\xcf\x7c\x93\xe0\x02\x15\x8a\x45\x03\x76\xac\xb8\x46\xf2\xd1\x96\x93\xa9\xfc\x77\xa4\xd7\xec\x54\x2c\x44\x6a\xb2\xd2\x27\xb4\xc7\xba\xc6\x37\xb4\xd7\x4a\xc3\x47\x6c\xf0\xcc\x98\xa0\x27\xcd\x04\x71\xcb\x02\x5b\x6b\x6e\xe6\x6a\x79\x0a\x2d\xdd\x77\xe5\x4a\xb6\x9a\xb0\x5c\x73\xdc\xb2\x29\x0f\x36\x01\x76\xa9\xce\x43\x03\xb5\xd9\x86\x12\x14\x57\xa5\xea\x01\xfb\x4a\xb9\x9a\x27\x0f\xf1\x36\x1a\xd3\xbd\xe4\x4b\xcc\x02\xb5\xcd\xf8\x3b\x7a\xf5\xeb\x4f\xba\x20\xe1\x1f\x32\xef\x72\xda\x70\xd6\x04\x15\x62\x86\x60\x6a\xd2\x84\xbf\xe3\x97\xc1\xf9\x6d\xc9\x1f\x67\x6e\x14\x36\x35\x3f\xee\x04\x51\x12\x9c\x66\x08\xeb\x35\x71\x5b\x46\x6d\x46\xb4\x4e\xcd\x07\x01\xb4\x3a\x4a\x6b\xf1\x06\x57\xfb\x4e\xdd\x0e\x98\xf6\x96\x10\x81\x24\x56\xd8\x37\x85\x54\xfa\xa8\xaa\x7a\x60\xbd\xdb\xb9\xa2\xb3\xdd\x1d\xf6\xcc\x5e\x99\x2b\xfc\xb8\x95\x24\x3f\xb3\x1c\x3c\x60\x9a\x22\xb0\xff\x1a\x7d\x2c\xff\xd0\xae\xbf\x49\xd9\x32\x8a\xe3\x88\xc2\x1d\x51\x3a\x11\x7c\x11\x1b\x29\xb0\x36\x71\x9b\x17\x7a\x17\xbb\xe3\x9a\xa5\xda\xca\xbc\x0b\xca\x85\x9f\xa7\x10\xaf\x0d\x06\x03\x3d\x22\xc7\x65\x83\x5f\xad\x91\x95\xdc\xaf\x94\xea\x8a\xa6\x47\xe1\xdf\xa5\x17\xda\x98\x59\x53\xb9\x3d\x99\xd8\xf1\x90\xdc\x2b\x22\xc3\x74\xe2\x81\xa1\x00\x37\x5f\x41\x9f\xba\x75\x68\x62\x35\xf5\x15\x09\x01\x6d\x55\x2b\x1d\x05\x65\x45\x3e\x02\xb0\xcb\xde\xbc\xc0\xea\x5c\x5f\x4f\x0a\x95\x2f\x37\x05\x03\xb1\xca\xd5\x4c\x10\x66\xc8\xa2\xc1\xae\xc3\x21\x01\x79\x36\xc7\x82\xf2\xa8\xbc\x35\x7c\x50\xd8\x57\x00\x3b\xd1\xb1\x3b\x64\x7b\xaa\x5a\xd4\x0b\x21\x77\xe7\xc4\xe5\xae\x50\xbe\xc0\x60\x0b\x6d\x8d\xc7\x30\xb7\x3f\xa8\xa5\x28\x1b\x4b\xbf\x6e\xc3\x84\xfd\x58\x93\xaf\x7c\xfb\x9f\x5c\x57\xd1\xde\x3f\x75\x20\xdb\x9c\x51\xc7\xb1\x75\xc1\x75\xcc\x25\xbd\x62\x9d\x55\x56\x6c\xb8\x88\xd3\x34\x1f\xd0\x6e\x8a\x64\x00\x8d\xdb\x9f\xa0\xb4\xd2\x53\xcd\xfd\x99\xe3\xa4\xe1\xc8\x54\xc1\x41\x10\x5e\x45\x45\x0a\xfd\x16\x84\xdb\xd7\xce\x14\x21\x08\xf9\x55\x44\x3b\xdc\xc6\x2f\x94\x1b\x14\x96\x7a\x95\xa5\x13\xe2\xc0\x44\x9a\x87\xb8\xb5\x22\xc0\x05\xc0\x89\xe1\xe8\x26\xf6\xee\x93\x6b\x79\x4a\x0e\xfe\x9b\x23\xf6\x15\xe6\x87\x06\xfa\x39\xfc\xd8\x1e\x8e\xac\xe1\x9e\xd6\xab\xdf\xde\x92\x04\x51\x69\x94\xae\x5a\x36\x6c\x53\x54\x85\x51\x91\xc5\xc1\xad\x38\x81\xf0\x9d\xaf\x1a\x7b\x6f\x0c\x64\xc8\x41\xbe\xe3\xc2\xc3\x2a\x5e\xb2\x01\x45\x09\x3a\x84\xaa\xc5\xc4\x0e\x5a\x2e\xf6\x23\x69\x6e\xd1\x78\x98\x5e\x0a\xb3\x5c\x96\xc1\xcd\x99\xd6\xdd\xf6\x50\xbf\x33\x2b\xc4\x91\x23\x51\x24\x77\x66\x6f\x17\x1b\x66\x19\xa1\x2b\xf0\x00\xc6\x60\x32\xb2\x81\x67\x6e\xf1\xdc\xca\x7d\x68\x32\x87\xb4\xa5\x6b\x6c\x2e\x29\x9f\x67\xcf\x49\x50\x9e\x3d\x9f\xa9\xd7\x3f\x44\xf5\xd7\x8e\x9d\x6e\xf3\x5f\xee\x6d\x23\xd7\xea\xa9\xb5\x49\x93\x1e\x0e\x4d\xe7\xee\x07\xfc\xf1\x87\xb4\xbc\x47\x28\xf9\x68\x59\x93\xc7\xce\x7d\x77\x3b\x54\xeb\x9a\x5c\xa7\xf9\x17\x88\xef\xcf\xb0\x90\xa2\xad\x61\x67\x42\x62\x43\x06\x98\x72\xc7\x5b\xcc\x16\xa9\x5a\xf0\x53\xd7\x98\x14\x63\xb5\xce\x7a\x6a\xfe\x0e\x41\xb1\x4d\x0f\x88\xca\x86\x4a\xc3\xac\x81\x7c\xe1\xf4\xae\x65\xa7\x46\x52\x0f\x53\xa7\xd8\xf0\x49\xed\xbd\x92\x6f\x91\xa7\x97\x74\x90\xe8\x3c\xc8\xfd\xc7\x72\x04\x17\x69\x29\xd6\x58\x4d\xd1\x77\x4c\xa5\xa5\xf4\x9d\xa1\x2a\x74\xa4\x49\xd7\x21\x6c\xd8\x8f\x56\x54\xf3\x34\x0e\x35\x26\x1b\xef\xd8\x10\x8d\xb0\x5f\x0f\xbc\xaf\x14\x6b\xc6\xf0\x66\xac\x96\xae\x7f\x1d\x85\xe5\x62\x60\x46\xb8\xc9\xbc\x6f\xbc\x61\xa3\x0d\x76\x54\x6f\x64\x75\xee\xb6\x12\x70\x63\xac\x17\xf0\xf4\x86\x31\xfe\xb2\x53\xdb\xf6\x69\xa1\xfa\xb8\xc5\xf1\x98\x2d\xda\xa8\xb0\xe1\x1c\x1e\xb0\x4d\x0b\x9b\xc7\x06\x08\x6c\xb3\x00\x69\x1a\x7a\xc2\x35\xed\x9b\xbf\xab\x07\x2f\xd6\x2a\x13\xb6\xd0\x2e\xe8\xbb\x08\xec\x13\x7f\xa6\xa0\x01\xd3\x55\x6e\xe6\xed\x3d\x2f\x51\x40\x0e\x54\x2b\x2a\xe9\x1f\x68\x24\x62\x8b\x5d\xff\x94\x26\xa8\x4d\x09\x1a\x98\xae\xda\x2f\x03\xa1\x32\x67\xb8\xf3\xe1\x74\xd5\xa8\xfa\x8a\x5a\x93\x2e\xe3\xed\x15\xf1\x75\x22\x46\xc4\xca\x9b\xad\xfc\x86\x11\xcb\x6a\xa1\x9b\x1c\x33\x1d\x86\x7a\xe0\x06\x9b\xea\xa4\x6b\x93\x4d\xbe\x5f\xb5\xd1\x86\xb3\x67\x26\x8b\xe6\x50\xa9\x85\xc8\x99\x0d\x3c\x76\xb0\x3d\x73\xe9\xa8\x95\x1c\x6a\x36\xd7\x1b\x76\xce\xb0\x16\xd0\xba\x73\x27\x29\xf7\x35\xaa\x51\xad\x98\xb1\x09\x61\x92\xd1\xce\x34\x0b\x1a\xac\x02\x77\xd0\x69\x45\x1a\x73\x3f\x4e\x2f\x4d\xff\xde\x27\xb9\x7b\x9a\x82\xcb\x94\x84\x66\x08\x4f\xbd\x11\xab\xc9\xa1\xf7\x14\x1d\x48\x4f\x57\x8d\x38\x7b\x7f\x92\x2c\x27\x1b\xb4\xd6\xe0\x4b\x01\xc1\xbf\x0f\xd5\xdf\xff\x9c\xd5\x0f\x07\xc9\x11\x9f\xdf\x2b\xf2\x95\x3b\xdd\xaa\x42\xf6\x31\x9d\x0b\x77\x6b\xa3\x29\x10\x27\xae\x10\x9c\xfa\xe5\xcd\x19\x31\x97\x8d\x8d\x66\x24\x72\xef\xd1\xd6\xde\x30\x74\xb8\xf2\x38\x24\xe6\xbf\x80\xc4\xbc\x27\x89\x8f\xb0\x8f\x43\x5a\x0b\x13\x15\xf2\x3c\xe1\xca\x5c\xa3\x52\x53\x54\x13\x57\xb4\x6a\xc1\xd7\xf4\xea\x3f\x6a\xf0\xdf\x5b\x0d\x0a\x05\xf8\x1f\xd5\xf7\xeb\xa8\x3e\xb1\xfc\x1e\xa8\xfb\x44\xe3\x5f\x5f\xf9\x3d\x9c\xc8\xbc\x2f\x91\x8f\xa0\xfe\x84\xba\x6a\xd5\x7f\x56\xb6\xc9\x4a\xf1\x88\x68\x45\x9c\x0e\xac\xf9\x81\x98\xde\x39\x22\x28\x91\xa1\x58\x55\x14\xd7\x14\xe6\x16\x15\xa4\xc4\x97\x76\x5e\xda\x53\x7f\x2d\x0b\x92\xe3\xc1\x4a\x08\x4e\x5e\x84\xd1\xd5\xae\xd7\x79\xd0\x7a\x7d\x82\x70\x7d\x7a\xf0\x11\x92\x83\xb5\xe2\xe3\x57\x1f\xde\x19\xd9\xdb\xf8\x45\x79\x43\x21\xc6\x20\xc1\x32\xb2\x93\x25\xf7\x32\xa4\xb3\xc8\x36\x21\x9d\x1c\x11\xc4\x6f\xd6\x55\x08\xf2\xb2\x04\x33\x1c\x2b\x66\xdb\xa8\xc7\x78\x36\xb0\x49\x40\x6f\x3a\x51\x9b\xe9\x75\xe8\xcd\x9c\x23\xba\xb8\x23\xcd\xe7\x78\xbd\x01\x58\x40\xe7\x01\x69\x91\xb3\x32\x3d\xbb\xa8\xe2\xb8\x79\x8c\x97\x48\xde\xdc\x01\x25\x0e\xd6\x5a\x5c\x09\x61\x47\x94\x36\x55\x1a\x23\xcc\x67\x7c\x86\xf9\x93\x5a\x7c\xb9\x55\x60\xd5\x7f\x4c\x47\xb8\x6c\x24\xfa\x62\x85\x6e\xc2\x9c\x5a\x2b\xe4\x3d\xd2\xa5\xf8\x4e\x61\x31\xfe\x8f\x5c\xa4\x77\xb5\xe4\xde\xc1\x12\x8f\x8b\x0f\x22\xfa\xc7\xb8\x08\xe2\x37\x86\x76\x38\x64\xf6\xf3\xcf\x4c\x3c\x31\xa7\x8b\xea\x87\x8b\x94\x0e\x70\x0e\xb6\xe1\xa9\x12\x7d\x6c\xc3\xb2\x59\x87\x9c\xce\xf7\x89\xcc\xbc\x77\x1c\x5c\x92\xf3\x7e\xf0\x8a\xce\xcb\x44\x79\x89\x75\x26\x78\xa0\x1d\x7f\xd3\x49\x16\x24\xd7\xad\x0d\x31\xd7\x0f\x10\x46\x51\x59\x8f\xf0\x6d\x7f\xe9\xb3\xa8\xea\x2f\x8d\x46\x9f\x80\x57\xdb\x2b\xbd\x92\x85\x0e\x33\x1a\xcb\xb7\xc5\x40\x11\xc5\x30\xcc\xda\xa3\x1c\xf9\x00\xcf\x04\x3e\x8c\xa8\xf1\xc9\x19\x42\xa2\x77\x52\x64\x71\x54\x0e\xbc\xa9\xa7\x1d\x01\x00\xa7\xa7\xe0\x9e\x8d\xb7\x47\x6c\x7b\x45\x61\x60\x0b\xce\xee\x7a\x15\xea\xa9\x8b\x92\xcf\x4d\x4a\xa4\x03\x47\xad\x8c\xef\xb6\x6d\x97\x72\x50\x87\xa2\xe8\x86\xc0\x4e\x5c\x68\x54\xb3\xc3\xe6\x19\xf1\xba\x11\x14\x43\xfe\x9c\x46\x09\xf5\xde\x6a\x28\xa9\x27\x01\x02\x33\xdc\x0e\x63\xc6\x15\x85\x7e\x51\x9d\x83\x6c\x60\xaa\xfd\xd9\xf3\xe1\x6a\xdb\xfb\xd3\xd5\xd4\xe2\xc9\x95\x90\xcd\x33\x71\xa3\xce\xc5\xd4\xc9\x1e\xb5\x83\x0d\xef\x2c\x25\x17\xda\xa7\x27\x0d\xfc\x9c\x0e\x6b\x85\xf2\x28\x64\x2b\x41\x2e\x1d\xb2\x01\x91\x10\xfa\x65\xfa\x36\x9d\x07\x60\x06\x49\xde\x07\xba\xc7\x35\x96\x5a\x9c\x1b\x2b\x3b\x2f\x21\xf1\xc2\x74\xfe\x85\xe7\x63\xd1\x2d\xde\xd6\x32\xb1\x2f\x21\x99\x35\x74\x89\x3c\x71\x84\xea\xa4\x38\x4c\xd3\x72\xc4\xf4\xf1\x9a\xcc\x1c\x46\x32\x4a\xc6\x7a\xd8\xa6\x57\x64\x82\x50\xa0\x1c\x97\x69\xe6\x0d\x85\x3a\xf3\xde\xa7\x4c\xbf\x00\xd9\xaf\x84\x03\xd1\xd4\x45\xae\xd6\x11\xa7\x62\x54\x59\xe5\x47\xa1\x6d\x3e\xca\x7f\x8f\x4a\xac\xa1\x53\xbe\x34\x16\xef\x7c\x43\xf7\xee\xbc\x7e\x27\xfe\x38\x3c\x3a\x52\x57\x7a\xd4\x15\x94\x38\xb3\xe4\xc9\x2a\x72\xb4\x02\x1a\x4d\xba\x04\xf1\x08\xa9\x9f\xa3\x43\x3c\xc1\xdc\xa1\xbe\x04\xe2\x15\xfa\x6a\xb4\xee\xad\xfa\x4b\x1f\xfd\x69\xb4\x5a\xa1\x17\x6d\xc2\x6c\x85\xf8\x5c\xf9\x41\x62\x3e\x3b\x2a\xbe\x65\x8e\x5b\x4d\x81\x19\x99\x84\x90\xdd\xf5\x2c\x08\x97\x1a\xb6\x29\x1b\x7d\xd4\xac\xbb\x66\x2c\x1c\xb8\x68\xa8\xe2\xb3\x07\x5c\x16\x85\xbd\xc0\x02\x3c\x1b\x7d\xd6\x13\xba\x40\xf9\x3a\x43\xb3\xdd\xbe\xbc\x95\x2e\x9e\xb2\x7a\x37\xa2\x9a\x01\xcb\x3d\x74\x1d\xce\x2a\x20\xeb\x12\xa8\x0d\xbb\x6c\xee\xbe\xfd\x2d\xf9\x72\x7d\x7f\x00\xd4\xb3\xbf\x66\x47\x79\x51\x34\x54\x68\x13\x64\x78\x5f\xfa\x1d\x15\x6d\x06\xb0\xa2\x17\x47\x5b\xaf\x18\x43\x73\x46\xcb\xaa\xe8\x03\x99\x0b\xb5\xd0\x3d\xfb\x35\xf8\xf9\xb2\x9f\x00\x16\xb9\x55\x86\xe2\x2e\x51\x19\xef\x80\x5b\x5a\x65\xb0\x3e\xea\x1d\xd0\xf3\xb3\x2c\x10\x35\x0e\x2a\x18\x28\x44\xdc\x85\x19\x28\xd9\x32\x8e\x92\x2f\x58\x02\x1a\x95\x0c\xe3\xc7\x42\x6f\x8c\x9b\x43\x74\x7e\xa3\xbf\xb7\xd8\x08\xbc\xe2\x17\x01\x5b\xe4\xfc\x62\xe7\x29\xdd\x43\x66\x0e\x05\x9a\xb6\x5b\x74\x12\x50\x74\x05\x2f\x9e\xee\x7a\x8e\x53\x2c\xde\x58\xd6\xfa\x5b\x71\xdb\x16\x7b\xb1\x15\xec\x7a\xb3\xd6\xc3\x0d\x28\x68\xa2\x1d\x09\x97\xa1\xe8\xee\x3e\x47\x12\xd6\x9a\x46\xd7\x2e\x81\x1f\xf1\x5d\xc3\x34\xda\xc9\xbc\x46\x28\x9b\xa4\xa1\x13\xc9\x92\x7a\xa8\x87\xb2\x3d\xd2\x79\x1d\xd1\x99\xf4\xbb\xe5\x61\x20\x68\x9d\xe1\xce\x92\x08\xd2\x68\xff\x08\x53\x54\xf5\xa8\x6f\x5d\xa4\x67\x90\xde\x3b\x96\xee\x88\x90\x7b\x86\xd8\xbf\x5e\x28\xcd\x63\x3f\xc8\x32\x9e\x84\xc6\xe1\x33\x14\x5a\x92\xc8\x18\xdd\x6c\x83\x57\x5e\x0d\x3c\x08\xf5\x70\x63\x74\x5c\x2c\xc7\xdb\xcf\x1a\x60\x02\x1d\x62\x59\x3c\xdf\xd5\x1e\x8b\x2e\x7b\x89\xa8\xdc\x05\xa5\x78\x4a\x71\x9e\x15\x3d\x0f\x87\xf6\x39\x88\x5a\x00\x6d\x55\xaf\x19\x0a\x2d\xa2\xf4\x4e\xea\xb9\xd5\x16\x7f\x8c\xc3\x20\xb9\x34\xd6\xf9\x41\x23\x96\xa3\xfd\xfd\x8a\xc1\x76\x12\x84\x0f\x05\x58\x6d\x44\xee\x70\xad\x98\xd5\x11\x93\x26\x15\xdf\x36\x87\x62\x35\x56\x38\xef\x95\xb6\xd0\x97\x1f\x30\xe6\xd5\xa8\xf4\xa6\xf5\x99\x50\x46\xc5\xb3\x7a\x05\x28\xeb\x97\x86\xa0\x44\x38\xbc\x8b\xc4\x93\x3b\x25\xce\xe8\xd9\x7a\x54\x1b\xa5\xee\x20\xf0\xf9\x32\x2b\x6f\x07\x9a\x57\x3c\x36\x1b\xcb\x3d\x32\x5c\x4a\xe1\xbc\xbe\xc9\xf8\x1c\xef\x77\xb4\x8e\x7d\xcc\xe3\xb4\xa8\xb0\x48\x12\xaf\xbf\x01\xdd\xed\xb3\xbd\x0b\xbc\x03\x87\xce\x04\xf2\x1b\x3e\xaf\x48\x03\xa1\x9a\xfa\xe3\x11\x03\x5b\x85\x66\x8a\x45\x05\xe2\xbb\x8c\xae\x78\x42\xca\x3e\x4f\x63\x86\x87\xaa\xd9\x39\xc7\x24\x04\x3d\x8b\x92\x0a\xcc\x1a\x5d\xa4\x7a\x4c\xf7\xd6\x2a\x6d\x26\x16\x2f\x90\x51\xdc\x26\xf3\x45\x9e\x26\x69\x55\xc4\xb7\xb6\xb6\xe3\xd9\x6b\xea\x19\x77\x19\x78\x56\xe8\x63\xf6\xe0\xd9\xd3\x03\x1c\x58\x9a\xf9\x7a\xa3\x00\x1e\xad\x4b\x3d\x98\x9d\x88\x80\x70\x50\x51\xa8\x18\x1f\x0c\xa7\x54\xfb\x11\xf4\x6a\x47\xf4\xe2\x8b\xcb\x3a\x48\x9e\xf0\xc1\x40\x5f\x9f\x71\x34\x5f\xf0\xb0\x8a\xb9\xbc\x90\xeb\xa6\xa4\xf7\x88\xa3\x10\x37\xd7\xa4\x55\xe9\x9c\x60\x68\x19\xd3\x8c\xdd\x8d\xd8\xa4\x76\xd1\x1d\xd8\x4e\x7d\xed\x50\xc1\x24\xdf\xb3\x96\x63\x02\x04\x30\xe8\x2e\xb2\xaa\x9d\x65\x37\x49\x4e\xea\xdc\x54\x15\xaf\xa9\x20\xfe\xf9\x67\xd6\xab\x98\x54\xf0\x8b\x2c\x66\xcb\xc1\x8d\x46\x2d\xb5\x47\x66\x6e\xac\x2e\xb0\x5d\x51\x2b\x36\xb3\x3c\x2e\x35\x89\x10\x7e\xf9\x6b\x49\xef\x4f\x99\x7b\x44\x1f\x0f\x47\x8c\x29\x25\x38\x16\x44\xaa\xeb\x76\x7b\x12\x69\x6e\xf6\xca\x3f\x27\xc1\x65\x80\x37\x7b\x1d\xf2\xb1\xb8\x90\x91\x8e\x7d\xe0\x39\x6d\x16\xd0\x22\xa3\xdb\x80\x61\x8d\xd2\x8d\x8a\x8d\x5a\x74\x89\x6c\xd5\x08\xe0\xf5\x7f\xd9\xc7\xbf\x9f\x22\xf5\x78\x14\x5a\x90\xfd\xb4\x07\xd9\x80\x42\x51\xde\x8b\x57\xce\xd1\x65\x3b\x47\x89\x5d\xab\xb3\xcf\x0f\xe5\x5d\x2f\x0a\x6a\xc7\x34\xeb\x34\x88\xae\xf5\x31\xcf\xfb\x12\xa1\xa4\x4c\xd4\xf1\xf8\xf7\x29\xa9\x5e\x4f\xbd\x5d\x2c\x29\x0b\x18\x1f\xc6\x2a\x45\xa5\xdc\xf2\x5c\x43\xa6\xda\x30\xec\x4d\xa7\x53\x3e\xa3\xb6\x78\xc7\xb4\xff\xfd\x6b\x4c\xaa\xbb\x4f\xad\xfb\x13\x3b\x4e\x0f\x65\x8d\xa9\xec\x5d\xc3\x9d\xa6\x7b\xd9\x41\xf1\x7a\x7b\x5b\xa3\xaa\xae\xb8\x2a\x30\x5b\x4b\x79\x29\x74\xb1\x46\x85\x11\x2c\x08\x18\xc1\xf6\x9b\xb9\x4b\x5e\x8a\x2e\x64\x0f\xf6\xd2\xa8\x7b\x3c\x3a\xf7\x56\x7f\xe1\x5e\xbd\x62\x61\xd0\x1d\x4a\x9a\x4c\xb6\xce\xfc\x87\x56\x68\x17\x09\x62\x85\xd2\xdb\xb1\xc4\xa1\xe7\xb6\x66\xbc\xec\x2e\x66\x16\x8a\xbb\xd6\x99\xb6\x0f\x2a\xe9\xbb\x75\x1a\xe7\x94\xc0\xe8\xc1\x4c\x97\xee\x41\xab\x62\xb0\xd2\x6c\xea\x5a\x84\x8e\x93\xa4\x4e\x45\x02\xc0\x3d\xe0\xfc\xdd\xba\x49\x95\x81\x8a\x28\x5f\xdf\x4f\x2b\xe5\x01\x7f\xa5\xf4\xad\xdd\xc3\x58\xc2\x81\x12\x06\x40\xf0\x8f\x71\x1f\xdd\xe2\xe0\xaa\x23\x86\x1b\xee\x3c\xb5\x61\x77\x54\x95\x03\x4f\xd5\xe9\x2f\x6f\x01\x77\xeb\x91\x5e\x8b\xfa\x61\xe3\xc8\xe6\xdd\xc6\x3d\xf9\x57\xaf\xfc\x7e\x30\x0b\xa5\x06\x7e\x08\x17\x57\x1c\xdb\x73\x19\xd9\xd5\xc7\x5a\x5e\x8a\x1e\x1e\xc2\x4e\xc9\xd2\x16\x9f\xd3\x3d\x19\x0e\x71\x00\xf5\x55\x3f\xfe\x5d\x50\xb2\x42\x7c\xc8\x01\x3c\x96\x91\x28\x33\x0d\x4a\xb6\x4c\x8b\x12\x33\x34\xc8\x15\x06\xa1\x09\xde\xa7\x69\xa7\x29\x56\x0a\x01\x35\x13\x93\xd2\x5c\x4e\xd8\xa1\x99\xba\x60\xc4\xce\xed\x65\x15\xf8\xf2\x66\x97\x02\xcf\x8e\xc1\xa2\x3b\x77\x1e\x34\x4a\x4a\x45\x11\x11\xb0\x01\x37\x3c\x79\x1b\x8a\x17\xeb\x50\xb8\x18\x6a\x2f\xf1\x92\xc1\x20\x87\xa1\xa2\x8e\x14\xd4\x5a\xbc\xb7\xaf\xde\x6f\x19\xa9\x3a\xc7\x41\xfb\xf2\xcb\x28\xe9\x54\x2e\x8a\x65\xba\x88\x99\x98\xe4\xf4\xfd\x90\x19\x15\x02\xd9\x3e\xa9\x74\x2b\x62\xd7\xbc\x76\x0b\xe4\xe3\x4c\xad\x3c\x49\xe0\xcc\xae\xeb\x60\xf5\x9c\x60\x89\xe8\x45\x0f\x44\xff\x9a\xd3\x8c\x10\x92\x3a\xdc\x26\x87\xd0\xbb\x10\x9f\x44\x91\x7d\x40\x38\x1e\x43\x78\x51\x2b\x05\x77\x87\x03\xa0\x7b\x64\xee\x5e\x5a\xa5\x7f\xf0\x4c\x54\x6d\xef\xd2\x1b\x71\xf7\x81\x7b\x8a\x97\x38\x66\xf1\xdd\xb4\x79\xd1\xd9\x66\x6c\x37\x72\xde\x4c\x66\xd6\xc1\x79\x25\xc4\x2a\x23\x89\xc1\xaf\x9a\x40\xf5\xdb\x39\x56\x2d\xb2\x89\x9d\xd7\x4c\xc8\x2b\xad\x0a\x87\xf5\x56\xbd\x4f\x56\x1d\x55\x4b\x7b\x67\x5f\x08\x89\xf5\xd0\x6e\x28\x73\x97\x8d\x0b\x0a\xf0\xb1\xae\xfb\x13\x28\x37\x45\xea\xb3\xfd\xa0\xab\xe9\x44\x81\xf5\x39\x14\xed\xdc\x45\x00\x78\xa7\xaa\xaf\xad\x36\x22\xa5\x64\x59\xfd\x4d\xad\x7e\xd7\x34\x69\x4c\x07\x5d\x52\x0b\xfc\x8f\x96\x4b\x1e\x46\x58\x12\xe4\xe8\x96\x91\xbc\xc0\x16\x63\x58\xe1\xb8\xe9\x59\xb3\xe6\xe6\xde\xbe\x97\x39\xe0\x5e\x73\x91\x1d\xbd\xf6\xf3\xcf\xea\x40\x6d\x37\x90\x73\xe9\xad\x69\xf1\xa4\xa5\x3f\xf3\x39\x9a\x53\xa7\x80\xcb\x06\xd5\x5f\x7d\xa0\x8c\xdd\xaa\x7e\x9b\xb2\xd2\x10\x44\xd6\x44\x7d\xe2\xcc\x0e\x2e\xa7\xd3\xda\xed\x1f\xf4\x70\x66\xae\x6c\xec\xf2\x77\xc5\x20\x1e\x40\x53\xdd\xe7\xed\xa4\xeb\x49\xfb\xc5\x56\x0e\xa4\x5e\xc2\x3b\xfd\x16\x68\xf7\xe5\x20\x8d\x4b\x5a\x6a\xfa\x78\x85\x42\x76\xeb\x11\x0a\x5d\x2c\x3c\x68\x39\x9c\x82\xae\xa1\x8a\x41\x0b\x1e\x8b\xd3\xa0\xb5\x03\x45\x32\x23\xbb\xd1\xcc\x75\x17\x59\x90\x98\xd4\xbe\x2e\x46\x9e\x62\xed\x49\x0b\xf8\xb9\x86\x75\x29\x19\xce\x36\x7a\x94\x2c\xb3\xda\xe9\x1a\x95\xa1\xd4\x7b\x4b\x58\x4f\x22\xd5\x24\x32\xe7\x22\xe7\xc5\x42\x5c\x6e\xaa\x8d\x8a\xb8\x0c\xb8\x90\xe0\xa2\xda\x5b\x0f\x1b\x9c\xdd\x34\xab\x5d\x97\x45\xdb\x51\x8a\x7f\x1a\x52\xa5\xb7\xbb\x4e\x7b\xae\x3c\xcc\xa9\xab\xc8\x3b\x17\xb4\xbd\x71\x54\x5b\xc7\x6d\x87\xeb\x5a\xab\xb7\x57\x22\x6f\x6f\xd2\x23\xaf\xdc\x31\x49\x5a\x43\xf4\x99\x44\xcf\x9b\xd9\x97\xf3\x51\xa5\x9f\xa6\x5a\x9e\xa5\x34\x33\xe1\x6e\x99\xd1\x66\x41\x7d\x1a\x86\xf7\x38\xc9\xba\x72\xe4\x8d\x3d\x33\x47\xde\x3a\x8e\x36\xb6\x22\x54\x47\xc2\xea\xfb\x33\xb8\x0a\xe2\xa8\xbe\xc1\xa4\x56\x4a\x59\xe6\xb8\xa9\x16\x53\xc9\x0c\x7e\xa6\x0d\x2f\x6f\xa0\x6f\x18\xb4\xc3\x1b\x9c\x41\x3b\xca\x15\xe8\x81\x71\x55\x54\xf2\x65\xdf\x76\x65\x70\x2e\x36\x71\x46\xe0\xe2\xac\x6d\x33\x07\x97\x10\xd7\x8b\x5a\x3a\x3e\x36\xa6\x5b\x8c\x6a\xe5\xff\xc3\xb5\xa8\xda\xf4\x85\xde\xa2\xb6\x94\x5b\xff\xb9\x99\xe8\x8b\xae\x94\xf1\x27\x55\x21\x7c\x2e\xba\x3b\xb9\x7d\x37\xc2\x55\x29\xe6\x03\x3b\x26\x2b\xd6\x52\x25\x6c\xbd\x45\xa2\xf5\x7d\xcb\x1b\x8f\x91\xab\x6a\x5e\x0b\xdc\xe9\x5d\x98\x5a\xc5\xc6\x86\x20\x7e\xbc\xe7\xb0\x4a\xdc\x04\x57\x17\x14\xa6\x96\xc4\xdd\xf5\xf6\x75\x87\xf7\xc9\x38\x5a\xc7\x3c\x9a\x3b\xfd\x0d\x69\xf0\x0c\x02\x91\x47\xe8\x93\x1d\x75\xbf\xd0\xb3\x86\xc2\x5a\xc6\xd8\x21\x4f\x09\x97\xd7\xb0\x91\xde\x23\xf4\xdc\x9e\xf8\x74\x08\x70\x33\x8c\xbd\x52\x8a\x1d\x94\xdc\xb9\x99\xb1\xfb\xee\xaa\xd5\xae\x86\xd6\x8b\x22\x08\xc3\xbd\x38\xa6\x3b\xfe\x1b\x4e\x6e\x23\x7b\x4a\x9f\x16\x31\x0f\xd7\xd7\x50\x8b\x48\x05\x1b\x1c\x65\x74\x68\xa6\x85\x93\x2e\x17\x1d\x5b\xe0\x2c\x1a\x2c\x28\x6f\xc9\xe7\x3a\x70\x78\x46\xdf\x80\x9c\x38\xed\x4f\xad\x0a\x63\xfb\x5e\x58\x4d\x5e\xfb\x2d\x27\xe2\xbd\x34\x20\x06\xd8\xb5\x10\x0a\x8c\xf8\xf8\x67\xfa\x36\x95\xee\xfb\xc4\x60\xd0\xd7\xa2\xd0\x6a\x24\x60\x3c\x8e\x4e\x5f\x8c\x90\x2f\xd4\xd0\xc5\xa7\x1d\xf4\x90\x15\xe2\xba\x32\x10\x60\x3b\x68\x95\x5d\xf9\x43\x63\x7b\x5e\x95\x25\x78\x83\x68\x73\x0d\x0d\x43\x7f\x11\x85\xdc\xce\x11\xde\x6d\xd8\x15\x14\x5f\xb9\xe0\x58\xe0\x71\x46\xbd\x14\x1d\x45\x13\x8d\x25\xbf\xca\x48\xde\xdf\x4c\xde\xdb\x50\x3e\xdc\x54\xda\x86\x8f\x18\x6e\x9b\x3d\xc3\x92\x91\x98\x9c\x61\x0f\x74\x64\xfc\x24\xb4\xc5\x71\x9c\x39\x23\x02\xee\xc4\x39\xa2\x61\x7d\x66\x44\x2a\x15\x49\xd9\xa0\x49\xce\xac\xa1\x2a\xec\x0f\x96\xd9\x6b\x1e\x3f\xf1\x40\xcd\xc5\xa9\x43\x4b\x36\xcc\x79\x7a\x6b\x85\x34\x4c\xa2\x91\xc8\x8f\x41\x94\xeb\x65\xb3\xb9\x19\xd9\x41\xd9\x9a\x66\xea\xb3\x71\x3b\xcc\xe9\xdf\x1c\xb5\xef\x38\x66\x6f\x07\x46\x36\x2b\x14\x1b\x74\x58\x64\xe4\x18\x65\xf8\x17\x47\x47\xd4\xd7\xfa\xc8\x48\x4e\xb6\xa8\x58\x30\xe7\x3d\x9b\xfc\xd6\x1b\x36\x12\x0a\xcf\x93\xd6\xbf\xf1\xd5\xc5\x71\x71\x25\x94\x33\x6e\x79\x15\x94\x9d\xb7\x5a\x3b\x01\x74\x5e\xee\x84\x6e\x9e\x24\x1c\x3a\x4c\x68\x09\xbe\x9c\xc4\x15\xb1\x92\x87\x75\x49\x94\x46\x62\xd5\xa8\xdf\xab\x1b\x4a\x9b\x23\x57\xf3\x0c\xf1\x85\x3d\xed\xbd\x47\x51\x93\x8e\xa6\xf5\xb5\xaa\x10\x3b\x6e\xc2\xbb\xbf\xa9\xb2\x5a\x91\xe7\xd9\x1a\x63\x74\xb4\x6b\xd4\x11\x3a\x16\xd6\xb2\x48\x6d\x6b\x30\xb8\x71\x06\xd7\x29\x39\x35\x38\xc7\x86\x59\xb7\x83\xf5\x58\xf5\x35\x4c\x0f\x33\x93\x62\x77\xeb\xea\x98\xce\xc8\xd9\xe5\x10\xc8\x04\x36\xc7\xfa\xb8\x9d\x1f\x3d\x15\x3e\xfe\xe8\xed\xb2\x17\xc2\x8a\xe9\x77\xe7\x65\xc2\xe0\xbf\x63\xf5\x25\xe9\xda\xed\x26\xaa\xe9\xb8\x4c\x2f\x2f\x63\xfe\xa3\xc7\xca\xdb\x8c\x63\x3b\x42\x03\xbf\xa3\x50\xff\xaa\x99\x46\x7d\xdb\xaa\x24\x70\xd3\xa1\x10\x9a\x62\xa9\x9d\x44\xec\x50\xc9\x82\x3c\x0a\xc6\xe0\x12\xe2\x77\x90\x32\x78\x85\x26\xfd\x47\xaf\x4e\x1b\x41\xf1\x1b\x50\x2b\x21\x47\x22\x48\xbb\xc3\x18\xbd\x66\xc7\x4c\xa8\x1f\x41\x6c\xdd\x22\xdb\x48\x6b\x7a\x0d\xb0\xbd\xd8\x22\xc5\xc5\x04\x02\xc5\xb6\x79\x00\xeb\xc9\x7e\xbb\x25\x58\xd0\xd1\x79\x15\xaf\xef\x5a\xba\x05\x30\xfc\xfa\xbc\x8d\xd1\xe4\xc2\x73\xb4\xc0\xf0\x58\xfc\x6a\xe5\x06\xa1\x88\x79\x78\x7e\xdb\x35\x29\xa8\xbc\x49\x0e\xb6\xaa\x18\xff\x97\x16\x4b\x2b\xcd\x28\x41\x9a\x68\xf3\x25\x45\x54\xfe\x5d\x28\x1d\x64\x76\x98\x2f\x11\x0f\x6b\xd7\xec\xba\x99\x00\xd1\x5c\x2a\xfb\x96\x52\x48\xbb\x04\xb2\xa6\x42\x5d\xcd\xf4\x18\x5f\x35\x82\xce\xa4\x7e\xd9\xfa\xa7\xf9\xbc\x91\x5b\x08\xd8\x4b\x19\xff\x9b\x44\x1d\xbf\x8e\x76\x15\x6f\x3e\xc9\x0f\x95\xbb\x70\xf4\x3d\x70\xe7\x5a\xc6\xc6\xcd\x0b\x2d\x81\xca\xfd\xc2\x9a\xce\xf4\x84\x18\xdb\x3e\xee\xf5\x91\x40\xd8\x2e\x6f\xaf\x06\x4d\x5f\xb8\x6f\xe0\x5a\xff\xde\x6f\xe3\xd0\x96\x70\xab\x2d\x8f\x46\x26\xff\xbb\x9c\x1c\x97\x35\x82\x2b\x2e\x9b\x4e\x1c\x74\xa7\x8d\xd8\x4e\x5e\x50\x9e\x18\x14\xce\x77\x87\x85\xcf\xba\x63\xde\x4a\x1f\x67\xe6\xd4\x88\xb4\x1c\x1a\x73\x3a\xc1\x18\x28\x3a\xa7\x92\x61\xd5\x91\x1b\x68\x88\x0f\x05\xaa\x6e\x4e\x34\xfc\xa9\x1d\x6f\xb8\x67\x52\xa8\x49\x4b\xa0\x21\x66\x5e\xc3\x6a\xaf\xe5\x99\xdb\xa3\x8b\x6c\xd2\x8a\xa8\xf3\xa6\x73\x03\xd4\xeb\x72\x86\x16\xb5\x3f\xb2\xd7\x87\xa3\xd8\x55\xcd\x39\x15\xa0\x84\x0c\x3f\x2a\xa6\x13\x88\x78\x01\x4a\x54\x2c\xf0\x63\xce\x69\x10\x52\x99\xb8\xeb\x64\xe2\x67\xca\x07\x35\xc5\x0d\xae\xc0\x7e\x56\xd1\xbf\xef\x64\x0d\x83\xd6\xf1\xf6\xf9\x26\xdc\xfe\xa0\x0f\x48\x5f\xf0\x72\xbe\x10\x72\x29\x3e\xe4\x2c\xbe\x2b\xbd\x08\xae\x38\x7d\x4f\xda\x7c\x61\xd3\xec\x50\xec\x9b\xcf\x75\xbc\x73\x4a\x06\x3a\x92\xfe\xee\x41\xf6\xda\xb2\xeb\xdc\x02\xb0\x12\x87\x2a\x51\xd1\x91\xf4\x04\x08\xf9\x57\x1b\x90\xab\x55\x77\x5c\x2d\x3b\xdb\xd8\xe8\xaf\x08\xba\xc8\xa8\x45\x06\x7a\x61\xf4\xf0\x89\xb7\x27\xce\x6e\x94\x3c\x14\xe5\xdc\xa4\x1a\x94\x62\xba\xa9\x1a\x5f\x06\x48\x8c\x5f\xf1\xfc\x16\x3f\xa5\xe7\x8b\xdc\x70\x9f\x4f\x15\x6a\x73\xda\x38\x4a\xcc\xee\x73\xf8\xd8\xec\xb7\x17\xd6\x45\x34\x8d\x92\xb2\x47\x20\xea\xde\x64\xe9\xa2\xc7\x11\xb0\x46\x9c\x03\x37\x67\x1a\xec\xcf\x4e\x8e\x70\x95\x91\x3f\x64\x25\xef\x05\x4f\xb7\x15\x4b\x5b\x3f\x46\x69\x0a\x3c\xcd\xdb\xee\x10\xc8\xce\xef\xd7\xf2\xf9\xdd\xdc\xab\x07\xc1\x38\x1a\x7d\xa8\x5d\xf8\x37\xff\x07\x6e\x99\x7f\x48\x70\x89\x00\x00")
 
 func cmdInternalPagesAssetsJsContainersJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -126,7 +126,7 @@ func cmdInternalPagesAssetsJsContainersJs() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "cmd/internal/pages/assets/js/containers.js", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa9, 0x8b, 0x82, 0xb8, 0xe0, 0x68, 0x92, 0x21, 0x9, 0xc6, 0xea, 0xfa, 0x2d, 0x53, 0xd5, 0xf3, 0x57, 0x2, 0xe9, 0xae, 0x9b, 0x54, 0x52, 0xc9, 0xa4, 0xf3, 0x48, 0x7b, 0x72, 0x98, 0xd5, 0x8c}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2c, 0x25, 0x55, 0x5c, 0x63, 0x86, 0x60, 0x53, 0xd2, 0xa8, 0xf7, 0xb6, 0x3b, 0xce, 0x60, 0x3c, 0x3d, 0x74, 0xe5, 0x4a, 0xa5, 0x8e, 0x33, 0x5d, 0x9d, 0xbd, 0x22, 0x4f, 0x9f, 0xb8, 0xdc, 0xec}}
 	return a, nil
 }
 
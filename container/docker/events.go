@@ -0,0 +1,92 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package docker
+
+import (
+	"context"
+	"flag"
+
+	"github.com/moby/moby/api/types/events"
+	dclient "github.com/moby/moby/client"
+
+	"github.com/google/cadvisor/lib/container/common"
+	"github.com/google/cadvisor/lib/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var argDockerEvents = flag.Bool("docker_events", false, "Subscribe to docker's event stream to pick up containers as soon as they start and renames and updates as they happen, on top of watching the cgroup hierarchy")
+
+// newEventWatcher returns a watcher of the containers reported by docker's
+// event stream.
+func newEventWatcher(client dclient.APIClient) watcher.ContainerWatcher {
+	return common.NewRuntimeEventWatcher("docker", eventSource(client), common.HostRootFs())
+}
+
+func eventSource(client dclient.APIClient) common.RuntimeEventSource {
+	return func(ctx context.Context, handle func(common.RuntimeEvent)) error {
+		filters := make(dclient.Filters).
+			Add("type", string(events.ContainerEventType)).
+			Add("event", string(events.ActionStart), string(events.ActionDie), string(events.ActionRename), string(events.ActionUpdate))
+		result := client.Events(ctx, dclient.EventsListOptions{Filters: filters})
+		for {
+			select {
+			case message := <-result.Messages:
+				event, ok := RuntimeEvent(message)
+				if !ok {
+					continue
+				}
+				// Start events do not carry the PID the container's cgroup is
+				// found from.
+				if event.Type == watcher.ContainerAdd {
+					res, err := client.ContainerInspect(ctx, event.ID, dclient.ContainerInspectOptions{})
+					if err != nil {
+						klog.V(4).Infof("Failed to inspect started docker container %q: %v", event.ID, err)
+						continue
+					}
+					if res.Container.State != nil {
+						event.Pid = res.Container.State.Pid
+					}
+				}
+				handle(event)
+			case err := <-result.Err:
+				return err
+			}
+		}
+	}
+}
+
+// RuntimeEvent returns the container event of a docker event message, if it
+// affects monitored containers. Podman's docker-compatible event stream is
+// read the same way.
+func RuntimeEvent(message events.Message) (common.RuntimeEvent, bool) {
+	if message.Type != events.ContainerEventType {
+		return common.RuntimeEvent{}, false
+	}
+	event := common.RuntimeEvent{ID: message.Actor.ID}
+	switch message.Action {
+	case events.ActionStart:
+		event.Type = watcher.ContainerAdd
+	case events.ActionDie:
+		event.Type = watcher.ContainerDelete
+	case events.ActionRename, events.ActionUpdate:
+		event.Type = watcher.ContainerUpdate
+	default:
+		return common.RuntimeEvent{}, false
+	}
+	return event, true
+}
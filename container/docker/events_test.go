@@ -0,0 +1,62 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/moby/moby/api/types/events"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/lib/container/common"
+	"github.com/google/cadvisor/lib/watcher"
+)
+
+func TestRuntimeEvent(t *testing.T) {
+	tests := []struct {
+		message  events.Message
+		expected common.RuntimeEvent
+		ok       bool
+	}{
+		{
+			message:  events.Message{Type: events.ContainerEventType, Action: events.ActionStart, Actor: events.Actor{ID: "abc"}},
+			expected: common.RuntimeEvent{Type: watcher.ContainerAdd, ID: "abc"},
+			ok:       true,
+		},
+		{
+			message:  events.Message{Type: events.ContainerEventType, Action: events.ActionDie, Actor: events.Actor{ID: "abc"}},
+			expected: common.RuntimeEvent{Type: watcher.ContainerDelete, ID: "abc"},
+			ok:       true,
+		},
+		{
+			message:  events.Message{Type: events.ContainerEventType, Action: events.ActionRename, Actor: events.Actor{ID: "abc"}},
+			expected: common.RuntimeEvent{Type: watcher.ContainerUpdate, ID: "abc"},
+			ok:       true,
+		},
+		{
+			message: events.Message{Type: events.ContainerEventType, Action: events.ActionPause, Actor: events.Actor{ID: "abc"}},
+		},
+		{
+			message: events.Message{Type: events.ImageEventType, Action: events.ActionDelete, Actor: events.Actor{ID: "sha256:abc"}},
+		},
+	}
+	for _, test := range tests {
+		event, ok := RuntimeEvent(test.message)
+		assert.Equal(t, test.ok, ok, "%+v", test.message)
+		assert.Equal(t, test.expected, event, "%+v", test.message)
+	}
+}
@@ -18,6 +18,7 @@ package docker
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"regexp"
@@ -49,6 +50,8 @@ var ArgDockerCert = flag.String("docker-tls-cert", "cert.pem", "path to client c
 var ArgDockerKey = flag.String("docker-tls-key", "key.pem", "path to private key")
 var ArgDockerCA = flag.String("docker-tls-ca", "ca.pem", "path to trusted CA")
 
+var argDockerStatsSource = flag.String("docker_stats_source", StatsSourceAuto, "Where to read the stats of docker containers from: \"cgroup\" for their cgroups, \"api\" for the stats endpoint of the Docker Engine API, or \"auto\" for their cgroups unless cAdvisor cannot see them, e.g. with rootless Docker")
+
 var dockerEnvMetadataWhiteList = flag.String("docker_env_metadata_whitelist", "", "DEPRECATED: this flag will be removed, please use `env_metadata_whitelist`. A comma-separated list of environment variable keys matched with specified prefix that needs to be collected for docker containers")
 
 // The namespace under which Docker aliases are unique.
@@ -102,15 +105,22 @@ const (
 	Overlay2StorageDriver              StorageDriver = "overlay2"
 	ContainerdSnapshotterStorageDriver StorageDriver = "overlayfs"
 	ZfsStorageDriver                   StorageDriver = "zfs"
+	BtrfsStorageDriver                 StorageDriver = "btrfs"
 	VfsStorageDriver                   StorageDriver = "vfs"
 )
 
+// errNoSystemDaemon is returned for the containers of the system-wide Docker
+// daemon when only rootless daemons are monitored.
+var errNoSystemDaemon = errors.New("no system-wide docker daemon")
+
 type dockerFactory struct {
 	machineInfoFactory info.MachineInfoFactory
 
 	storageDriver StorageDriver
 	storageDir    string
 
+	// The client of the system-wide daemon, nil if there is none and only
+	// rootless daemons are monitored.
 	client           *dclient.Client
 	containerdClient containerd.ContainerdClient
 
@@ -126,10 +136,16 @@ type dockerFactory struct {
 
 	includedMetrics container.MetricSet
 
+	// Where to read the stats of containers from, one of the StatsSource*
+	// constants.
+	statsSource string
+
 	thinPoolName    string
 	thinPoolWatcher *devicemapper.ThinPoolWatcher
 
 	zfsWatcher *zfs.ZfsWatcher
+
+	imageLayers ImageLayers
 }
 
 func (f *dockerFactory) String() string {
@@ -141,6 +157,17 @@ func (f *dockerFactory) NewContainerHandler(name string, metadataEnvAllowList []
 	if err != nil {
 		return
 	}
+	storageDriver, storageDir, dockerVersion := f.storageDriver, f.storageDir, f.dockerVersion
+	user, rootless := RootlessUID(name)
+	if rootless {
+		daemon, err := rootlessDaemonOf(user)
+		if err != nil {
+			return nil, err
+		}
+		client, storageDriver, storageDir, dockerVersion = daemon.client, daemon.storageDriver, daemon.storageDir, daemon.dockerVersion
+	} else if f.client == nil {
+		return nil, errNoSystemDaemon
+	}
 
 	dockerMetadataEnvAllowList := strings.Split(*dockerEnvMetadataWhiteList, ",")
 
@@ -153,15 +180,17 @@ func (f *dockerFactory) NewContainerHandler(name string, metadataEnvAllowList []
 		client,
 		f.containerdClient,
 		name,
+		user,
 		f.machineInfoFactory,
 		f.fsInfo,
-		f.storageDriver,
-		f.storageDir,
+		storageDriver,
+		storageDir,
 		f.cgroupSubsystems,
 		inHostNamespace,
 		dockerMetadataEnvAllowList,
-		f.dockerVersion,
+		dockerVersion,
 		f.includedMetrics,
+		f.statsSource,
 		f.thinPoolName,
 		f.thinPoolWatcher,
 		f.zfsWatcher,
@@ -179,8 +208,19 @@ func (f *dockerFactory) CanHandleAndAccept(name string) (bool, bool, error) {
 	// Check if the container is known to docker and it is active.
 	id := dockerutil.ContainerNameToId(name)
 
+	client := f.client
+	if user, rootless := RootlessUID(name); rootless {
+		daemon, err := rootlessDaemonOf(user)
+		if err != nil {
+			return false, true, err
+		}
+		client = daemon.client
+	} else if client == nil {
+		return false, false, nil
+	}
+
 	// We assume that if Inspect fails then the container is not known to docker.
-	res, err := f.client.ContainerInspect(context.Background(), id, dclient.ContainerInspectOptions{})
+	res, err := client.ContainerInspect(context.Background(), id, dclient.ContainerInspectOptions{})
 	if err != nil || !res.Container.State.Running {
 		return false, true, fmt.Errorf("error inspecting container: %v", err)
 	}
@@ -309,24 +349,51 @@ func ensureThinLsKernelVersion(kernelVersion string) error {
 
 // Register root container before running this function!
 func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
+	_, err := register(factory, fsInfo, includedMetrics)
+	return err
+}
+
+// register registers the docker factory and returns the watcher of docker's
+// event stream, nil unless --docker_events is set.
+func register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) (watcher.ContainerWatcher, error) {
 	client, err := Client()
 	if err != nil {
-		return fmt.Errorf("unable to communicate with docker daemon: %v", err)
+		return nil, fmt.Errorf("unable to communicate with docker daemon: %v", err)
 	}
 
 	dockerInfo, err := ValidateInfo(Info, VersionString)
 	if err != nil {
-		return fmt.Errorf("failed to validate Docker info: %v", err)
+		if *ArgUserRuntimeDir == "" {
+			return nil, fmt.Errorf("failed to validate Docker info: %v", err)
+		}
+		// The rootless daemons of users are looked for as their containers
+		// show up, there need not be a system-wide one.
+		klog.Warningf("Only monitoring rootless docker containers: failed to validate Docker info: %v", err)
+		client, dockerInfo = nil, &dockersystem.Info{}
 	}
 
-	// Version already validated above, assume no error here.
-	dockerVersion, _ := ParseVersion(dockerInfo.ServerVersion, VersionRe, 3)
+	var dockerVersion, dockerAPIVersion []int
+	if client != nil {
+		// Version already validated above, assume no error here.
+		dockerVersion, _ = ParseVersion(dockerInfo.ServerVersion, VersionRe, 3)
+		dockerAPIVersion, _ = APIVersion()
+	}
 
-	dockerAPIVersion, _ := APIVersion()
+	statsSource := *argDockerStatsSource
+	switch statsSource {
+	case StatsSourceCgroup, StatsSourceAPI, StatsSourceAuto:
+	default:
+		return nil, fmt.Errorf("unknown docker stats source %q", statsSource)
+	}
 
 	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
 	if err != nil {
-		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+		if statsSource == StatsSourceCgroup {
+			return nil, fmt.Errorf("failed to get cgroup subsystems: %v", err)
+		}
+		// Stats are read from the Docker Engine for every container.
+		klog.Warningf("Reading the stats of docker containers from the docker API: failed to get cgroup subsystems: %v", err)
+		cgroupSubsystems = map[string]string{}
 	}
 
 	var (
@@ -358,10 +425,15 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics
 	if StorageDriver(dockerInfo.Driver) == ContainerdSnapshotterStorageDriver {
 		containerdClient, err = containerd.Client(*containerd.ArgContainerdEndpoint, "moby")
 		if err != nil {
-			return fmt.Errorf("unable to create containerd client: %v", err)
+			return nil, fmt.Errorf("unable to create containerd client: %v", err)
 		}
 	}
 
+	storageDir := ""
+	if client != nil {
+		storageDir = RootDir()
+	}
+
 	klog.V(1).Infof("Registering Docker factory")
 	f := &dockerFactory{
 		cgroupSubsystems:   cgroupSubsystems,
@@ -372,13 +444,17 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics
 		fsInfo:             fsInfo,
 		machineInfoFactory: factory,
 		storageDriver:      StorageDriver(dockerInfo.Driver),
-		storageDir:         RootDir(),
+		storageDir:         storageDir,
 		includedMetrics:    includedMetrics,
+		statsSource:        statsSource,
 		thinPoolName:       thinPoolName,
 		thinPoolWatcher:    thinPoolWatcher,
 		zfsWatcher:         zfsWatcher,
 	}
 
-	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
-	return nil
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw, watcher.Runtime})
+	if !*argDockerEvents || client == nil {
+		return nil, nil
+	}
+	return newEventWatcher(client), nil
 }
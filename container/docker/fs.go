@@ -54,7 +54,7 @@ func FsStats(
 		switch storageDriver {
 		case DevicemapperStorageDriver:
 			device = poolName
-		case AufsStorageDriver, OverlayStorageDriver, Overlay2StorageDriver, VfsStorageDriver:
+		case AufsStorageDriver, OverlayStorageDriver, Overlay2StorageDriver, VfsStorageDriver, BtrfsStorageDriver:
 			deviceInfo, err := globalFsInfo.GetDirFsDevice(rootfsStorageDir)
 			if err != nil {
 				return fmt.Errorf("unable to determine device info for dir: %v: %v", rootfsStorageDir, err)
@@ -76,12 +76,17 @@ func FsStats(
 					BaseUsage: usage.BaseUsageBytes,
 					Usage:     usage.TotalUsageBytes,
 					Inodes:    usage.InodeUsage,
+					Overlay:   common.OverlayStats(usage.Overlay),
+					Btrfs:     common.QgroupStats(usage.Qgroup),
 				}
 				fileSystems, err := globalFsInfo.GetGlobalFsInfo()
 				if err != nil {
 					return fmt.Errorf("unable to obtain diskstats for filesystem %s: %v", fsStat.Device, err)
 				}
 				addDiskStats(fileSystems, &fs, &fsStat)
+				if h, ok := fsHandler.(*FsHandler); ok {
+					fsStat.Zfs = h.zfsDatasetStats()
+				}
 				stats.Filesystem = append(stats.Filesystem, fsStat)
 				break
 			}
@@ -173,3 +178,22 @@ func (h *FsHandler) Usage() common.FsUsage {
 	}
 	return usage
 }
+
+// zfsDatasetStats returns the cached stats of the container's zfs dataset, or
+// nil if zfs is not the storage driver or they are not known yet.
+func (h *FsHandler) zfsDatasetStats() *info.ZfsDatasetStats {
+	if h.ZfsWatcher == nil {
+		return nil
+	}
+	ds, err := h.ZfsWatcher.GetDataset(h.ZfsFilesystem)
+	if err != nil {
+		return nil
+	}
+	return &info.ZfsDatasetStats{
+		Dataset:         ds.Name,
+		Used:            ds.Used,
+		Referenced:      ds.Referenced,
+		UsedBySnapshots: ds.UsedBySnapshots,
+		CompressRatio:   ds.CompressRatio,
+	}
+}
@@ -25,8 +25,10 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	dockercontainer "github.com/moby/moby/api/types/container"
 	dclient "github.com/moby/moby/client"
 	"github.com/opencontainers/cgroups"
 	"github.com/opencontainers/runtime-spec/specs-go"
@@ -50,6 +52,8 @@ const (
 	aufsRWLayer     = "diff"
 	overlayRWLayer  = "upper"
 	overlay2RWLayer = "diff"
+	// The btrfs driver makes a subvolume of each layer in this directory.
+	btrfsSubvolumesDir = "subvolumes"
 
 	// Path to the directory where docker stores log files if the json logging driver is enabled.
 	pathToContainersDir = "containers"
@@ -75,11 +79,16 @@ type containerHandler struct {
 	startTime time.Time
 
 	// Metadata associated with the container.
-	envs   map[string]string
-	labels map[string]string
+	envs map[string]string
+	// Lock for the aliases in reference and the restart count, which change
+	// when the container is renamed or restarted.
+	metadataLock sync.RWMutex
+	labels       map[string]string
+	restartCount int
 
-	// Image name used for this container.
-	image string
+	// Image name used for this container, and the ID of the image.
+	image   string
+	imageID string
 
 	// Filesystem handler.
 	fsHandler common.FsHandler
@@ -98,12 +107,36 @@ type containerHandler struct {
 	// Reference to the container
 	reference info.ContainerReference
 
+	// The UID of the user whose rootless Docker daemon runs the container,
+	// empty for containers of the system daemon.
+	user string
+
+	// Handler of the container's cgroups, nil if its stats are read from the
+	// Docker Engine.
 	libcontainerHandler *containerlibcontainer.Handler
 
+	// Whether the stats of the container are read from the Docker Engine
+	// rather than from its cgroups, and the resources it was given, which
+	// then stand for those its cgroups would report.
+	statsFromAPI bool
+	resources    dockercontainer.Resources
+
 	// the docker client is needed to inspect the container and get the health status
 	client dclient.APIClient
+
+	// Whether the container was running when last inspected, and when, which
+	// Exists reuses in API mode for existsCacheDuration.
+	inspectLock sync.Mutex
+	running     bool
+	inspectedAt time.Time
 }
 
+// How long Exists reuses the last inspection of a container whose stats are
+// read from the Docker Engine: the default housekeeping interval, so that the
+// container is inspected about once per housekeeping however many times its
+// housekeeping checks whether it still exists.
+const existsCacheDuration = time.Second
+
 var _ container.ContainerHandler = &containerHandler{}
 
 func getRwLayerID(containerID, storageDir string, sd StorageDriver, dockerVersion []int) (string, error) {
@@ -128,6 +161,7 @@ func newContainerHandler(
 	client *dclient.Client,
 	containerdClient containerd.ContainerdClient,
 	name string,
+	user string,
 	machineInfoFactory info.MachineInfoFactory,
 	fsInfo fs.FsInfo,
 	storageDriver StorageDriver,
@@ -137,6 +171,7 @@ func newContainerHandler(
 	metadataEnvAllowList []string,
 	dockerVersion []int,
 	metrics container.MetricSet,
+	statsSource string,
 	thinPoolName string,
 	thinPoolWatcher *devicemapper.ThinPoolWatcher,
 	zfsWatcher *zfs.ZfsWatcher,
@@ -144,10 +179,18 @@ func newContainerHandler(
 	// Create the cgroup paths.
 	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
 
+	// The cgroups of containers are not visible to cAdvisor with rootless
+	// Docker or when it runs in another cgroup namespace.
+	statsFromAPI := statsSource == StatsSourceAPI || (statsSource == StatsSourceAuto && !common.CgroupExists(cgroupPaths))
+
 	// Generate the equivalent cgroup manager for this container.
-	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
-	if err != nil {
-		return nil, err
+	var cgroupManager cgroups.Manager
+	if !statsFromAPI {
+		var err error
+		cgroupManager, err = containerlibcontainer.NewCgroupManager(name, cgroupPaths)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	rootFs := "/"
@@ -163,7 +206,13 @@ func newContainerHandler(
 	otherStorageDir := path.Join(storageDir, pathToContainersDir, id)
 
 	var rootfsStorageDir, zfsFilesystem, zfsParent string
-	if storageDriver == ContainerdSnapshotterStorageDriver {
+	var storageUnknown bool
+	switch {
+	case storageDriver == ContainerdSnapshotterStorageDriver && user != "":
+		// The containerd of rootless Docker runs in the user's namespaces.
+		klog.V(4).Infof("No filesystem stats for container %q of rootless docker", id)
+		storageUnknown = true
+	case storageDriver == ContainerdSnapshotterStorageDriver:
 		ctx := namespaces.WithNamespace(context.Background(), "moby")
 		cntr, err := containerdClient.LoadContainer(ctx, id)
 		if err != nil {
@@ -175,17 +224,23 @@ func newContainerHandler(
 			return nil, err
 		}
 		rootfsStorageDir = spec.Root.Path
-	} else {
+	default:
 		rwLayerID, err := getRwLayerID(id, storageDir, storageDriver, dockerVersion)
-		if err != nil {
-			return nil, err
+		if err == nil {
+			// Determine the rootfs storage dir OR the pool name to determine the device.
+			// For devicemapper, we only need the thin pool name, and that is passed in to this call
+			rootfsStorageDir, zfsFilesystem, zfsParent, err = DetermineDeviceStorage(storageDriver, storageDir, rwLayerID)
+			if err != nil {
+				err = fmt.Errorf("unable to determine device storage: %v", err)
+			}
 		}
-
-		// Determine the rootfs storage dir OR the pool name to determine the device.
-		// For devicemapper, we only need the thin pool name, and that is passed in to this call
-		rootfsStorageDir, zfsFilesystem, zfsParent, err = DetermineDeviceStorage(storageDriver, storageDir, rwLayerID)
-		if err != nil {
-			return nil, fmt.Errorf("unable to determine device storage: %v", err)
+		switch {
+		case err != nil && (statsFromAPI || user != ""):
+			// The storage of rootless Docker need not be visible.
+			klog.V(4).Infof("No filesystem stats for container %q: %v", id, err)
+			storageUnknown = true
+		case err != nil:
+			return nil, err
 		}
 	}
 
@@ -219,6 +274,9 @@ func newContainerHandler(
 
 	// Do not report network metrics for containers that share netns with another container.
 	includedMetrics := common.RemoveNetMetrics(metrics, ctnr.HostConfig.NetworkMode.IsContainer())
+	if storageUnknown {
+		includedMetrics = includedMetrics.Difference(container.MetricSet{container.DiskUsageMetrics: struct{}{}})
+	}
 
 	handler := &containerHandler{
 		machineInfoFactory: machineInfoFactory,
@@ -230,10 +288,12 @@ func newContainerHandler(
 		envs:               make(map[string]string),
 		labels:             ctnr.Config.Labels,
 		image:              ctnr.Config.Image,
+		imageID:            ctnr.Image,
 		metrics:            includedMetrics,
 		thinPoolName:       thinPoolName,
 		zfsParent:          zfsParent,
 		client:             client,
+		user:               user,
 		reference: info.ContainerReference{
 			// Add the name and bare ID as aliases of the container.
 			Id:        id,
@@ -241,7 +301,15 @@ func newContainerHandler(
 			Aliases:   []string{strings.TrimPrefix(ctnr.Name, "/"), id},
 			Namespace: DockerNamespace,
 		},
-		libcontainerHandler: containerlibcontainer.NewHandler(cgroupManager, rootFs, ctnr.State.Pid, metrics),
+		statsFromAPI: statsFromAPI,
+	}
+	if ctnr.HostConfig != nil {
+		handler.resources = ctnr.HostConfig.Resources
+	}
+	if statsFromAPI {
+		klog.V(4).Infof("Reading the stats of container %q from the docker API", id)
+	} else {
+		handler.libcontainerHandler = containerlibcontainer.NewHandler(cgroupManager, rootFs, ctnr.State.Pid, metrics)
 	}
 
 	// Timestamp returned by Docker is in time.RFC3339Nano format.
@@ -260,6 +328,7 @@ func newContainerHandler(
 	if ctnr.RestartCount > 0 {
 		handler.labels["restartcount"] = strconv.Itoa(ctnr.RestartCount)
 	}
+	handler.restartCount = ctnr.RestartCount
 
 	if includedMetrics.Has(container.DiskUsageMetrics) {
 		var deviceID string
@@ -268,8 +337,17 @@ func newContainerHandler(
 		} else {
 			klog.V(4).Infof("GraphDriver not found for container %q", id)
 		}
+		fsHandler := common.NewFsHandler(common.DefaultPeriod, rootfsStorageDir, otherStorageDir, fsInfo)
+		if ctnr.State.Pid > 0 {
+			// Relative overlay layers are under the directory of the driver.
+			layers := common.RootLayersFunc(rootFs, ctnr.State.Pid, path.Join(storageDir, string(storageDriver)))
+			fsHandler = common.NewOverlayFsHandler(common.DefaultPeriod, rootfsStorageDir, otherStorageDir, fsInfo, layers)
+		}
+		if fh, ok := fsHandler.(common.FsHandlerWithLimit); ok && ctnr.HostConfig != nil {
+			fh.SetLimit(dockerutil.StorageSizeLimit(ctnr.HostConfig.StorageOpt))
+		}
 		handler.fsHandler = &FsHandler{
-			FsHandler:       common.NewFsHandler(common.DefaultPeriod, rootfsStorageDir, otherStorageDir, fsInfo),
+			FsHandler:       fsHandler,
 			ThinPoolWatcher: thinPoolWatcher,
 			ZfsWatcher:      zfsWatcher,
 			DeviceID:        deviceID,
@@ -308,6 +386,10 @@ func DetermineDeviceStorage(storageDriver StorageDriver, storageDir string, rwLa
 		rootfsStorageDir = path.Join(storageDir, string(storageDriver), rwLayerID, overlay2RWLayer)
 	case VfsStorageDriver:
 		rootfsStorageDir = path.Join(storageDir)
+	case BtrfsStorageDriver:
+		// The writable layer is a subvolume, whose usage the btrfs fs plugin
+		// reads from its qgroup.
+		rootfsStorageDir = path.Join(storageDir, string(BtrfsStorageDriver), btrfsSubvolumesDir, rwLayerID)
 	case ZfsStorageDriver:
 		var status info.DockerStatus
 		status, err = Status()
@@ -321,29 +403,70 @@ func DetermineDeviceStorage(storageDriver StorageDriver, storageDir string, rwLa
 }
 
 func (h *containerHandler) ContainerReference() (info.ContainerReference, error) {
+	h.metadataLock.RLock()
+	defer h.metadataLock.RUnlock()
 	return h.reference, nil
 }
 
+// RefreshMetadata implements container.MetadataRefresher: it picks up the
+// container's current name and restart count.
+func (h *containerHandler) RefreshMetadata() error {
+	res, err := h.client.ContainerInspect(context.Background(), h.reference.Id, dclient.ContainerInspectOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to inspect container %q: %v", h.reference.Id, err)
+	}
+	ctnr := res.Container
+
+	h.metadataLock.Lock()
+	defer h.metadataLock.Unlock()
+	h.reference.Aliases = []string{strings.TrimPrefix(ctnr.Name, "/"), h.reference.Id}
+	// The restartcount label keeps the count the handler was created with, so
+	// that restarts in place do not start new series; the current count is in
+	// the spec.
+	h.restartCount = ctnr.RestartCount
+	return nil
+}
+
 func (h *containerHandler) GetSpec() (info.ContainerSpec, error) {
 	hasFilesystem := h.metrics.Has(container.DiskUsageMetrics)
 	hasNetwork := h.metrics.Has(container.NetworkUsageMetrics)
-	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNetwork, hasFilesystem)
-	if err != nil {
-		return info.ContainerSpec{}, err
+	var spec info.ContainerSpec
+	if h.statsFromAPI {
+		spec = specFromAPI(h.resources)
+		spec.HasNetwork = hasNetwork
+		spec.HasFilesystem = hasFilesystem
+		spec.HasDiskIo = h.metrics.Has(container.DiskIOMetrics)
+	} else {
+		var err error
+		spec, err = common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNetwork, hasFilesystem)
+		if err != nil {
+			return info.ContainerSpec{}, err
+		}
 	}
 
+	h.metadataLock.RLock()
 	spec.Labels = h.labels
+	spec.RestartCount = h.restartCount
+	h.metadataLock.RUnlock()
 	spec.Envs = h.envs
 	spec.Image = h.image
+	spec.ImageID = h.imageID
 	spec.CreationTime = h.creationTime
 	spec.StartTime = h.startTime
+	spec.User = h.user
 
 	return spec, nil
 }
 
 func (h *containerHandler) GetStats() (*info.ContainerStats, error) {
-	// TODO(vmarmol): Get from libcontainer API instead of cgroup manager when we don't have to support older Dockers.
-	stats, err := h.libcontainerHandler.GetStats()
+	var stats *info.ContainerStats
+	var err error
+	if h.statsFromAPI {
+		stats, err = h.apiStats()
+	} else {
+		// TODO(vmarmol): Get from libcontainer API instead of cgroup manager when we don't have to support older Dockers.
+		stats, err = h.libcontainerHandler.GetStats()
+	}
 	if err != nil {
 		return stats, err
 	}
@@ -354,6 +477,7 @@ func (h *containerHandler) GetStats() (*info.ContainerStats, error) {
 		return nil, fmt.Errorf("failed to inspect container %q: %v", h.reference.Id, err)
 	}
 	ctnr := res.Container
+	h.setInspected(ctnr.State != nil && ctnr.State.Running)
 
 	if ctnr.State.Health != nil {
 		stats.Health.Status = string(ctnr.State.Health.Status)
@@ -374,6 +498,9 @@ func (h *containerHandler) ListContainers(container.ListType) ([]info.ContainerR
 }
 
 func (h *containerHandler) ListProcesses(container.ListType) ([]int, error) {
+	if h.statsFromAPI {
+		return h.apiProcesses()
+	}
 	return h.libcontainerHandler.GetProcesses()
 }
 
@@ -390,6 +517,8 @@ func (h *containerHandler) GetCgroupPath(resource string) (string, error) {
 }
 
 func (h *containerHandler) GetContainerLabels() map[string]string {
+	h.metadataLock.RLock()
+	defer h.metadataLock.RUnlock()
 	return h.labels
 }
 
@@ -398,7 +527,26 @@ func (h *containerHandler) GetContainerIPAddress() string {
 }
 
 func (h *containerHandler) Exists() bool {
-	return common.CgroupExists(h.cgroupPaths)
+	if !h.statsFromAPI {
+		return common.CgroupExists(h.cgroupPaths)
+	}
+	h.inspectLock.Lock()
+	running, fresh := h.running, time.Since(h.inspectedAt) < existsCacheDuration
+	h.inspectLock.Unlock()
+	if fresh {
+		return running
+	}
+	res, err := h.client.ContainerInspect(context.Background(), h.reference.Id, dclient.ContainerInspectOptions{})
+	running = err == nil && res.Container.State != nil && res.Container.State.Running
+	h.setInspected(running)
+	return running
+}
+
+// setInspected records whether the container was running when just inspected.
+func (h *containerHandler) setInspected(running bool) {
+	h.inspectLock.Lock()
+	defer h.inspectLock.Unlock()
+	h.running, h.inspectedAt = running, time.Now()
 }
 
 func (h *containerHandler) Cleanup() {
@@ -430,3 +578,27 @@ func (h *containerHandler) GetExitCode() (int, error) {
 
 	return ctnr.State.ExitCode, nil
 }
+
+// GetExitStatus implements container.ExitStatusHandler.
+func (h *containerHandler) GetExitStatus() (container.ExitStatus, error) {
+	res, err := h.client.ContainerInspect(context.Background(), h.reference.Id, dclient.ContainerInspectOptions{})
+	if err != nil {
+		return container.ExitStatus{ExitCode: -1}, fmt.Errorf("failed to inspect container %s: %w", h.reference.Id, err)
+	}
+	ctnr := res.Container
+
+	if ctnr.State.Running {
+		return container.ExitStatus{ExitCode: -1}, fmt.Errorf("container %s is still running", h.reference.Id)
+	}
+
+	status := container.ExitStatus{
+		ExitCode:   ctnr.State.ExitCode,
+		Signal:     container.SignalFromExitCode(ctnr.State.ExitCode),
+		StartedAt:  common.ParseStateTime(ctnr.State.StartedAt),
+		FinishedAt: common.ParseStateTime(ctnr.State.FinishedAt),
+	}
+	if status.StartedAt.IsZero() {
+		status.StartedAt = h.startTime
+	}
+	return status, nil
+}
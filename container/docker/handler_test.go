@@ -27,6 +27,7 @@ import (
 	"github.com/moby/moby/api/types/container"
 	dclient "github.com/moby/moby/client"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	info "github.com/google/cadvisor/info/v1"
 	"github.com/google/cadvisor/lib/fs"
@@ -317,3 +318,24 @@ func TestGetExitCode(t *testing.T) {
 		})
 	}
 }
+
+func TestRefreshMetadataRestartCount(t *testing.T) {
+	client := &mockDockerClientForStats{restartCount: 3}
+	h := &containerHandler{
+		client:             client,
+		machineInfoFactory: machineInfoFactory{},
+		statsFromAPI:       true,
+		labels:             map[string]string{"restartcount": "1"},
+		restartCount:       1,
+		reference:          info.ContainerReference{Id: "test-container-id"},
+	}
+
+	require.NoError(t, h.RefreshMetadata())
+	spec, err := h.GetSpec()
+	require.NoError(t, err)
+	// The label keeps the count the container was first seen with, so its
+	// series do not change on restarts in place.
+	assert.Equal(t, 3, spec.RestartCount)
+	assert.Equal(t, map[string]string{"restartcount": "1"}, spec.Labels)
+	assert.Equal(t, []string{"test-container", "test-container-id"}, h.reference.Aliases)
+}
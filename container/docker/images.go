@@ -0,0 +1,84 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package docker
+
+import (
+	"context"
+	"sync"
+
+	dockerimage "github.com/moby/moby/api/types/image"
+	dclient "github.com/moby/moby/client"
+	"k8s.io/klog/v2"
+
+	dockerutil "github.com/google/cadvisor/container/docker/utils"
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/lib/container"
+)
+
+var _ container.ImageStoreProvider = &dockerFactory{}
+
+// ImageLayers caches the number of layers of images, which cannot change for
+// a given image ID, so that each image is only inspected once.
+type ImageLayers struct {
+	lock   sync.Mutex
+	layers map[string]int
+}
+
+// Update returns the number of layers of the given images, by ID, inspecting
+// those it has not seen yet, and forgets the images that are gone.
+func (l *ImageLayers) Update(summaries []dockerimage.Summary, inspect func(id string) (int, error)) map[string]int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	layers := make(map[string]int, len(summaries))
+	for _, summary := range summaries {
+		n, ok := l.layers[summary.ID]
+		if !ok {
+			var err error
+			n, err = inspect(summary.ID)
+			if err != nil {
+				// The image may have been removed since it was listed.
+				klog.V(4).Infof("Failed to inspect image %q: %v", summary.ID, err)
+				continue
+			}
+		}
+		layers[summary.ID] = n
+	}
+	l.layers = layers
+	return layers
+}
+
+// GetImageStore implements container.ImageStoreProvider.
+func (f *dockerFactory) GetImageStore() (info.ImageStore, error) {
+	if f.client == nil {
+		return info.ImageStore{}, errNoSystemDaemon
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), dockerTimeout)
+	defer cancel()
+	res, err := f.client.DiskUsage(ctx, dclient.DiskUsageOptions{Images: true, Verbose: true})
+	if err != nil {
+		return info.ImageStore{}, err
+	}
+	summaries := res.Images.Items
+	layers := f.imageLayers.Update(summaries, func(id string) (int, error) {
+		res, err := f.client.ImageInspect(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		return len(res.RootFS.Layers), nil
+	})
+	return dockerutil.SummariesToImageStore(DockerNamespace, res.Images.TotalSize, summaries, layers), nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package docker
+
+import (
+	"errors"
+	"testing"
+
+	dockerimage "github.com/moby/moby/api/types/image"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageLayers(t *testing.T) {
+	var inspected []string
+	inspect := func(id string) (int, error) {
+		inspected = append(inspected, id)
+		if id == "sha256:gone" {
+			return 0, errors.New("no such image")
+		}
+		return len(inspected), nil
+	}
+	var l ImageLayers
+
+	layers := l.Update([]dockerimage.Summary{{ID: "sha256:aaa"}, {ID: "sha256:gone"}}, inspect)
+	assert.Equal(t, map[string]int{"sha256:aaa": 1}, layers)
+
+	// Known images are not inspected again, and removed ones are forgotten.
+	layers = l.Update([]dockerimage.Summary{{ID: "sha256:aaa"}, {ID: "sha256:bbb"}}, inspect)
+	assert.Equal(t, map[string]int{"sha256:aaa": 1, "sha256:bbb": 3}, layers)
+	assert.Equal(t, []string{"sha256:aaa", "sha256:gone", "sha256:bbb"}, inspected)
+}
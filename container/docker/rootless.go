@@ -0,0 +1,88 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package docker
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	dclient "github.com/moby/moby/client"
+
+	dockerutil "github.com/google/cadvisor/container/docker/utils"
+)
+
+// ArgUserRuntimeDir is where the rootless docker and podman sockets of users
+// are looked for, in the <uid> subdirectory of each user.
+var ArgUserRuntimeDir = flag.String("user_runtime_dir", "/run/user", "Directory holding the runtime directory of each user, <dir>/<uid>, in which the sockets of rootless docker and podman are looked for. Empty disables monitoring rootless containers")
+
+// Path of the socket of rootless Docker within the runtime directory of its
+// user.
+const rootlessSocket = "docker.sock"
+
+// rootlessDaemon is the rootless Docker daemon of a user.
+type rootlessDaemon struct {
+	client        *dclient.Client
+	storageDriver StorageDriver
+	storageDir    string
+	dockerVersion []int
+}
+
+// Rootless daemons by the UID of their user.
+var rootlessDaemons = dockerutil.NewRootlessCache[*rootlessDaemon]()
+
+// RootlessUID returns the UID of the user whose rootless runtime runs the
+// container with the given cgroup name, if rootless containers are monitored.
+func RootlessUID(name string) (string, bool) {
+	if *ArgUserRuntimeDir == "" {
+		return "", false
+	}
+	return dockerutil.RootlessUID(name)
+}
+
+// rootlessDaemonOf returns the rootless Docker daemon of the user with the
+// given UID.
+func rootlessDaemonOf(uid string) (*rootlessDaemon, error) {
+	return rootlessDaemons.Get(uid, dialRootlessDaemon)
+}
+
+// dialRootlessDaemon connects to the rootless Docker daemon of the user with
+// the given UID.
+func dialRootlessDaemon(uid string) (*rootlessDaemon, error) {
+	client, err := dclient.New(dclient.WithHost(dockerutil.UserSocket(*ArgUserRuntimeDir, uid, rootlessSocket)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to communicate with the rootless docker daemon of user %s: %v", uid, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), dockerTimeout)
+	defer cancel()
+	res, err := client.Info(ctx, dclient.InfoOptions{})
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to detect the Docker info of user %s: %v", uid, err)
+	}
+	dockerVersion, err := ParseVersion(res.Info.ServerVersion, VersionRe, 3)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to parse the Docker version of user %s: %v", uid, err)
+	}
+	return &rootlessDaemon{
+		client:        client,
+		storageDriver: StorageDriver(res.Info.Driver),
+		storageDir:    res.Info.DockerRootDir,
+		dockerVersion: dockerVersion,
+	}, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package docker
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dockerutil "github.com/google/cadvisor/container/docker/utils"
+)
+
+const rootlessName = "/user.slice/user-1000.slice/user@1000.service/user.slice/docker-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope"
+
+func TestRootlessDaemon(t *testing.T) {
+	runtimeDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(runtimeDir, "1000"), 0o700))
+	listener, err := net.Listen("unix", filepath.Join(runtimeDir, "1000", rootlessSocket))
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/info") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Driver":"overlay2","DockerRootDir":"/home/alice/.local/share/docker","ServerVersion":"28.0.1"}`))
+	}))
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+
+	defer func(dir string) { *ArgUserRuntimeDir = dir }(*ArgUserRuntimeDir)
+	defer func(c *dockerutil.RootlessCache[*rootlessDaemon]) { rootlessDaemons = c }(rootlessDaemons)
+	rootlessDaemons = dockerutil.NewRootlessCache[*rootlessDaemon]()
+	*ArgUserRuntimeDir = runtimeDir
+
+	user, rootless := RootlessUID(rootlessName)
+	require.True(t, rootless)
+	assert.Equal(t, "1000", user)
+
+	daemon, err := rootlessDaemonOf(user)
+	require.NoError(t, err)
+	assert.Equal(t, Overlay2StorageDriver, daemon.storageDriver)
+	assert.Equal(t, "/home/alice/.local/share/docker", daemon.storageDir)
+	assert.Equal(t, []int{28, 0, 1}, daemon.dockerVersion)
+
+	// The daemon is only asked for its info once.
+	server.Close()
+	cached, err := rootlessDaemonOf(user)
+	require.NoError(t, err)
+	assert.Same(t, daemon, cached)
+
+	// A failed lookup is remembered.
+	_, err = rootlessDaemonOf("1001")
+	require.Error(t, err)
+	_, cachedErr := rootlessDaemonOf("1001")
+	assert.Same(t, err, cachedErr)
+
+	// Without a system-wide daemon only rootless containers are handled.
+	f := &dockerFactory{}
+	handle, accept, err := f.CanHandleAndAccept("/system.slice/docker-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope")
+	assert.NoError(t, err)
+	assert.False(t, handle)
+	assert.False(t, accept)
+
+	*ArgUserRuntimeDir = ""
+	_, rootless = RootlessUID(rootlessName)
+	assert.False(t, rootless)
+}
@@ -0,0 +1,282 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dockercontainer "github.com/moby/moby/api/types/container"
+	dclient "github.com/moby/moby/client"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/lib/container"
+)
+
+// Where the docker handler reads the stats of containers from.
+const (
+	// The cgroups of containers, through libcontainer.
+	StatsSourceCgroup = "cgroup"
+	// The stats endpoint of the Docker Engine API.
+	StatsSourceAPI = "api"
+	// The cgroups of containers when cAdvisor can see them, and the Docker
+	// Engine API otherwise, e.g. for rootless Docker.
+	StatsSourceAuto = "auto"
+)
+
+// Timeout of the requests for the stats of a container.
+const statsTimeout = 10 * time.Second
+
+// apiStats returns the stats of the container as the Docker Engine reports
+// them.
+func (h *containerHandler) apiStats() (*info.ContainerStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), statsTimeout)
+	defer cancel()
+	res, err := h.client.ContainerStats(ctx, h.reference.Id, dclient.ContainerStatsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the stats of container %q: %v", h.reference.Id, err)
+	}
+	defer res.Body.Close()
+	var response dockercontainer.StatsResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode the stats of container %q: %v", h.reference.Id, err)
+	}
+	return statsFromAPI(&response, h.metrics), nil
+}
+
+// statsFromAPI converts the stats the Docker Engine reports for a container,
+// which it reads from the container's cgroups, to cAdvisor's.
+func statsFromAPI(response *dockercontainer.StatsResponse, metrics container.MetricSet) *info.ContainerStats {
+	stats := &info.ContainerStats{
+		Timestamp: response.Read,
+		Cpu:       &info.CpuStats{},
+		Memory:    &info.MemoryStats{},
+	}
+	if stats.Timestamp.IsZero() {
+		stats.Timestamp = time.Now()
+	}
+
+	cpu := response.CPUStats
+	stats.Cpu.Usage = info.CpuUsage{
+		Total:  cpu.CPUUsage.TotalUsage,
+		PerCpu: cpu.CPUUsage.PercpuUsage,
+		User:   cpu.CPUUsage.UsageInUsermode,
+		System: cpu.CPUUsage.UsageInKernelmode,
+	}
+	stats.Cpu.CFS = info.CpuCFS{
+		Periods:          cpu.ThrottlingData.Periods,
+		ThrottledPeriods: cpu.ThrottlingData.ThrottledPeriods,
+		ThrottledTime:    cpu.ThrottlingData.ThrottledTime,
+	}
+
+	setMemoryStatsFromAPI(&response.MemoryStats, stats.Memory)
+
+	if metrics.Has(container.ProcessMetrics) {
+		stats.Processes = &info.ProcessStats{
+			ThreadsCurrent: response.PidsStats.Current,
+			ThreadsMax:     response.PidsStats.Limit,
+		}
+	}
+
+	if metrics.Has(container.DiskIOMetrics) {
+		blkio := response.BlkioStats
+		stats.DiskIo = &info.DiskIoStats{
+			IoServiceBytes: perDiskStatsFromAPI(blkio.IoServiceBytesRecursive),
+			IoServiced:     perDiskStatsFromAPI(blkio.IoServicedRecursive),
+			IoQueued:       perDiskStatsFromAPI(blkio.IoQueuedRecursive),
+			Sectors:        perDiskStatsFromAPI(blkio.SectorsRecursive),
+			IoServiceTime:  perDiskStatsFromAPI(blkio.IoServiceTimeRecursive),
+			IoWaitTime:     perDiskStatsFromAPI(blkio.IoWaitTimeRecursive),
+			IoMerged:       perDiskStatsFromAPI(blkio.IoMergedRecursive),
+			IoTime:         perDiskStatsFromAPI(blkio.IoTimeRecursive),
+		}
+	}
+
+	if metrics.Has(container.NetworkUsageMetrics) && len(response.Networks) > 0 {
+		stats.Network = &info.NetworkStats{}
+		for name, n := range response.Networks {
+			stats.Network.Interfaces = append(stats.Network.Interfaces, info.InterfaceStats{
+				Name:      name,
+				RxBytes:   n.RxBytes,
+				RxPackets: n.RxPackets,
+				RxErrors:  n.RxErrors,
+				RxDropped: n.RxDropped,
+				TxBytes:   n.TxBytes,
+				TxPackets: n.TxPackets,
+				TxErrors:  n.TxErrors,
+				TxDropped: n.TxDropped,
+			})
+		}
+		sort.Slice(stats.Network.Interfaces, func(i, j int) bool {
+			return stats.Network.Interfaces[i].Name < stats.Network.Interfaces[j].Name
+		})
+		// For backwards compatibility.
+		stats.Network.InterfaceStats = stats.Network.Interfaces[0]
+	}
+	return stats
+}
+
+// setMemoryStatsFromAPI sets ret from the memory stats the Docker Engine
+// reports, whose Stats are the memory.stat of the container's cgroup v1 or
+// v2.
+func setMemoryStatsFromAPI(memory *dockercontainer.MemoryStats, ret *info.MemoryStats) {
+	ret.Usage = memory.Usage
+	ret.MaxUsage = memory.MaxUsage
+	ret.Failcnt = memory.Failcnt
+
+	s := memory.Stats
+	inactiveFile := s["total_inactive_file"]
+	if _, ok := s["anon"]; ok {
+		// cgroup v2.
+		ret.Cache = s["file"]
+		ret.RSS = s["anon"]
+		ret.MappedFile = s["file_mapped"]
+		ret.TotalActiveFile = s["active_file"]
+		ret.FileDirty = s["file_dirty"]
+		ret.FileWriteback = s["file_writeback"]
+		ret.Pgscan = s["pgscan"]
+		ret.Pgsteal = s["pgsteal"]
+		ret.WorkingsetRefaultFile = s["workingset_refault_file"]
+		ret.WorkingsetRefaultAnon = s["workingset_refault_anon"]
+		ret.KernelUsage = s["kernel"]
+		inactiveFile = s["inactive_file"]
+	} else {
+		ret.Cache = s["total_cache"]
+		ret.RSS = s["total_rss"]
+		ret.Swap = s["total_swap"]
+		ret.MappedFile = s["total_mapped_file"]
+		ret.TotalActiveFile = s["total_active_file"]
+	}
+	ret.TotalInactiveFile = inactiveFile
+	ret.ContainerData.Pgfault = s["pgfault"]
+	ret.ContainerData.Pgmajfault = s["pgmajfault"]
+	ret.HierarchicalData = ret.ContainerData
+
+	ret.WorkingSet = ret.Usage
+	if ret.WorkingSet < inactiveFile {
+		ret.WorkingSet = 0
+	} else {
+		ret.WorkingSet -= inactiveFile
+	}
+}
+
+// perDiskStatsFromAPI groups blkio entries by device. Operations are named as
+// cgroup v1 names them, e.g. "Read", whatever the cgroup version.
+func perDiskStatsFromAPI(entries []dockercontainer.BlkioStatEntry) []info.PerDiskStats {
+	if len(entries) == 0 {
+		return nil
+	}
+	var disks []info.PerDiskStats
+	index := make(map[[2]uint64]int)
+	for _, entry := range entries {
+		device := [2]uint64{entry.Major, entry.Minor}
+		i, ok := index[device]
+		if !ok {
+			i = len(disks)
+			index[device] = i
+			disks = append(disks, info.PerDiskStats{Major: entry.Major, Minor: entry.Minor, Stats: make(map[string]uint64)})
+		}
+		op := entry.Op
+		if op != "" {
+			op = strings.ToUpper(op[:1]) + op[1:]
+		}
+		disks[i].Stats[op] += entry.Value
+	}
+	return disks
+}
+
+// specFromAPI returns the resources of a container as its host config sets
+// them, for when its cgroups cannot be read.
+func specFromAPI(resources dockercontainer.Resources) info.ContainerSpec {
+	spec := info.ContainerSpec{
+		HasCpu:    true,
+		HasMemory: true,
+		Cpu: info.CpuSpec{
+			// The default weight of cgroup v1 CPU shares.
+			Limit: 1024,
+			Mask:  resources.CpusetCpus,
+		},
+		Memory: info.MemorySpec{
+			Limit:       math.MaxUint64,
+			Reservation: math.MaxUint64,
+			SwapLimit:   math.MaxUint64,
+		},
+	}
+	if resources.CPUShares > 0 {
+		spec.Cpu.Limit = uint64(resources.CPUShares)
+	}
+	spec.Cpu.Period = 100000
+	if resources.CPUPeriod > 0 {
+		spec.Cpu.Period = uint64(resources.CPUPeriod)
+	}
+	switch {
+	case resources.CPUQuota > 0:
+		spec.Cpu.Quota = uint64(resources.CPUQuota)
+	case resources.NanoCPUs > 0:
+		spec.Cpu.Quota = uint64(resources.NanoCPUs) * spec.Cpu.Period / 1e9
+	}
+	if resources.Memory > 0 {
+		spec.Memory.Limit = uint64(resources.Memory)
+	}
+	if resources.MemoryReservation > 0 {
+		spec.Memory.Reservation = uint64(resources.MemoryReservation)
+	}
+	if resources.MemorySwap > 0 {
+		spec.Memory.SwapLimit = uint64(resources.MemorySwap)
+	}
+	if resources.PidsLimit != nil && *resources.PidsLimit > 0 {
+		spec.HasProcesses = true
+		spec.Processes.Limit = uint64(*resources.PidsLimit)
+	}
+	return spec
+}
+
+// apiProcesses returns the PIDs of the processes of the container, as the
+// Docker Engine lists them.
+func (h *containerHandler) apiProcesses() ([]int, error) {
+	res, err := h.client.ContainerTop(context.Background(), h.reference.Id, dclient.ContainerTopOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the processes of container %q: %v", h.reference.Id, err)
+	}
+	column := -1
+	for i, title := range res.Titles {
+		if title == "PID" {
+			column = i
+		}
+	}
+	if column < 0 {
+		return nil, fmt.Errorf("no PID in the processes of container %q: %v", h.reference.Id, res.Titles)
+	}
+	pids := make([]int, 0, len(res.Processes))
+	for _, process := range res.Processes {
+		if column >= len(process) {
+			continue
+		}
+		pid, err := strconv.Atoi(process[column])
+		if err != nil {
+			return nil, fmt.Errorf("invalid PID %q in the processes of container %q", process[column], h.reference.Id)
+		}
+		pids = append(pids, pid)
+	}
+	return pids, nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	dockercontainer "github.com/moby/moby/api/types/container"
+	dclient "github.com/moby/moby/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/info/v1"
+	"github.com/google/cadvisor/lib/container"
+)
+
+type mockDockerClientForStats struct {
+	dclient.APIClient
+	stats        dockercontainer.StatsResponse
+	top          dclient.ContainerTopResult
+	running      bool
+	restartCount int
+	// Number of calls to ContainerInspect.
+	inspects int
+}
+
+func (m *mockDockerClientForStats) ContainerStats(ctx context.Context, containerID string, options dclient.ContainerStatsOptions) (dclient.ContainerStatsResult, error) {
+	data, err := json.Marshal(m.stats)
+	if err != nil {
+		return dclient.ContainerStatsResult{}, err
+	}
+	return dclient.ContainerStatsResult{Body: io.NopCloser(strings.NewReader(string(data)))}, nil
+}
+
+func (m *mockDockerClientForStats) ContainerInspect(ctx context.Context, containerID string, options dclient.ContainerInspectOptions) (dclient.ContainerInspectResult, error) {
+	m.inspects++
+	return dclient.ContainerInspectResult{
+		Container: dockercontainer.InspectResponse{
+			Name:         "/test-container",
+			State:        &dockercontainer.State{Running: m.running},
+			RestartCount: m.restartCount,
+		},
+	}, nil
+}
+
+func (m *mockDockerClientForStats) ContainerTop(ctx context.Context, containerID string, options dclient.ContainerTopOptions) (dclient.ContainerTopResult, error) {
+	return m.top, nil
+}
+
+type machineInfoFactory struct{}
+
+func (machineInfoFactory) GetMachineInfo() (*info.MachineInfo, error) {
+	return &info.MachineInfo{}, nil
+}
+
+func (machineInfoFactory) GetVersionInfo() (*info.VersionInfo, error) {
+	return &info.VersionInfo{}, nil
+}
+
+func TestStatsFromAPICgroupV1(t *testing.T) {
+	read := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	stats := statsFromAPI(&dockercontainer.StatsResponse{
+		Read: read,
+		CPUStats: dockercontainer.CPUStats{
+			CPUUsage:       dockercontainer.CPUUsage{TotalUsage: 3000, PercpuUsage: []uint64{1000, 2000}, UsageInKernelmode: 1000, UsageInUsermode: 2000},
+			ThrottlingData: dockercontainer.ThrottlingData{Periods: 10, ThrottledPeriods: 2, ThrottledTime: 500},
+		},
+		MemoryStats: dockercontainer.MemoryStats{
+			Usage:    1000,
+			MaxUsage: 1500,
+			Stats:    map[string]uint64{"total_cache": 300, "total_rss": 700, "total_inactive_file": 200, "pgfault": 7},
+		},
+		PidsStats: dockercontainer.PidsStats{Current: 12, Limit: 100},
+		BlkioStats: dockercontainer.BlkioStats{IoServiceBytesRecursive: []dockercontainer.BlkioStatEntry{
+			{Major: 8, Minor: 0, Op: "Read", Value: 4096},
+			{Major: 8, Minor: 0, Op: "Write", Value: 8192},
+			{Major: 8, Minor: 16, Op: "Read", Value: 512},
+		}},
+		Networks: map[string]dockercontainer.NetworkStats{
+			"eth1": {RxBytes: 2},
+			"eth0": {RxBytes: 1, TxBytes: 3},
+		},
+	}, container.AllMetrics)
+
+	assert.Equal(t, read, stats.Timestamp)
+	assert.Equal(t, info.CpuUsage{Total: 3000, PerCpu: []uint64{1000, 2000}, User: 2000, System: 1000}, stats.Cpu.Usage)
+	assert.Equal(t, uint64(2), stats.Cpu.CFS.ThrottledPeriods)
+	assert.Equal(t, uint64(300), stats.Memory.Cache)
+	assert.Equal(t, uint64(700), stats.Memory.RSS)
+	assert.Equal(t, uint64(800), stats.Memory.WorkingSet)
+	assert.Equal(t, uint64(7), stats.Memory.ContainerData.Pgfault)
+	assert.Equal(t, uint64(12), stats.Processes.ThreadsCurrent)
+	assert.Equal(t, []info.PerDiskStats{
+		{Major: 8, Minor: 0, Stats: map[string]uint64{"Read": 4096, "Write": 8192}},
+		{Major: 8, Minor: 16, Stats: map[string]uint64{"Read": 512}},
+	}, stats.DiskIo.IoServiceBytes)
+	require.Len(t, stats.Network.Interfaces, 2)
+	assert.Equal(t, "eth0", stats.Network.Interfaces[0].Name)
+	assert.Equal(t, uint64(3), stats.Network.TxBytes)
+}
+
+func TestStatsFromAPICgroupV2(t *testing.T) {
+	stats := statsFromAPI(&dockercontainer.StatsResponse{
+		MemoryStats: dockercontainer.MemoryStats{
+			Usage: 1000,
+			Stats: map[string]uint64{"anon": 600, "file": 400, "inactive_file": 1200, "file_dirty": 5},
+		},
+		BlkioStats: dockercontainer.BlkioStats{IoServicedRecursive: []dockercontainer.BlkioStatEntry{
+			{Major: 259, Minor: 0, Op: "read", Value: 3},
+		}},
+		Networks: map[string]dockercontainer.NetworkStats{"eth0": {RxBytes: 1}},
+	}, container.AllMetrics.Difference(container.AllNetworkMetrics))
+
+	assert.False(t, stats.Timestamp.IsZero())
+	assert.Equal(t, uint64(600), stats.Memory.RSS)
+	assert.Equal(t, uint64(400), stats.Memory.Cache)
+	assert.Equal(t, uint64(5), stats.Memory.FileDirty)
+	assert.Equal(t, uint64(0), stats.Memory.WorkingSet)
+	assert.Equal(t, map[string]uint64{"Read": 3}, stats.DiskIo.IoServiced[0].Stats)
+	assert.Nil(t, stats.Network)
+}
+
+func TestSpecFromAPI(t *testing.T) {
+	pids := int64(64)
+	spec := specFromAPI(dockercontainer.Resources{
+		CPUShares:  512,
+		NanoCPUs:   1500000000,
+		CpusetCpus: "0-1",
+		Memory:     256 << 20,
+		PidsLimit:  &pids,
+	})
+	assert.Equal(t, info.CpuSpec{Limit: 512, Mask: "0-1", Quota: 150000, Period: 100000}, spec.Cpu)
+	assert.Equal(t, info.MemorySpec{Limit: 256 << 20, Reservation: math.MaxUint64, SwapLimit: math.MaxUint64}, spec.Memory)
+	assert.True(t, spec.HasProcesses)
+	assert.Equal(t, uint64(64), spec.Processes.Limit)
+
+	spec = specFromAPI(dockercontainer.Resources{})
+	assert.Equal(t, uint64(1024), spec.Cpu.Limit)
+	assert.Equal(t, uint64(0), spec.Cpu.Quota)
+	assert.Equal(t, uint64(math.MaxUint64), spec.Memory.Limit)
+	assert.False(t, spec.HasProcesses)
+}
+
+func TestHandlerStatsFromAPI(t *testing.T) {
+	client := &mockDockerClientForStats{
+		stats: dockercontainer.StatsResponse{
+			CPUStats:    dockercontainer.CPUStats{CPUUsage: dockercontainer.CPUUsage{TotalUsage: 42}},
+			MemoryStats: dockercontainer.MemoryStats{Usage: 1 << 20},
+		},
+		top: dclient.ContainerTopResult{
+			Titles:    []string{"UID", "PID", "PPID", "CMD"},
+			Processes: [][]string{{"root", "1234", "1200", "sleep"}, {"root", "1240", "1234", "sh"}},
+		},
+		running: true,
+	}
+	h := &containerHandler{
+		client:             client,
+		machineInfoFactory: machineInfoFactory{},
+		metrics:            container.MetricSet{container.CpuUsageMetrics: struct{}{}, container.MemoryUsageMetrics: struct{}{}},
+		statsFromAPI:       true,
+		resources:          dockercontainer.Resources{Memory: 1 << 30},
+		reference:          info.ContainerReference{Id: "test-container-id"},
+	}
+
+	stats, err := h.GetStats()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), stats.Cpu.Usage.Total)
+	assert.Equal(t, uint64(1<<20), stats.Memory.Usage)
+
+	spec, err := h.GetSpec()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1<<30), spec.Memory.Limit)
+	assert.False(t, spec.HasFilesystem)
+
+	pids, err := h.ListProcesses(container.ListSelf)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1234, 1240}, pids)
+
+	// Exists reuses the inspection of GetStats ...
+	inspects := client.inspects
+	assert.True(t, h.Exists())
+	client.running = false
+	assert.True(t, h.Exists())
+	assert.Equal(t, inspects, client.inspects)
+
+	// ... until it is older than existsCacheDuration.
+	h.inspectedAt = time.Now().Add(-existsCacheDuration)
+	assert.False(t, h.Exists())
+	assert.False(t, h.Exists())
+	assert.Equal(t, inspects+1, client.inspects)
+}
@@ -19,8 +19,11 @@ import (
 	"os"
 	"path"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/docker/go-units"
 	dockerimage "github.com/moby/moby/api/types/image"
 	dockersystem "github.com/moby/moby/api/types/system"
 
@@ -37,6 +40,10 @@ const (
 // --cgroup-parent have another prefix than 'docker'
 var cgroupRegexp = regexp.MustCompile(`([a-z0-9]{64})`)
 
+// Regexp that identifies the cgroups of containers of rootless runtimes, which
+// run under the systemd user manager of their user.
+var rootlessCgroupRegexp = regexp.MustCompile(`/user@(\d+)\.service/`)
+
 func DriverStatusValue(status [][2]string, target string) string {
 	for _, v := range status {
 		if strings.EqualFold(v[0], target) {
@@ -105,6 +112,45 @@ func SummariesToImages(summaries []dockerimage.Summary) ([]v1.DockerImage, error
 	return out, nil
 }
 
+// SummariesToImageStore returns the image store of a runtime whose images
+// use usage bytes, given the summaries of its images and the number of layers
+// of each image by ID.
+func SummariesToImageStore(runtime string, usage int64, summaries []dockerimage.Summary, layers map[string]int) v1.ImageStore {
+	store := v1.ImageStore{
+		Runtime: runtime,
+		Usage:   nonNegative(usage),
+		Images:  make([]v1.ImageInfo, 0, len(summaries)),
+	}
+	for _, summary := range summaries {
+		image := v1.ImageInfo{
+			ID:          summary.ID,
+			Size:        nonNegative(summary.Size),
+			SharedSize:  nonNegative(summary.SharedSize),
+			Layers:      layers[summary.ID],
+			RepoDigests: summary.RepoDigests,
+		}
+		for _, tag := range summary.RepoTags {
+			if tag != "<none>:<none>" {
+				image.RepoTags = append(image.RepoTags, tag)
+			}
+		}
+		if summary.Created > 0 {
+			image.Created = time.Unix(summary.Created, 0).UTC()
+		}
+		store.Images = append(store.Images, image)
+	}
+	sort.Slice(store.Images, func(i, j int) bool { return store.Images[i].ID < store.Images[j].ID })
+	return store
+}
+
+// nonNegative returns v, or 0 for the -1 the API reports unknown sizes as.
+func nonNegative(v int64) uint64 {
+	if v < 0 {
+		return 0
+	}
+	return uint64(v)
+}
+
 // ContainerNameToId returns the ID from the full container name.
 func ContainerNameToId(name string) string {
 	id := path.Base(name)
@@ -125,3 +171,37 @@ func IsContainerName(name string) bool {
 	}
 	return cgroupRegexp.MatchString(path.Base(name))
 }
+
+// RootlessUID returns the UID of the user whose rootless runtime runs the
+// container with the given cgroup name, e.g. "1000" for
+// "/user.slice/user-1000.slice/user@1000.service/user.slice/libpod-<id>.scope".
+func RootlessUID(name string) (string, bool) {
+	matches := rootlessCgroupRegexp.FindStringSubmatch(name)
+	if matches == nil {
+		return "", false
+	}
+	return matches[1], true
+}
+
+// UserSocket returns the endpoint of the socket at socketPath within the
+// runtime directory of the user with the given UID, e.g.
+// "unix:///run/user/1000/docker.sock".
+func UserSocket(userRuntimeDir, uid, socketPath string) string {
+	return "unix://" + path.Join(userRuntimeDir, uid, socketPath)
+}
+
+// StorageSizeLimit returns the size limit of the writable layer of a container
+// from its storage options, e.g. 1073741824 for {"size": "1G"}, or 0 if it has
+// none.
+func StorageSizeLimit(storageOpt map[string]string) uint64 {
+	size, ok := storageOpt["size"]
+	if !ok {
+		return 0
+	}
+	// The daemon parses it as a size in binary units.
+	limit, err := units.RAMInBytes(size)
+	if err != nil || limit < 0 {
+		return 0
+	}
+	return uint64(limit)
+}
@@ -14,7 +14,15 @@
 
 package utils
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	dockerimage "github.com/moby/moby/api/types/image"
+
+	v1 "github.com/google/cadvisor/info/v1"
+)
 
 func TestIsContainerName(t *testing.T) {
 	tests := []struct {
@@ -36,3 +44,62 @@ func TestIsContainerName(t *testing.T) {
 		}
 	}
 }
+
+func TestRootlessUID(t *testing.T) {
+	tests := []struct {
+		name     string
+		uid      string
+		rootless bool
+	}{
+		{
+			name:     "/user.slice/user-1000.slice/user@1000.service/user.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope/container",
+			uid:      "1000",
+			rootless: true,
+		},
+		{
+			name:     "/system.slice/docker-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope",
+			rootless: false,
+		},
+	}
+	for _, test := range tests {
+		if uid, rootless := RootlessUID(test.name); uid != test.uid || rootless != test.rootless {
+			t.Errorf("%s: expected: %q %v, actual: %q %v", test.name, test.uid, test.rootless, uid, rootless)
+		}
+	}
+}
+
+func TestStorageSizeLimit(t *testing.T) {
+	tests := []struct {
+		storageOpt map[string]string
+		limit      uint64
+	}{
+		{storageOpt: map[string]string{"size": "1G"}, limit: 1 << 30},
+		{storageOpt: map[string]string{"size": "512m"}, limit: 512 << 20},
+		{storageOpt: map[string]string{"size": "invalid"}, limit: 0},
+		{storageOpt: nil, limit: 0},
+	}
+	for _, test := range tests {
+		if limit := StorageSizeLimit(test.storageOpt); limit != test.limit {
+			t.Errorf("%v: expected: %d, actual: %d", test.storageOpt, test.limit, limit)
+		}
+	}
+}
+
+func TestSummariesToImageStore(t *testing.T) {
+	summaries := []dockerimage.Summary{
+		{ID: "sha256:bbb", RepoTags: []string{"<none>:<none>"}, RepoDigests: []string{"redis@sha256:ccc"}, Size: 300, SharedSize: -1, Created: 1767323045},
+		{ID: "sha256:aaa", RepoTags: []string{"nginx:1.27"}, Size: 700, SharedSize: 100},
+	}
+	expected := v1.ImageStore{
+		Runtime: "docker",
+		Usage:   0,
+		Images: []v1.ImageInfo{
+			{ID: "sha256:aaa", RepoTags: []string{"nginx:1.27"}, Size: 700, SharedSize: 100, Layers: 3},
+			{ID: "sha256:bbb", RepoDigests: []string{"redis@sha256:ccc"}, Size: 300, Created: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		},
+	}
+	actual := SummariesToImageStore("docker", -1, summaries, map[string]int{"sha256:aaa": 3})
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected: %+v, actual: %+v", expected, actual)
+	}
+}
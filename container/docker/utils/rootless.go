@@ -0,0 +1,72 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// How long a failed lookup of the rootless runtime of a user is remembered, so
+// that the containers of a user without one do not each dial its socket.
+const rootlessRetryInterval = time.Minute
+
+// rootlessFailure is a failed lookup of the rootless runtime of a user.
+type rootlessFailure struct {
+	err error
+	at  time.Time
+}
+
+// RootlessCache holds the rootless runtime, e.g. the Docker daemon or the
+// Podman service, of each user. Runtimes are looked up on first use, and
+// failed lookups are not retried for a minute.
+type RootlessCache[T any] struct {
+	lock sync.Mutex
+	// Runtimes by the UID of their user.
+	runtimes map[string]T
+	// Failed lookups by the UID of their user.
+	failures map[string]rootlessFailure
+}
+
+// NewRootlessCache returns an empty RootlessCache.
+func NewRootlessCache[T any]() *RootlessCache[T] {
+	return &RootlessCache[T]{
+		runtimes: make(map[string]T),
+		failures: make(map[string]rootlessFailure),
+	}
+}
+
+// Get returns the rootless runtime of the user with the given UID, calling
+// lookup to find it unless it is already known or was not found recently.
+func (c *RootlessCache[T]) Get(uid string, lookup func(uid string) (T, error)) (T, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if runtime, ok := c.runtimes[uid]; ok {
+		return runtime, nil
+	}
+	if failure, ok := c.failures[uid]; ok && time.Since(failure.at) < rootlessRetryInterval {
+		var none T
+		return none, failure.err
+	}
+
+	runtime, err := lookup(uid)
+	if err != nil {
+		c.failures[uid] = rootlessFailure{err: err, at: time.Now()}
+		return runtime, err
+	}
+	delete(c.failures, uid)
+	c.runtimes[uid] = runtime
+	return runtime, nil
+}
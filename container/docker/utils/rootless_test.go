@@ -0,0 +1,58 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootlessCache(t *testing.T) {
+	lookups := make(map[string]int)
+	lookup := func(uid string) (string, error) {
+		lookups[uid]++
+		if uid == "1001" {
+			return "", fmt.Errorf("no runtime for user %s", uid)
+		}
+		return "runtime of " + uid, nil
+	}
+	c := NewRootlessCache[string]()
+
+	// Runtimes are only looked up once.
+	for range 2 {
+		runtime, err := c.Get("1000", lookup)
+		require.NoError(t, err)
+		assert.Equal(t, "runtime of 1000", runtime)
+	}
+	assert.Equal(t, 1, lookups["1000"])
+
+	_, err := c.Get("1001", lookup)
+	require.Error(t, err)
+	failure, ok := c.failures["1001"]
+	require.True(t, ok)
+
+	// The failed lookup is not retried until rootlessRetryInterval passed.
+	_, err = c.Get("1001", lookup)
+	assert.Equal(t, failure.err, err)
+	assert.Equal(t, 1, lookups["1001"])
+	c.failures["1001"] = rootlessFailure{err: failure.err, at: failure.at.Add(-rootlessRetryInterval)}
+	_, err = c.Get("1001", lookup)
+	assert.Error(t, err)
+	assert.Equal(t, 2, lookups["1001"])
+	assert.True(t, c.failures["1001"].at.After(failure.at))
+}
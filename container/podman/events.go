@@ -0,0 +1,87 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package podman
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"net/http"
+	urllib "net/url"
+
+	"github.com/moby/moby/api/types/events"
+
+	"github.com/google/cadvisor/container/docker"
+	"github.com/google/cadvisor/lib/container/common"
+	"github.com/google/cadvisor/lib/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var eventsFlag = flag.Bool("podman_events", false, "Subscribe to podman's event stream to pick up containers as soon as they start and renames and updates as they happen, on top of watching the cgroup hierarchy")
+
+// Filters of the container events read from podman's docker-compatible event
+// stream.
+const eventsFilters = `{"type":["container"],"event":["start","die","rename","update"]}`
+
+// newEventWatcher returns a watcher of the containers reported by podman's
+// event stream.
+func newEventWatcher() watcher.ContainerWatcher {
+	return common.NewRuntimeEventWatcher("podman", eventSource, common.HostRootFs())
+}
+
+func eventSource(ctx context.Context, handle func(common.RuntimeEvent)) error {
+	conn, err := client(&ctx, *endpointFlag)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://d/v1.0.0/events?filters="+urllib.QueryEscape(eventsFilters), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := conn.Client.Do(req)
+	err = validateResponse(err, resp)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var message events.Message
+		if err := decoder.Decode(&message); err != nil {
+			return err
+		}
+		event, ok := docker.RuntimeEvent(message)
+		if !ok {
+			continue
+		}
+		// Start events do not carry the PID the container's cgroup is found
+		// from.
+		if event.Type == watcher.ContainerAdd {
+			ctnr, err := InspectContainer(event.ID)
+			if err != nil {
+				klog.V(4).Infof("Failed to inspect started podman container %q: %v", event.ID, err)
+				continue
+			}
+			if ctnr.State != nil {
+				event.Pid = ctnr.State.Pid
+			}
+		}
+		handle(event)
+	}
+}
@@ -17,6 +17,7 @@
 package podman
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"path"
@@ -62,6 +63,10 @@ func RootDir() string {
 	return rootDir
 }
 
+// errNoSystemService is returned for the containers of the system-wide Podman
+// service when only rootless services are monitored.
+var errNoSystemService = errors.New("no system-wide podman service")
+
 type podmanFactory struct {
 	// Information about the mounted cgroup subsystems.
 	machineInfoFactory info.MachineInfoFactory
@@ -69,6 +74,10 @@ type podmanFactory struct {
 	storageDriver docker.StorageDriver
 	storageDir    string
 
+	// Whether there is a system-wide service, rather than only the rootless
+	// services of users.
+	system bool
+
 	cgroupSubsystem map[string]string
 
 	fsInfo fs.FsInfo
@@ -79,8 +88,12 @@ type podmanFactory struct {
 	thinPoolWatcher *devicemapper.ThinPoolWatcher
 
 	zfsWatcher *zfs.ZfsWatcher
+
+	imageLayers docker.ImageLayers
 }
 
+var _ container.ImageStoreProvider = &podmanFactory{}
+
 func (f *podmanFactory) CanHandleAndAccept(name string) (handle bool, accept bool, err error) {
 	// Rootless
 	if path.Base(name) == containerBaseName {
@@ -92,7 +105,14 @@ func (f *podmanFactory) CanHandleAndAccept(name string) (handle bool, accept boo
 
 	id := dockerutil.ContainerNameToId(name)
 
-	ctnr, err := InspectContainer(id)
+	s, _, err := f.serviceOf(name)
+	if err == errNoSystemService {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, true, err
+	}
+	ctnr, err := inspectContainerAt(s.endpoint, id)
 	if err != nil {
 		return false, true, fmt.Errorf("error inspecting container: %v", err)
 	}
@@ -111,7 +131,45 @@ func (f *podmanFactory) String() string {
 }
 
 func (f *podmanFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (handler container.ContainerHandler, err error) {
-	return newContainerHandler(name, f.machineInfoFactory, f.fsInfo,
-		f.storageDriver, f.storageDir, f.cgroupSubsystem, inHostNamespace,
+	s, user, err := f.serviceOf(name)
+	if err != nil {
+		return nil, err
+	}
+	return newContainerHandler(name, s.endpoint, user, f.machineInfoFactory, f.fsInfo,
+		s.storageDriver, s.storageDir, f.cgroupSubsystem, inHostNamespace,
 		metadataEnvAllowList, f.metrics, f.thinPoolName, f.thinPoolWatcher, f.zfsWatcher)
 }
+
+// serviceOf returns the Podman service running the container with the given
+// cgroup name, and the UID of its user if it is rootless.
+func (f *podmanFactory) serviceOf(name string) (*service, string, error) {
+	user, rootless := docker.RootlessUID(name)
+	if !rootless {
+		if !f.system {
+			return nil, "", errNoSystemService
+		}
+		return &service{endpoint: *endpointFlag, storageDriver: f.storageDriver, storageDir: f.storageDir}, "", nil
+	}
+	s, err := rootlessServiceOf(user)
+	return s, user, err
+}
+
+// GetImageStore implements container.ImageStoreProvider. Only the images of
+// the system-wide service are listed.
+func (f *podmanFactory) GetImageStore() (info.ImageStore, error) {
+	if !f.system {
+		return info.ImageStore{}, errNoSystemService
+	}
+	usage, err := getDiskUsage()
+	if err != nil {
+		return info.ImageStore{}, err
+	}
+	layers := f.imageLayers.Update(usage.Images, func(id string) (int, error) {
+		image, err := inspectImage(id)
+		if err != nil {
+			return 0, err
+		}
+		return len(image.RootFS.Layers), nil
+	})
+	return dockerutil.SummariesToImageStore(Namespace, usage.LayersSize, usage.Images, layers), nil
+}
@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	dockercontainer "github.com/moby/moby/api/types/container"
@@ -59,11 +60,16 @@ type containerHandler struct {
 	startTime time.Time
 
 	// Metadata associated with the container.
-	envs   map[string]string
-	labels map[string]string
+	envs map[string]string
+	// Lock for the aliases in reference and the restart count, which change
+	// when the container is renamed or restarted.
+	metadataLock sync.RWMutex
+	labels       map[string]string
+	restartCount int
 
-	// Image name used for this container.
-	image string
+	// Image name used for this container, and the ID of the image.
+	image   string
+	imageID string
 
 	networkMode dockercontainer.NetworkMode
 
@@ -84,11 +90,18 @@ type containerHandler struct {
 	// Reference to the container
 	reference info.ContainerReference
 
+	// Endpoint of the Podman service running the container, and the UID of
+	// its user if it is rootless.
+	endpoint string
+	user     string
+
 	libcontainerHandler *containerlibcontainer.Handler
 }
 
 func newContainerHandler(
 	name string,
+	endpoint string,
+	user string,
 	machineInfoFactory info.MachineInfoFactory,
 	fsInfo fs.FsInfo,
 	storageDriver docker.StorageDriver,
@@ -124,7 +137,7 @@ func newContainerHandler(
 	id := dockerutil.ContainerNameToId(name)
 
 	// We assume that if Inspect fails then the container is not known to Podman.
-	ctnr, err := InspectContainer(id)
+	ctnr, err := inspectContainerAt(endpoint, id)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +150,7 @@ func newContainerHandler(
 			// If the NetworkMode starts with 'container:' then we need to use the IP address of the container specified.
 			// This happens in cases such as kubernetes where the containers doesn't have an IP address itself and we need to use the pod's address
 			containerID := ctnr.HostConfig.NetworkMode.ConnectedContainer()
-			c, err = InspectContainer(containerID)
+			c, err = inspectContainerAt(endpoint, containerID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to inspect container %q: %v", containerID, err)
 			}
@@ -163,6 +176,16 @@ func newContainerHandler(
 
 	otherStorageDir := filepath.Join(storageDir, string(storageDriver)+"-containers", id)
 
+	fsHandler := common.NewFsHandler(common.DefaultPeriod, rootfsStorageDir, otherStorageDir, fsInfo)
+	if ctnr.State.Pid > 0 {
+		// Relative overlay layers are under the directory of the driver.
+		layers := common.RootLayersFunc(rootFs, ctnr.State.Pid, path.Join(storageDir, string(storageDriver)))
+		fsHandler = common.NewOverlayFsHandler(common.DefaultPeriod, rootfsStorageDir, otherStorageDir, fsInfo, layers)
+	}
+	if fh, ok := fsHandler.(common.FsHandlerWithLimit); ok && ctnr.HostConfig != nil {
+		fh.SetLimit(dockerutil.StorageSizeLimit(ctnr.HostConfig.StorageOpt))
+	}
+
 	handler := &containerHandler{
 		machineInfoFactory: machineInfoFactory,
 		cgroupPaths:        cgroupPaths,
@@ -173,11 +196,14 @@ func newContainerHandler(
 		envs:               make(map[string]string),
 		labels:             ctnr.Config.Labels,
 		image:              ctnr.Config.Image,
+		imageID:            ctnr.Image,
 		networkMode:        ctnr.HostConfig.NetworkMode,
-		fsHandler:          common.NewFsHandler(common.DefaultPeriod, rootfsStorageDir, otherStorageDir, fsInfo),
+		fsHandler:          fsHandler,
 		metrics:            metrics,
 		thinPoolName:       thinPoolName,
 		zfsParent:          zfsParent,
+		endpoint:           endpoint,
+		user:               user,
 		reference: info.ContainerReference{
 			// Add the name and bare ID as aliases of the container.
 			Id:        id,
@@ -205,10 +231,11 @@ func newContainerHandler(
 	if ctnr.RestartCount > 0 {
 		handler.labels["restartcount"] = strconv.Itoa(ctnr.RestartCount)
 	}
+	handler.restartCount = ctnr.RestartCount
 
 	if metrics.Has(container.DiskUsageMetrics) {
 		handler.fsHandler = &docker.FsHandler{
-			FsHandler:       common.NewFsHandler(common.DefaultPeriod, rootfsStorageDir, otherStorageDir, fsInfo),
+			FsHandler:       fsHandler,
 			ThinPoolWatcher: thinPoolWatcher,
 			ZfsWatcher:      zfsWatcher,
 			DeviceID:        ctnr.GraphDriver.Data["DeviceId"],
@@ -248,9 +275,29 @@ func determineDeviceStorage(storageDriver docker.StorageDriver, storageDir strin
 }
 
 func (h *containerHandler) ContainerReference() (info.ContainerReference, error) {
+	h.metadataLock.RLock()
+	defer h.metadataLock.RUnlock()
 	return h.reference, nil
 }
 
+// RefreshMetadata implements container.MetadataRefresher: it picks up the
+// container's current name and restart count.
+func (h *containerHandler) RefreshMetadata() error {
+	ctnr, err := inspectContainerAt(h.endpoint, h.reference.Id)
+	if err != nil {
+		return fmt.Errorf("failed to inspect container %q: %v", h.reference.Id, err)
+	}
+
+	h.metadataLock.Lock()
+	defer h.metadataLock.Unlock()
+	h.reference.Aliases = []string{strings.TrimPrefix(ctnr.Name, "/"), h.reference.Id}
+	// The restartcount label keeps the count the handler was created with, so
+	// that restarts in place do not start new series; the current count is in
+	// the spec.
+	h.restartCount = ctnr.RestartCount
+	return nil
+}
+
 func (h *containerHandler) needNet() bool {
 	if h.metrics.Has(container.NetworkUsageMetrics) {
 		h.networkMode.IsContainer()
@@ -267,11 +314,16 @@ func (h *containerHandler) GetSpec() (info.ContainerSpec, error) {
 		return info.ContainerSpec{}, err
 	}
 
+	h.metadataLock.RLock()
 	spec.Labels = h.labels
+	spec.RestartCount = h.restartCount
+	h.metadataLock.RUnlock()
 	spec.Envs = h.envs
 	spec.Image = h.image
+	spec.ImageID = h.imageID
 	spec.CreationTime = h.creationTime
 	spec.StartTime = h.startTime
+	spec.User = h.user
 
 	return spec, nil
 }
@@ -318,6 +370,8 @@ func (h *containerHandler) GetCgroupPath(resource string) (string, error) {
 }
 
 func (h *containerHandler) GetContainerLabels() map[string]string {
+	h.metadataLock.RLock()
+	defer h.metadataLock.RUnlock()
 	return h.labels
 }
 
@@ -346,7 +400,7 @@ func (h *containerHandler) Type() container.ContainerType {
 }
 
 func (h *containerHandler) GetExitCode() (int, error) {
-	ctnr, err := InspectContainer(h.reference.Id)
+	ctnr, err := inspectContainerAt(h.endpoint, h.reference.Id)
 	if err != nil {
 		return -1, fmt.Errorf("failed to inspect container %s: %w", h.reference.Id, err)
 	}
@@ -361,3 +415,30 @@ func (h *containerHandler) GetExitCode() (int, error) {
 
 	return ctnr.State.ExitCode, nil
 }
+
+// GetExitStatus implements container.ExitStatusHandler.
+func (h *containerHandler) GetExitStatus() (container.ExitStatus, error) {
+	ctnr, err := inspectContainerAt(h.endpoint, h.reference.Id)
+	if err != nil {
+		return container.ExitStatus{ExitCode: -1}, fmt.Errorf("failed to inspect container %s: %w", h.reference.Id, err)
+	}
+
+	if ctnr.State == nil {
+		return container.ExitStatus{ExitCode: -1}, fmt.Errorf("container state not available for %s", h.reference.Id)
+	}
+
+	if ctnr.State.Running {
+		return container.ExitStatus{ExitCode: -1}, fmt.Errorf("container %s is still running", h.reference.Id)
+	}
+
+	status := container.ExitStatus{
+		ExitCode:   ctnr.State.ExitCode,
+		Signal:     container.SignalFromExitCode(ctnr.State.ExitCode),
+		StartedAt:  common.ParseStateTime(ctnr.State.StartedAt),
+		FinishedAt: common.ParseStateTime(ctnr.State.FinishedAt),
+	}
+	if status.StartedAt.IsZero() {
+		status.StartedAt = h.startTime
+	}
+	return status, nil
+}
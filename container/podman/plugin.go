@@ -19,6 +19,7 @@ package podman
 import (
 	"fmt"
 
+	dockersystem "github.com/moby/moby/api/types/system"
 	"github.com/opencontainers/cgroups"
 	"k8s.io/klog/v2"
 
@@ -59,9 +60,20 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, metrics contain
 		return nil, fmt.Errorf("failed to get cgroup subsystems: %v", err)
 	}
 
+	system := true
 	validatedInfo, err := docker.ValidateInfo(GetInfo, VersionString)
 	if err != nil {
-		return nil, fmt.Errorf("failed to validate Podman info: %v", err)
+		if *docker.ArgUserRuntimeDir == "" {
+			return nil, fmt.Errorf("failed to validate Podman info: %v", err)
+		}
+		// The rootless services of users are looked for as their containers
+		// show up, there need not be a system-wide one.
+		klog.Warningf("Only monitoring rootless podman containers: failed to validate Podman info: %v", err)
+		system, validatedInfo = false, &dockersystem.Info{}
+	}
+	storageDir := ""
+	if system {
+		storageDir = RootDir()
 	}
 
 	var (
@@ -92,7 +104,8 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, metrics contain
 	f := &podmanFactory{
 		machineInfoFactory: factory,
 		storageDriver:      docker.StorageDriver(validatedInfo.Driver),
-		storageDir:         RootDir(),
+		storageDir:         storageDir,
+		system:             system,
 		cgroupSubsystem:    cgroupSubsystem,
 		fsInfo:             fsInfo,
 		metrics:            metrics,
@@ -101,11 +114,14 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, metrics contain
 		zfsWatcher:         zfsWatcher,
 	}
 
-	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw, watcher.Runtime})
 
 	if !cgroups.IsCgroup2UnifiedMode() {
 		klog.Warning("Podman rootless containers not working with cgroups v1!")
 	}
 
-	return nil, nil
+	if !*eventsFlag || !system {
+		return nil, nil
+	}
+	return newEventWatcher(), nil
 }
@@ -59,11 +59,11 @@ func validateResponse(gotError error, response *http.Response) error {
 	return err
 }
 
-func apiGetRequest(url string, item interface{}) error {
+func apiGetRequest(endpoint, url string, item interface{}) error {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	conn, err := client(&ctx)
+	conn, err := client(&ctx, endpoint)
 	if err != nil {
 		return err
 	}
@@ -96,13 +96,31 @@ func apiGetRequest(url string, item interface{}) error {
 
 func Images() ([]v1.DockerImage, error) {
 	var summaries []dockerimage.Summary
-	err := apiGetRequest("http://d/v1.0.0/images/json", &summaries)
+	err := apiGetRequest(*endpointFlag, "http://d/v1.0.0/images/json", &summaries)
 	if err != nil {
 		return nil, err
 	}
 	return utils.SummariesToImages(summaries)
 }
 
+// diskUsage is the response of the docker-compatible /system/df endpoint.
+type diskUsage struct {
+	LayersSize int64
+	Images     []dockerimage.Summary
+}
+
+func getDiskUsage() (diskUsage, error) {
+	var usage diskUsage
+	err := apiGetRequest(*endpointFlag, "http://d/v1.0.0/system/df", &usage)
+	return usage, err
+}
+
+func inspectImage(id string) (dockerimage.InspectResponse, error) {
+	var data dockerimage.InspectResponse
+	err := apiGetRequest(*endpointFlag, fmt.Sprintf("http://d/v1.0.0/images/%s/json", id), &data)
+	return data, err
+}
+
 func Status() (v1.DockerStatus, error) {
 	podmanInfo, err := GetInfo()
 	if err != nil {
@@ -132,14 +150,18 @@ func Status() (v1.DockerStatus, error) {
 }
 
 func GetInfo() (*dockersystem.Info, error) {
+	return getInfoAt(*endpointFlag)
+}
+
+func getInfoAt(endpoint string) (*dockersystem.Info, error) {
 	var info dockersystem.Info
-	err := apiGetRequest("http://d/v1.0.0/info", &info)
+	err := apiGetRequest(endpoint, "http://d/v1.0.0/info", &info)
 	return &info, err
 }
 
 func VersionString() (string, error) {
 	var version dockersystem.VersionResponse
-	err := apiGetRequest("http://d/v1.0.0/version", &version)
+	err := apiGetRequest(*endpointFlag, "http://d/v1.0.0/version", &version)
 	if err != nil {
 		return "Unknown", err
 	}
@@ -149,7 +171,7 @@ func VersionString() (string, error) {
 
 func APIVersionString() (string, error) {
 	var version dockersystem.VersionResponse
-	err := apiGetRequest("http://d/v1.0.0/version", &version)
+	err := apiGetRequest(*endpointFlag, "http://d/v1.0.0/version", &version)
 	if err != nil {
 		return "Unknown", err
 	}
@@ -158,7 +180,11 @@ func APIVersionString() (string, error) {
 }
 
 func InspectContainer(id string) (dockercontainer.InspectResponse, error) {
+	return inspectContainerAt(*endpointFlag, id)
+}
+
+func inspectContainerAt(endpoint, id string) (dockercontainer.InspectResponse, error) {
 	var data dockercontainer.InspectResponse
-	err := apiGetRequest(fmt.Sprintf("http://d/v1.0.0/containers/%s/json", id), &data)
+	err := apiGetRequest(endpoint, fmt.Sprintf("http://d/v1.0.0/containers/%s/json", id), &data)
 	return data, err
 }
@@ -0,0 +1,59 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package podman
+
+import (
+	"fmt"
+
+	"github.com/google/cadvisor/container/docker"
+	dockerutil "github.com/google/cadvisor/container/docker/utils"
+)
+
+// Path of the socket of rootless Podman within the runtime directory of its
+// user.
+const rootlessSocket = "podman/podman.sock"
+
+// service is a Podman API service, and the storage of its containers.
+type service struct {
+	endpoint      string
+	storageDriver docker.StorageDriver
+	storageDir    string
+}
+
+// Rootless services by the UID of their user.
+var rootlessServices = dockerutil.NewRootlessCache[*service]()
+
+// rootlessServiceOf returns the rootless Podman service of the user with the
+// given UID.
+func rootlessServiceOf(uid string) (*service, error) {
+	return rootlessServices.Get(uid, connectRootlessService)
+}
+
+// connectRootlessService looks up the rootless Podman service of the user
+// with the given UID.
+func connectRootlessService(uid string) (*service, error) {
+	endpoint := dockerutil.UserSocket(*docker.ArgUserRuntimeDir, uid, rootlessSocket)
+	podmanInfo, err := getInfoAt(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the Podman info of user %s: %v", uid, err)
+	}
+	return &service{
+		endpoint:      endpoint,
+		storageDriver: docker.StorageDriver(podmanInfo.Driver),
+		storageDir:    podmanInfo.DockerRootDir,
+	}, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package podman
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/container/docker"
+	dockerutil "github.com/google/cadvisor/container/docker/utils"
+)
+
+func TestServiceOf(t *testing.T) {
+	runtimeDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(runtimeDir, "1000", "podman"), 0o700))
+	socket := filepath.Join(runtimeDir, "1000", rootlessSocket)
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1.0.0/info" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Driver":"overlay","DockerRootDir":"/home/alice/.local/share/containers/storage"}`))
+	}))
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+
+	defer func(dir string) { *docker.ArgUserRuntimeDir = dir }(*docker.ArgUserRuntimeDir)
+	defer func(c *dockerutil.RootlessCache[*service]) { rootlessServices = c }(rootlessServices)
+	rootlessServices = dockerutil.NewRootlessCache[*service]()
+	*docker.ArgUserRuntimeDir = runtimeDir
+
+	f := &podmanFactory{storageDriver: docker.Overlay2StorageDriver, storageDir: "/var/lib/containers/storage", system: true}
+
+	s, user, err := f.serviceOf("/user.slice/user-1000.slice/user@1000.service/user.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope/container")
+	require.NoError(t, err)
+	assert.Equal(t, "1000", user)
+	assert.Equal(t, &service{
+		endpoint:      "unix://" + socket,
+		storageDriver: docker.OverlayStorageDriver,
+		storageDir:    "/home/alice/.local/share/containers/storage",
+	}, s)
+
+	s, user, err = f.serviceOf("/machine.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope/container")
+	require.NoError(t, err)
+	assert.Equal(t, "", user)
+	assert.Equal(t, &service{endpoint: *endpointFlag, storageDriver: docker.Overlay2StorageDriver, storageDir: "/var/lib/containers/storage"}, s)
+
+	const otherUser = "/user.slice/user-1001.slice/user@1001.service/user.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope/container"
+	// A failed lookup is remembered.
+	_, _, err = f.serviceOf(otherUser)
+	require.Error(t, err)
+	_, _, cachedErr := f.serviceOf(otherUser)
+	assert.Same(t, err, cachedErr)
+
+	// Without a system-wide service only rootless containers are handled.
+	f.system = false
+	_, _, err = f.serviceOf("/machine.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope/container")
+	assert.Equal(t, errNoSystemService, err)
+	handle, accept, err := f.CanHandleAndAccept("/machine.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope/container")
+	assert.NoError(t, err)
+	assert.False(t, handle)
+	assert.False(t, accept)
+	_, _, err = f.serviceOf("/user.slice/user-1000.slice/user@1000.service/user.slice/libpod-72e5a5ff5eef3c4222a6551b992b9360a99122f77d2229783f0ee0946dfd800e.scope/container")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+const (
+	// CloudEventsSpecVersion is the CloudEvents specification version events
+	// are encoded with.
+	CloudEventsSpecVersion = "1.0"
+
+	// CloudEventContentType and CloudEventBatchContentType are the media types
+	// of a single structured-mode CloudEvent and of a batch of them.
+	CloudEventContentType      = "application/cloudevents+json"
+	CloudEventBatchContentType = "application/cloudevents-batch+json"
+
+	cloudEventTypePrefix = "io.cadvisor.container."
+)
+
+// cloudEventTypes maps event types to their CloudEvents type attribute. These
+// names are part of the API: consumers route on them, so they must not change.
+var cloudEventTypes = map[info.EventType]string{
+	info.EventOom:               cloudEventTypePrefix + "oom",
+	info.EventOomKill:           cloudEventTypePrefix + "oom_kill",
+	info.EventContainerCreation: cloudEventTypePrefix + "created",
+	info.EventContainerDeletion: cloudEventTypePrefix + "deleted",
+	info.EventRestartLoop:       cloudEventTypePrefix + "restart_loop",
+}
+
+// CloudEvent is a CloudEvents 1.0 event in structured JSON mode.
+type CloudEvent struct {
+	SpecVersion     string      `json:"specversion"`
+	ID              string      `json:"id"`
+	Source          string      `json:"source"`
+	Type            string      `json:"type"`
+	Subject         string      `json:"subject,omitempty"`
+	Time            time.Time   `json:"time"`
+	DataContentType string      `json:"datacontenttype,omitempty"`
+	Data            interface{} `json:"data,omitempty"`
+}
+
+// CloudEventType returns the CloudEvents type attribute for an event type.
+func CloudEventType(eventType info.EventType) string {
+	if t, ok := cloudEventTypes[eventType]; ok {
+		return t
+	}
+	return cloudEventTypePrefix + string(eventType)
+}
+
+// CloudEventSource returns the CloudEvents source attribute identifying the
+// machine events come from: its machine ID, or its system UUID or boot ID if
+// the machine ID is unknown.
+func CloudEventSource(machineInfo *info.MachineInfo) string {
+	for _, id := range []string{machineInfo.MachineID, machineInfo.SystemUUID, machineInfo.BootID} {
+		if id != "" {
+			return "/cadvisor/machine/" + id
+		}
+	}
+	return "/cadvisor"
+}
+
+// ToCloudEvent encodes an event as a CloudEvent from source. The container
+// name is the subject and the event's type-specific data, if any, is the data.
+// The ID is the one the event manager assigned to the event, so an event read
+// twice (e.g. through both the events API and a stream) keeps the same ID.
+func ToCloudEvent(event *info.Event, source string) *CloudEvent {
+	ce := &CloudEvent{
+		SpecVersion: CloudEventsSpecVersion,
+		ID:          cloudEventID(event),
+		Source:      source,
+		Type:        CloudEventType(event.EventType),
+		Subject:     event.ContainerName,
+		Time:        event.Timestamp,
+	}
+	var data interface{}
+	switch {
+	case event.EventData.OomKill != nil:
+		data = event.EventData.OomKill
+	case event.EventData.ContainerDeletion != nil:
+		data = event.EventData.ContainerDeletion
+	case event.EventData.RestartLoop != nil:
+		data = event.EventData.RestartLoop
+	}
+	if data != nil {
+		ce.DataContentType = "application/json"
+		ce.Data = data
+	}
+	return ce
+}
+
+// ToCloudEvents encodes events as CloudEvents from source.
+func ToCloudEvents(events []*info.Event, source string) []*CloudEvent {
+	ces := make([]*CloudEvent, 0, len(events))
+	for _, e := range events {
+		ces = append(ces, ToCloudEvent(e, source))
+	}
+	return ces
+}
+
+func cloudEventID(event *info.Event) string {
+	if event.ID != "" {
+		return event.ID
+	}
+	// Events that did not go through the event manager.
+	return uuid.NewString()
+}
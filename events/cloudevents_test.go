@@ -0,0 +1,85 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudEventSource(t *testing.T) {
+	assert.Equal(t, "/cadvisor/machine/abc", CloudEventSource(&info.MachineInfo{MachineID: "abc", SystemUUID: "uuid"}))
+	assert.Equal(t, "/cadvisor/machine/uuid", CloudEventSource(&info.MachineInfo{SystemUUID: "uuid", BootID: "boot"}))
+	assert.Equal(t, "/cadvisor/machine/boot", CloudEventSource(&info.MachineInfo{BootID: "boot"}))
+	assert.Equal(t, "/cadvisor", CloudEventSource(&info.MachineInfo{}))
+}
+
+func TestCloudEventType(t *testing.T) {
+	assert.Equal(t, "io.cadvisor.container.oom_kill", CloudEventType(info.EventOomKill))
+	assert.Equal(t, "io.cadvisor.container.deleted", CloudEventType(info.EventContainerDeletion))
+	assert.Equal(t, "io.cadvisor.container.somethingNew", CloudEventType(info.EventType("somethingNew")))
+}
+
+func TestToCloudEvent(t *testing.T) {
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	event := &info.Event{
+		ContainerName: "/docker/abc",
+		Timestamp:     ts,
+		EventType:     info.EventContainerDeletion,
+		EventData: info.EventData{
+			ContainerDeletion: &info.ContainerDeletionEventData{ExitCode: 137, Signal: 9},
+		},
+	}
+
+	ce := ToCloudEvent(event, "/cadvisor/machine/abc")
+	out, err := json.Marshal(ce)
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(out, &decoded))
+	assert.Equal(t, "1.0", decoded["specversion"])
+	assert.Equal(t, "/cadvisor/machine/abc", decoded["source"])
+	assert.Equal(t, "io.cadvisor.container.deleted", decoded["type"])
+	assert.Equal(t, "/docker/abc", decoded["subject"])
+	assert.Equal(t, "2026-01-02T03:04:05Z", decoded["time"])
+	assert.Equal(t, "application/json", decoded["datacontenttype"])
+	assert.Equal(t, map[string]interface{}{"exit_code": float64(137), "signal": float64(9)}, decoded["data"])
+	assert.NotEmpty(t, decoded["id"])
+
+	// The ID is the one assigned by the event manager; events that did not go
+	// through it still get unique IDs, even when identical.
+	event.ID = "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"
+	assert.Equal(t, event.ID, ToCloudEvent(event, "/cadvisor").ID)
+	duplicate := *event
+	duplicate.ID = ""
+	assert.NotEqual(t, ToCloudEvent(&duplicate, "/cadvisor").ID, ToCloudEvent(&duplicate, "/cadvisor").ID)
+}
+
+func TestToCloudEventWithoutData(t *testing.T) {
+	ce := ToCloudEvent(&info.Event{
+		ContainerName: "/",
+		Timestamp:     time.Now(),
+		EventType:     info.EventContainerCreation,
+	}, "/cadvisor")
+	assert.Equal(t, "io.cadvisor.container.created", ce.Type)
+	assert.Nil(t, ce.Data)
+	assert.Empty(t, ce.DataContentType)
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+)
+
+// StorageConfig is the on-disk form of a StoragePolicy, e.g.:
+//
+//	{
+//	  "default": {"max_age": "24h", "max_events": 100000},
+//	  "event_types": {"oom": {"max_age": "168h"}},
+//	  "exclude_event_types": ["containerCreation"],
+//	  "container_prefixes": [
+//	    {"prefix": "/kubepods/besteffort", "max_age": "1h", "max_events": 1000}
+//	  ]
+//	}
+//
+// Limits left unset keep the value of the policy the file is applied to.
+type StorageConfig struct {
+	Default           StorageLimits                    `json:"default"`
+	EventTypes        map[info.EventType]StorageLimits `json:"event_types,omitempty"`
+	ExcludeEventTypes []info.EventType                 `json:"exclude_event_types,omitempty"`
+	ContainerPrefixes []ContainerPrefixConfig          `json:"container_prefixes,omitempty"`
+}
+
+// StorageLimits are the retention limits of a set of events. MaxAge is a
+// time.Duration string; a MaxEvents of 0 disables storage and -1 removes the
+// limit.
+type StorageLimits struct {
+	MaxAge    string `json:"max_age,omitempty"`
+	MaxEvents *int   `json:"max_events,omitempty"`
+}
+
+// ContainerPrefixConfig is the on-disk form of a ContainerPrefixPolicy.
+type ContainerPrefixConfig struct {
+	Prefix string `json:"prefix"`
+	StorageLimits
+}
+
+// knownEventTypes are the event types a storage config may refer to.
+var knownEventTypes = map[info.EventType]bool{
+	info.EventOom:               true,
+	info.EventOomKill:           true,
+	info.EventContainerCreation: true,
+	info.EventContainerDeletion: true,
+	info.EventRestartLoop:       true,
+}
+
+// ReadStorageConfig reads a storage config file and applies it to policy.
+func ReadStorageConfig(file string, policy StoragePolicy) (StoragePolicy, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return policy, err
+	}
+	return ParseStorageConfig(data, policy)
+}
+
+// ParseStorageConfig parses a JSON storage config and returns policy with the
+// config applied. policy itself is not modified.
+func ParseStorageConfig(data []byte, policy StoragePolicy) (StoragePolicy, error) {
+	var config StorageConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return policy, fmt.Errorf("unable to parse event storage config: %v", err)
+	}
+
+	result := StoragePolicy{
+		DefaultMaxAge:       policy.DefaultMaxAge,
+		DefaultMaxNumEvents: policy.DefaultMaxNumEvents,
+		PerTypeMaxAge:       maps.Clone(policy.PerTypeMaxAge),
+		PerTypeMaxNumEvents: maps.Clone(policy.PerTypeMaxNumEvents),
+		ExcludedTypes:       maps.Clone(policy.ExcludedTypes),
+		PerContainerPrefix:  append([]ContainerPrefixPolicy(nil), policy.PerContainerPrefix...),
+	}
+	if result.PerTypeMaxAge == nil {
+		result.PerTypeMaxAge = make(map[info.EventType]time.Duration)
+	}
+	if result.PerTypeMaxNumEvents == nil {
+		result.PerTypeMaxNumEvents = make(map[info.EventType]int)
+	}
+	if result.ExcludedTypes == nil {
+		result.ExcludedTypes = make(map[info.EventType]bool)
+	}
+
+	if err := config.Default.apply(&result.DefaultMaxAge, &result.DefaultMaxNumEvents); err != nil {
+		return policy, fmt.Errorf("default: %v", err)
+	}
+	for eventType, limits := range config.EventTypes {
+		if !knownEventTypes[eventType] {
+			return policy, fmt.Errorf("unknown event type %q", eventType)
+		}
+		maxAge, ok := result.PerTypeMaxAge[eventType]
+		if !ok {
+			maxAge = result.DefaultMaxAge
+		}
+		maxNumEvents, ok := result.PerTypeMaxNumEvents[eventType]
+		if !ok {
+			maxNumEvents = result.DefaultMaxNumEvents
+		}
+		if err := limits.apply(&maxAge, &maxNumEvents); err != nil {
+			return policy, fmt.Errorf("event type %q: %v", eventType, err)
+		}
+		result.PerTypeMaxAge[eventType] = maxAge
+		result.PerTypeMaxNumEvents[eventType] = maxNumEvents
+	}
+	for _, eventType := range config.ExcludeEventTypes {
+		if !knownEventTypes[eventType] {
+			return policy, fmt.Errorf("unknown excluded event type %q", eventType)
+		}
+		result.ExcludedTypes[eventType] = true
+	}
+	for _, prefixConfig := range config.ContainerPrefixes {
+		if !path.IsAbs(prefixConfig.Prefix) {
+			return policy, fmt.Errorf("container prefix %q is not an absolute container name", prefixConfig.Prefix)
+		}
+		prefixPolicy := ContainerPrefixPolicy{
+			Prefix:       path.Clean(prefixConfig.Prefix),
+			MaxAge:       result.DefaultMaxAge,
+			MaxNumEvents: result.DefaultMaxNumEvents,
+		}
+		if err := prefixConfig.apply(&prefixPolicy.MaxAge, &prefixPolicy.MaxNumEvents); err != nil {
+			return policy, fmt.Errorf("container prefix %q: %v", prefixConfig.Prefix, err)
+		}
+		result.PerContainerPrefix = append(result.PerContainerPrefix, prefixPolicy)
+	}
+	return result, nil
+}
+
+// apply overrides maxAge and maxNumEvents with the limits that are set.
+func (l StorageLimits) apply(maxAge *time.Duration, maxNumEvents *int) error {
+	if l.MaxAge != "" {
+		age, err := time.ParseDuration(l.MaxAge)
+		if err != nil {
+			return fmt.Errorf("invalid max_age: %v", err)
+		}
+		*maxAge = age
+	}
+	if l.MaxEvents != nil {
+		if *l.MaxEvents < -1 {
+			return fmt.Errorf("invalid max_events %d", *l.MaxEvents)
+		}
+		*maxNumEvents = *l.MaxEvents
+	}
+	return nil
+}
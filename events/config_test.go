@@ -0,0 +1,70 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	info "github.com/google/cadvisor/info/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStorageConfig(t *testing.T) {
+	base := DefaultStoragePolicy()
+	base.PerTypeMaxAge[info.EventOomKill] = time.Hour
+
+	policy, err := ParseStorageConfig([]byte(`{
+		"default": {"max_events": 500},
+		"event_types": {
+			"oom": {"max_age": "168h"},
+			"oomKill": {"max_events": -1}
+		},
+		"exclude_event_types": ["containerCreation"],
+		"container_prefixes": [
+			{"prefix": "/kubepods/besteffort/", "max_age": "1h", "max_events": 10}
+		]
+	}`), base)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 24*time.Hour, policy.DefaultMaxAge)
+	assert.Equal(t, 500, policy.DefaultMaxNumEvents)
+	assert.Equal(t, 168*time.Hour, policy.PerTypeMaxAge[info.EventOom])
+	assert.Equal(t, 500, policy.PerTypeMaxNumEvents[info.EventOom])
+	assert.Equal(t, time.Hour, policy.PerTypeMaxAge[info.EventOomKill])
+	assert.Equal(t, -1, policy.PerTypeMaxNumEvents[info.EventOomKill])
+	assert.Equal(t, map[info.EventType]bool{info.EventContainerCreation: true}, policy.ExcludedTypes)
+	assert.Equal(t, []ContainerPrefixPolicy{{Prefix: "/kubepods/besteffort", MaxAge: time.Hour, MaxNumEvents: 10}}, policy.PerContainerPrefix)
+
+	// The base policy is left untouched.
+	assert.Equal(t, 100000, base.DefaultMaxNumEvents)
+	assert.NotContains(t, base.PerTypeMaxAge, info.EventOom)
+	assert.Empty(t, base.ExcludedTypes)
+}
+
+func TestParseStorageConfigErrors(t *testing.T) {
+	for _, config := range []string{
+		`not json`,
+		`{"default": {"max_age": "forever"}}`,
+		`{"event_types": {"oom": {"max_events": -2}}}`,
+		`{"container_prefixes": [{"prefix": "relative"}]}`,
+		`{"event_types": {"oomkill": {"max_age": "1h"}}}`,
+		`{"exclude_event_types": ["containerCreation", "oomkill"]}`,
+	} {
+		_, err := ParseStorageConfig([]byte(config), DefaultStoragePolicy())
+		assert.Error(t, err, config)
+	}
+}
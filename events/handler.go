@@ -21,6 +21,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
 	info "github.com/google/cadvisor/info/v1"
 	"github.com/google/cadvisor/lib/utils"
 
@@ -87,12 +89,16 @@ type EventManager interface {
 	AddEvent(event *info.Event) error
 	// Cancels a previously requested watch event.
 	StopWatch(watchID int)
+	// SetStoragePolicy replaces the storage policy. Stored events are kept
+	// unless the new policy excludes them or they exceed its limits.
+	SetStoragePolicy(storagePolicy StoragePolicy)
 }
 
 // events provides an implementation for the EventManager interface.
 type events struct {
-	// eventStore holds the events by event type.
-	eventStore map[info.EventType]*utils.TimedStore
+	// eventStore holds the events by event type and the container prefix
+	// whose retention applies to them.
+	eventStore map[storeKey]*utils.TimedStore
 	// map of registered watchers keyed by watch id.
 	watchers map[int]*watch
 	// lock guarding the eventStore.
@@ -105,6 +111,15 @@ type events struct {
 	storagePolicy StoragePolicy
 }
 
+// storeKey identifies an event store. Events of containers under a prefix
+// with its own retention (see ContainerPrefixPolicy) are kept apart so that
+// their limits do not evict other containers' events.
+type storeKey struct {
+	eventType info.EventType
+	// The ContainerPrefixPolicy prefix, empty for the per-type stores.
+	prefix string
+}
+
 // initialized by a call to WatchEvents(), a watch struct will then be added
 // to the events slice of *watch objects. When AddEvent() finds an event that
 // satisfies the request parameter of a watch object in events.watchers,
@@ -136,6 +151,21 @@ type StoragePolicy struct {
 	// Per-event type limits.
 	PerTypeMaxAge       map[info.EventType]time.Duration
 	PerTypeMaxNumEvents map[info.EventType]int
+
+	// Event types that are neither stored nor sent to watchers.
+	ExcludedTypes map[info.EventType]bool
+
+	// Limits for the events of containers under a prefix, overriding the
+	// per-type and default limits. The longest matching prefix applies.
+	PerContainerPrefix []ContainerPrefixPolicy
+}
+
+// ContainerPrefixPolicy limits the events of containers whose name is Prefix
+// or below it. Each event type is limited separately.
+type ContainerPrefixPolicy struct {
+	Prefix       string
+	MaxAge       time.Duration
+	MaxNumEvents int
 }
 
 func DefaultStoragePolicy() StoragePolicy {
@@ -144,13 +174,47 @@ func DefaultStoragePolicy() StoragePolicy {
 		DefaultMaxNumEvents: 100000,
 		PerTypeMaxAge:       make(map[info.EventType]time.Duration),
 		PerTypeMaxNumEvents: make(map[info.EventType]int),
+		ExcludedTypes:       make(map[info.EventType]bool),
+	}
+}
+
+// limits returns the store an event belongs in and the limits of that store.
+func (p *StoragePolicy) limits(event *info.Event) (storeKey, time.Duration, int) {
+	var prefixPolicy *ContainerPrefixPolicy
+	for i := range p.PerContainerPrefix {
+		pp := &p.PerContainerPrefix[i]
+		if !isUnderPrefix(event.ContainerName, pp.Prefix) {
+			continue
+		}
+		if prefixPolicy == nil || len(pp.Prefix) > len(prefixPolicy.Prefix) {
+			prefixPolicy = pp
+		}
+	}
+	if prefixPolicy != nil {
+		return storeKey{eventType: event.EventType, prefix: prefixPolicy.Prefix}, prefixPolicy.MaxAge, prefixPolicy.MaxNumEvents
 	}
+
+	maxNumEvents := p.DefaultMaxNumEvents
+	if numEvents, ok := p.PerTypeMaxNumEvents[event.EventType]; ok {
+		maxNumEvents = numEvents
+	}
+	maxAge := p.DefaultMaxAge
+	if age, ok := p.PerTypeMaxAge[event.EventType]; ok {
+		maxAge = age
+	}
+	return storeKey{eventType: event.EventType}, maxAge, maxNumEvents
+}
+
+// isUnderPrefix returns whether containerName is prefix or one of its
+// subcontainers.
+func isUnderPrefix(containerName, prefix string) bool {
+	return prefix == "/" || containerName == prefix || strings.HasPrefix(containerName, strings.TrimSuffix(prefix, "/")+"/")
 }
 
 // returns a pointer to an initialized Events object.
 func NewEventManager(storagePolicy StoragePolicy) EventManager {
 	return &events{
-		eventStore:    make(map[info.EventType]*utils.TimedStore),
+		eventStore:    make(map[storeKey]*utils.TimedStore),
 		watchers:      make(map[int]*watch),
 		storagePolicy: storagePolicy,
 	}
@@ -235,12 +299,8 @@ func (e *events) GetEvents(request *Request) ([]*info.Event, error) {
 	returnEventList := []*info.Event{}
 	e.eventsLock.RLock()
 	defer e.eventsLock.RUnlock()
-	for eventType, fetch := range request.EventType {
-		if !fetch {
-			continue
-		}
-		evs, ok := e.eventStore[eventType]
-		if !ok {
+	for key, evs := range e.eventStore {
+		if !request.EventType[key.eventType] {
 			continue
 		}
 
@@ -280,24 +340,51 @@ func (e *events) WatchEvents(request *Request) (*EventChannel, error) {
 func (e *events) updateEventStore(event *info.Event) {
 	e.eventsLock.Lock()
 	defer e.eventsLock.Unlock()
-	if _, ok := e.eventStore[event.EventType]; !ok {
-		maxNumEvents := e.storagePolicy.DefaultMaxNumEvents
-		if numEvents, ok := e.storagePolicy.PerTypeMaxNumEvents[event.EventType]; ok {
-			maxNumEvents = numEvents
-		}
+	e.storeEvent(event)
+}
+
+// storeEvent adds an event to its store under the current storage policy.
+// Must be called with eventsLock held.
+func (e *events) storeEvent(event *info.Event) {
+	key, maxAge, maxNumEvents := e.storagePolicy.limits(event)
+	if _, ok := e.eventStore[key]; !ok {
 		if maxNumEvents == 0 {
-			// Event storage is disabled for event.EventType
+			// Event storage is disabled for these events.
 			return
 		}
+		e.eventStore[key] = utils.NewTimedStore(maxAge, maxNumEvents)
+	}
+	e.eventStore[key].Add(event.Timestamp, event)
+}
 
-		maxAge := e.storagePolicy.DefaultMaxAge
-		if age, ok := e.storagePolicy.PerTypeMaxAge[event.EventType]; ok {
-			maxAge = age
+// SetStoragePolicy replaces the storage policy and moves the stored events
+// into stores built for it, oldest first, so the new limits are applied as if
+// the events had been added under them.
+func (e *events) SetStoragePolicy(storagePolicy StoragePolicy) {
+	e.eventsLock.Lock()
+	defer e.eventsLock.Unlock()
+	stored := []*info.Event{}
+	for _, evs := range e.eventStore {
+		for _, in := range evs.InTimeRange(time.Time{}, time.Time{}, -1) {
+			stored = append(stored, in.(*info.Event))
 		}
+	}
+	sort.Sort(byTimestamp(stored))
 
-		e.eventStore[event.EventType] = utils.NewTimedStore(maxAge, maxNumEvents)
+	e.storagePolicy = storagePolicy
+	e.eventStore = make(map[storeKey]*utils.TimedStore)
+	for _, event := range stored {
+		if !storagePolicy.ExcludedTypes[event.EventType] {
+			e.storeEvent(event)
+		}
 	}
-	e.eventStore[event.EventType].Add(event.Timestamp, event)
+}
+
+// isExcluded returns whether the storage policy excludes an event.
+func (e *events) isExcluded(event *info.Event) bool {
+	e.eventsLock.RLock()
+	defer e.eventsLock.RUnlock()
+	return e.storagePolicy.ExcludedTypes[event.EventType]
 }
 
 func (e *events) findValidWatchers(event *info.Event) []*watch {
@@ -315,6 +402,12 @@ func (e *events) findValidWatchers(event *info.Event) []*watch {
 // eventStore. It also feeds the event to a set of watch channels
 // held by the manager if it satisfies the request keys of the channels
 func (e *events) AddEvent(event *info.Event) error {
+	if e.isExcluded(event) {
+		return nil
+	}
+	if event.ID == "" {
+		event.ID = uuid.NewString()
+	}
 	e.updateEventStore(event)
 	e.watcherLock.RLock()
 	defer e.watcherLock.RUnlock()
@@ -151,6 +151,17 @@ func TestAddEventAddsEventsToEventManager(t *testing.T) {
 	assert.Equal(t, fakeEvent, events[0])
 }
 
+func TestAddEventAssignsUniqueIDs(t *testing.T) {
+	myEventHolder, _, fakeEvent, _ := initializeScenario(t)
+	duplicate := *fakeEvent
+
+	assert.NoError(t, myEventHolder.AddEvent(fakeEvent))
+	assert.NoError(t, myEventHolder.AddEvent(&duplicate))
+	assert.NotEmpty(t, fakeEvent.ID)
+	assert.NotEmpty(t, duplicate.ID)
+	assert.NotEqual(t, fakeEvent.ID, duplicate.ID)
+}
+
 func TestGetEventsForOneEvent(t *testing.T) {
 	myEventHolder, myRequest, fakeEvent, fakeEvent2 := initializeScenario(t)
 	myRequest.MaxEventsReturned = 1
@@ -196,3 +207,76 @@ func TestGetEventsForNoTypeRequested(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, receivedEvents, 0)
 }
+
+func TestExcludedEventTypesAreDropped(t *testing.T) {
+	policy := DefaultStoragePolicy()
+	policy.ExcludedTypes[info.EventOom] = true
+	manager := NewEventManager(policy)
+	request := NewRequest()
+	request.EventType[info.EventOom] = true
+	watch, err := manager.WatchEvents(request)
+	assert.NoError(t, err)
+
+	assert.NoError(t, manager.AddEvent(makeEvent(time.Now(), "/")))
+	assert.Empty(t, watch.GetChannel())
+	stored, err := manager.GetEvents(request)
+	assert.NoError(t, err)
+	assert.Empty(t, stored)
+}
+
+func TestContainerPrefixRetention(t *testing.T) {
+	policy := DefaultStoragePolicy()
+	policy.PerContainerPrefix = []ContainerPrefixPolicy{
+		{Prefix: "/noisy", MaxAge: time.Hour, MaxNumEvents: 1},
+		{Prefix: "/noisy/keep", MaxAge: time.Hour, MaxNumEvents: 10},
+	}
+	manager := NewEventManager(policy)
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		ts := now.Add(time.Duration(i) * time.Second)
+		assert.NoError(t, manager.AddEvent(makeEvent(ts, "/noisy/a")))
+		assert.NoError(t, manager.AddEvent(makeEvent(ts, "/noisy/keep/b")))
+		assert.NoError(t, manager.AddEvent(makeEvent(ts, "/noisyneighbour")))
+	}
+
+	request := NewRequest()
+	request.EventType[info.EventOom] = true
+	request.MaxEventsReturned = -1
+	request.IncludeSubcontainers = true
+	count := func(containerName string) int {
+		request.ContainerName = containerName
+		stored, err := manager.GetEvents(request)
+		assert.NoError(t, err)
+		return len(stored)
+	}
+	assert.Equal(t, 1, count("/noisy/a"))
+	assert.Equal(t, 3, count("/noisy/keep/b"))
+	assert.Equal(t, 3, count("/noisyneighbour"))
+}
+
+func TestSetStoragePolicyKeepsEvents(t *testing.T) {
+	manager := NewEventManager(DefaultStoragePolicy())
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		ts := now.Add(time.Duration(i) * time.Second)
+		assert.NoError(t, manager.AddEvent(makeEvent(ts, "/")))
+		assert.NoError(t, manager.AddEvent(&info.Event{ContainerName: "/", Timestamp: ts, EventType: info.EventContainerCreation}))
+	}
+
+	policy := DefaultStoragePolicy()
+	policy.PerTypeMaxNumEvents[info.EventOom] = 2
+	policy.ExcludedTypes[info.EventContainerCreation] = true
+	manager.SetStoragePolicy(policy)
+
+	request := NewRequest()
+	request.MaxEventsReturned = -1
+	request.EventType[info.EventOom] = true
+	request.EventType[info.EventContainerCreation] = true
+	stored, err := manager.GetEvents(request)
+	assert.NoError(t, err)
+	if assert.Len(t, stored, 2) {
+		assert.Equal(t, info.EventOom, stored[0].EventType)
+		assert.Equal(t, now.Add(time.Second), stored[0].Timestamp)
+		assert.Equal(t, now.Add(2*time.Second), stored[1].Timestamp)
+	}
+}
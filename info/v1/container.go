@@ -24,12 +24,26 @@ type ProcessSpec = model.ProcessSpec
 
 type ContainerSpec = model.ContainerSpec
 
+type SystemdUnitSpec = model.SystemdUnitSpec
+
 // Container reference contains enough information to uniquely identify a container
 type ContainerReference = model.ContainerReference
 
 // Sorts by container name.
 type ContainerReferenceSlice = model.ContainerReferenceSlice
 
+// PodReference identifies a Kubernetes pod.
+type PodReference = model.PodReference
+
+// PodInfo is the aggregated view of the containers of a pod.
+type PodInfo = model.PodInfo
+
+// ImageStore describes the images a container runtime stores.
+type ImageStore = model.ImageStore
+
+// ImageInfo describes a container image.
+type ImageInfo = model.ImageInfo
+
 // ContainerInfoRequest is used when users check a container info from the REST API.
 // It specifies how much data users want to get about a container
 type ContainerInfoRequest = model.ContainerInfoRequest
@@ -91,6 +105,34 @@ type UdpStat = model.UdpStat
 
 type FsStats = model.FsStats
 
+type FsAllocation = model.FsAllocation
+
+type FsForecast = model.FsForecast
+
+type DiskIoRates = model.DiskIoRates
+
+type OverlayStats = model.OverlayStats
+
+type ZfsDatasetStats = model.ZfsDatasetStats
+
+type BtrfsQgroupStats = model.BtrfsQgroupStats
+
+type ZfsStats = model.ZfsStats
+
+type ZfsPoolStats = model.ZfsPoolStats
+
+type ZfsArcStats = model.ZfsArcStats
+
+type DiskHealthStats = model.DiskHealthStats
+
+type RaidStats = model.RaidStats
+
+type NfsStats = model.NfsStats
+
+type NfsOpStats = model.NfsOpStats
+
+type VolumeStats = model.VolumeStats
+
 type AcceleratorStats = model.AcceleratorStats
 
 // PerfStat represents value of a single monitored perf event.
@@ -136,6 +178,7 @@ const (
 	EventOomKill           EventType = "oomKill"
 	EventContainerCreation EventType = "containerCreation"
 	EventContainerDeletion EventType = "containerDeletion"
+	EventRestartLoop       EventType = "restartLoop"
 )
 
 // Extra information about an event. Only one type will be set.
@@ -146,3 +189,6 @@ type OomKillEventData = model.OomKillEventData
 
 // Information related to a container deletion event
 type ContainerDeletionEventData = model.ContainerDeletionEventData
+
+// Information related to a container restart loop event
+type RestartLoopEventData = model.RestartLoopEventData
@@ -29,6 +29,10 @@ type HugePagesInfo = model.HugePagesInfo
 
 type DiskInfo = model.DiskInfo
 
+type DeviceMapperInfo = model.DeviceMapperInfo
+
+type RaidInfo = model.RaidInfo
+
 type NetInfo = model.NetInfo
 
 type CloudProvider = model.CloudProvider
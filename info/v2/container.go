@@ -108,6 +108,22 @@ type ContainerSpec struct {
 
 	// Image name used for this container.
 	Image string `json:"image,omitempty"`
+
+	// ID of the image used for this container, if the runtime reports it.
+	ImageID string `json:"image_id,omitempty"`
+
+	// Number of times cAdvisor has seen this container recreated under the
+	// same name.
+	RestartCount int `json:"restart_count,omitempty"`
+
+	// The systemd unit this container is the cgroup of, if any.
+	Systemd *v1.SystemdUnitSpec `json:"systemd,omitempty"`
+
+	// The sandbox isolating the container from the host kernel, if any.
+	Sandbox string `json:"sandbox,omitempty"`
+
+	// The UID of the user whose rootless runtime runs the container, if any.
+	User string `json:"user,omitempty"`
 }
 
 type DeprecatedContainerStats struct {
@@ -277,4 +293,8 @@ type FilesystemStats struct {
 	// This only accounts for inodes that are shared across containers,
 	// and does not include inodes used in mounted directories.
 	InodeUsage *uint64 `json:"containter_inode_usage,omitempty"`
+	// Forecast of the usage of the container's root filesystem.
+	Forecast *v1.FsForecast `json:"forecast,omitempty"`
+	// Usage of the container's root filesystem from its btrfs qgroup.
+	Btrfs *v1.BtrfsQgroupStats `json:"btrfs,omitempty"`
 }
@@ -31,12 +31,16 @@ func machineFsStatsFromV1(fsStats []v1.FsStats) []MachineFsStats {
 		writeDuration := time.Millisecond * time.Duration(stat.WriteTime)
 		ioDuration := time.Millisecond * time.Duration(stat.IoTime)
 		weightedDuration := time.Millisecond * time.Duration(stat.WeightedIoTime)
+		discardDuration := time.Millisecond * time.Duration(stat.DiscardTime)
+		flushDuration := time.Millisecond * time.Duration(stat.FlushTime)
 		machineFsStat := MachineFsStats{
 			Device:    stat.Device,
 			Type:      stat.Type,
 			Capacity:  &stat.Limit,
 			Usage:     &stat.Usage,
 			Available: &stat.Available,
+			Forecast:  stat.Forecast,
+			IoRates:   stat.IoRates,
 			DiskStats: DiskStats{
 				ReadsCompleted:     &stat.ReadsCompleted,
 				ReadsMerged:        &stat.ReadsMerged,
@@ -49,6 +53,12 @@ func machineFsStatsFromV1(fsStats []v1.FsStats) []MachineFsStats {
 				IoInProgress:       &stat.IoInProgress,
 				IoDuration:         &ioDuration,
 				WeightedIoDuration: &weightedDuration,
+				DiscardsCompleted:  &stat.DiscardsCompleted,
+				DiscardsMerged:     &stat.DiscardsMerged,
+				SectorsDiscarded:   &stat.SectorsDiscarded,
+				DiscardDuration:    &discardDuration,
+				FlushesCompleted:   &stat.FlushesCompleted,
+				FlushDuration:      &flushDuration,
 			},
 		}
 		if stat.HasInodes {
@@ -138,6 +148,8 @@ func ContainerStatsFromV1(containerName string, spec *v1.ContainerSpec, stats []
 					TotalUsageBytes: &val.Filesystem[0].Usage,
 					BaseUsageBytes:  &val.Filesystem[0].BaseUsage,
 					InodeUsage:      &val.Filesystem[0].Inodes,
+					Forecast:        val.Filesystem[0].Forecast,
+					Btrfs:           val.Filesystem[0].Btrfs,
 				}
 			} else if len(val.Filesystem) > 1 && containerName != "/" {
 				// Cannot handle multiple devices per container.
@@ -295,6 +307,11 @@ func ContainerSpecFromV1(specV1 *v1.ContainerSpec, aliases []string, namespace s
 		HasDiskIo:        specV1.HasDiskIo,
 		HasCustomMetrics: specV1.HasCustomMetrics,
 		Image:            specV1.Image,
+		ImageID:          specV1.ImageID,
+		RestartCount:     specV1.RestartCount,
+		Systemd:          specV1.Systemd,
+		Sandbox:          specV1.Sandbox,
+		User:             specV1.User,
 		Labels:           specV1.Labels,
 		Envs:             specV1.Envs,
 	}
@@ -193,6 +193,7 @@ func TestContainerStatsFromV1(t *testing.T) {
 			BaseUsage:  50,
 			Available:  300,
 			InodesFree: 100,
+			Btrfs:      &v1.BtrfsQgroupStats{Referenced: 80, Exclusive: 20},
 		}},
 		Accelerators: []v1.AcceleratorStats{{
 			Make:        "nvidia",
@@ -274,6 +275,7 @@ func TestContainerStatsFromV1(t *testing.T) {
 			TotalUsageBytes: &v1Stats.Filesystem[0].Usage,
 			BaseUsageBytes:  &v1Stats.Filesystem[0].BaseUsage,
 			InodeUsage:      &v1Stats.Filesystem[0].Inodes,
+			Btrfs:           &v1.BtrfsQgroupStats{Referenced: 80, Exclusive: 20},
 		},
 		Accelerators:     v1Stats.Accelerators,
 		PerfStats:        v1Stats.PerfStats,
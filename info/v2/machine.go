@@ -135,6 +135,12 @@ type MachineFsStats struct {
 	// Number of inodes that are available on this filesystem.
 	InodesFree *uint64 `json:"inodes_free,omitempty"`
 
+	// Forecast of the usage of this filesystem.
+	Forecast *v1.FsForecast `json:"forecast,omitempty"`
+
+	// Rates of the I/O of the device between the last two samples.
+	IoRates *v1.DiskIoRates `json:"io_rates,omitempty"`
+
 	// DiskStats for this device.
 	DiskStats `json:"inline"`
 }
@@ -195,4 +201,20 @@ type DiskStats struct {
 	// last update of this field.  This can provide an easy measure of both
 	// I/O completion time and the backlog that may be accumulating.
 	WeightedIoDuration *time.Duration `json:"weighted_io_duration,omitempty"`
+
+	// Number of discards completed and merged, from Linux 4.18.
+	DiscardsCompleted *uint64 `json:"discards_completed,omitempty"`
+	DiscardsMerged    *uint64 `json:"discards_merged,omitempty"`
+
+	// Number of sectors discarded.
+	SectorsDiscarded *uint64 `json:"sectors_discarded,omitempty"`
+
+	// Time spent by all discards.
+	DiscardDuration *time.Duration `json:"discard_duration,omitempty"`
+
+	// Number of flushes completed, from Linux 5.5.
+	FlushesCompleted *uint64 `json:"flushes_completed,omitempty"`
+
+	// Time spent by all flushes.
+	FlushDuration *time.Duration `json:"flush_duration,omitempty"`
 }
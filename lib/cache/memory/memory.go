@@ -131,6 +131,11 @@ func (c *InMemoryCache) RecentStats(name string, start, end time.Time, maxStats
 	return cstore.RecentStats(start, end, maxStats)
 }
 
+// MaxAge returns how long the stats of containers are kept.
+func (c *InMemoryCache) MaxAge() time.Duration {
+	return c.maxAge
+}
+
 func (c *InMemoryCache) Close() error {
 	c.containerCacheMap = containerCacheMap{}
 	return nil
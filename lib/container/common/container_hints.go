@@ -24,10 +24,29 @@ import (
 	"encoding/json"
 	"flag"
 	"os"
+	"sync/atomic"
 )
 
 var ArgContainerHints = flag.String("container_hints", "/etc/cadvisor/container_hints.json", "location of the container hints file")
 
+// containerHintsFile, once set, overrides --container_hints.
+var containerHintsFile atomic.Pointer[string]
+
+// SetContainerHintsFile changes the container hints file read for containers
+// created from now on.
+func SetContainerHintsFile(file string) {
+	containerHintsFile.Store(&file)
+}
+
+// ContainerHintsFile returns the container hints file in effect: the one set
+// with SetContainerHintsFile, or --container_hints.
+func ContainerHintsFile() string {
+	if file := containerHintsFile.Load(); file != nil {
+		return *file
+	}
+	return *ArgContainerHints
+}
+
 type ContainerHints struct {
 	AllHosts []containerHint `json:"all_hosts,omitempty"`
 }
@@ -19,10 +19,13 @@ package common
 
 import (
 	"fmt"
+	"path/filepath"
 	"sync"
 	"time"
 
 	"github.com/google/cadvisor/lib/fs"
+	"github.com/google/cadvisor/lib/fs/overlay"
+	info "github.com/google/cadvisor/lib/model"
 
 	"k8s.io/klog/v2"
 )
@@ -33,12 +36,54 @@ type FsHandler interface {
 	Stop()
 }
 
+// FsHandlerWithLimit is an FsHandler that measures its rootfs first when its
+// usage comes close to a size limit.
+type FsHandlerWithLimit interface {
+	FsHandler
+
+	// SetLimit sets the size limit of the rootfs, such as that of the writable
+	// layer of the container, before Start.
+	SetLimit(limit uint64)
+}
+
 type FsUsage struct {
 	BaseUsageBytes  uint64
 	TotalUsageBytes uint64
 	InodeUsage      uint64
+	// Usage of the layers of the overlay root filesystem of the container,
+	// if it has one.
+	Overlay *OverlayUsage
+	// Usage of the root filesystem of the container from its btrfs qgroup,
+	// if it is a subvolume with one.
+	Qgroup *fs.QgroupUsage
+}
+
+// QgroupStats converts the qgroup usage of the root filesystem of a
+// container, if it has one.
+func QgroupStats(usage *fs.QgroupUsage) *info.BtrfsQgroupStats {
+	if usage == nil {
+		return nil
+	}
+	return &info.BtrfsQgroupStats{Referenced: usage.Referenced, Exclusive: usage.Exclusive}
 }
 
+// OverlayUsage is the usage of the layers of an overlay root filesystem.
+type OverlayUsage struct {
+	// Bytes used by the upper layer, written by the container.
+	UpperBytes uint64
+	// Bytes used by the lower layers, shared with the image and other
+	// containers.
+	LowerBytes uint64
+	// Number of lower layers.
+	LowerLayers int
+	// Bytes per second the upper layer grew by since the previous update.
+	UpperGrowthRate float64
+}
+
+// LayersFunc returns the resolved layers of the overlay root filesystem of a
+// container, or false if its root filesystem is not an overlay.
+type LayersFunc func() (overlay.Layers, bool, error)
+
 type realFsHandler struct {
 	sync.RWMutex
 	lastUpdate time.Time
@@ -47,9 +92,18 @@ type realFsHandler struct {
 	minPeriod  time.Duration
 	rootfs     string
 	extraDir   string
-	fsInfo     fs.FsInfo
+	// Bytes the rootfs may use, zero if it has no limit of its own.
+	limit  uint64
+	fsInfo fs.FsInfo
 	// Tells the container to stop.
 	stopChan chan struct{}
+
+	// Returns the overlay layers of the container, nil if not tracked.
+	layersFunc LayersFunc
+	// Layers of the container once known, nil until then.
+	layers *overlay.Layers
+	// Whether the root filesystem turned out not to be an overlay.
+	notOverlay bool
 }
 
 const (
@@ -58,7 +112,7 @@ const (
 
 const DefaultPeriod = time.Minute
 
-var _ FsHandler = &realFsHandler{}
+var _ FsHandlerWithLimit = &realFsHandler{}
 
 func NewFsHandler(period time.Duration, rootfs, extraDir string, fsInfo fs.FsInfo) FsHandler {
 	return &realFsHandler{
@@ -73,6 +127,28 @@ func NewFsHandler(period time.Duration, rootfs, extraDir string, fsInfo fs.FsInf
 	}
 }
 
+// NewOverlayFsHandler returns an FsHandler that also reports the usage of the
+// upper and lower layers of the overlay root filesystem of the container, as
+// returned by layersFunc.
+func NewOverlayFsHandler(period time.Duration, rootfs, extraDir string, fsInfo fs.FsInfo, layersFunc LayersFunc) FsHandler {
+	fh := NewFsHandler(period, rootfs, extraDir, fsInfo).(*realFsHandler)
+	fh.layersFunc = layersFunc
+	return fh
+}
+
+func (fh *realFsHandler) SetLimit(limit uint64) {
+	fh.limit = limit
+}
+
+// dirUsage returns the usage of dir, a layer of the rootfs, passing the limit
+// of the rootfs to the FsInfo if it takes one.
+func (fh *realFsHandler) dirUsage(dir string) (fs.UsageInfo, error) {
+	if fsInfo, ok := fh.fsInfo.(fs.FsInfoWithLimit); ok && fh.limit > 0 {
+		return fsInfo.GetDirUsageWithLimit(dir, fh.limit)
+	}
+	return fh.fsInfo.GetDirUsage(dir)
+}
+
 func (fh *realFsHandler) update() error {
 	var (
 		rootUsage, extraUsage fs.UsageInfo
@@ -80,22 +156,35 @@ func (fh *realFsHandler) update() error {
 	)
 	// TODO(vishh): Add support for external mounts.
 	if fh.rootfs != "" {
-		rootUsage, rootErr = fh.fsInfo.GetDirUsage(fh.rootfs)
+		rootUsage, rootErr = fh.dirUsage(fh.rootfs)
 	}
 
 	if fh.extraDir != "" {
 		extraUsage, extraErr = fh.fsInfo.GetDirUsage(fh.extraDir)
 	}
 
+	overlayUsage := fh.overlayUsage(rootUsage, rootErr)
+
 	// Wait to handle errors until after all operartions are run.
 	// An error in one will not cause an early return, skipping others
 	fh.Lock()
 	defer fh.Unlock()
-	fh.lastUpdate = time.Now()
+	now := time.Now()
+	if overlayUsage != nil {
+		if previous := fh.usage.Overlay; previous != nil && !fh.lastUpdate.IsZero() {
+			elapsed := now.Sub(fh.lastUpdate).Seconds()
+			if elapsed > 0 {
+				overlayUsage.UpperGrowthRate = (float64(overlayUsage.UpperBytes) - float64(previous.UpperBytes)) / elapsed
+			}
+		}
+		fh.usage.Overlay = overlayUsage
+	}
+	fh.lastUpdate = now
 	if fh.rootfs != "" && rootErr == nil {
 		fh.usage.InodeUsage = rootUsage.Inodes
 		fh.usage.BaseUsageBytes = rootUsage.Bytes
 		fh.usage.TotalUsageBytes = rootUsage.Bytes
+		fh.usage.Qgroup = rootUsage.Qgroup
 	}
 	if fh.extraDir != "" && extraErr == nil {
 		if fh.rootfs != "" {
@@ -155,3 +244,101 @@ func (fh *realFsHandler) Usage() FsUsage {
 	defer fh.RUnlock()
 	return fh.usage
 }
+
+// overlayUsage returns the usage of the overlay layers of the container, or
+// nil if they are not tracked or not known yet. The usage of the upper layer is
+// that of the rootfs when it is the upper layer, as for the overlay drivers.
+func (fh *realFsHandler) overlayUsage(rootUsage fs.UsageInfo, rootErr error) *OverlayUsage {
+	if fh.layersFunc == nil || fh.notOverlay {
+		return nil
+	}
+	if fh.layers == nil {
+		layers, ok, err := fh.layersFunc()
+		if err != nil {
+			klog.V(4).Infof("Unable to get the overlay layers of %s: %v", fh.rootfs, err)
+			return nil
+		}
+		if !ok {
+			fh.notOverlay = true
+			return nil
+		}
+		fh.layers = &layers
+	}
+
+	usage := &OverlayUsage{LowerLayers: len(fh.layers.Lower)}
+	switch {
+	case fh.layers.Upper == "":
+	case fh.layers.Upper == fh.rootfs && rootErr == nil:
+		usage.UpperBytes = rootUsage.Bytes
+	default:
+		upperUsage, err := fh.dirUsage(fh.layers.Upper)
+		if err != nil {
+			klog.V(4).Infof("Unable to get the usage of the upper layer %s: %v", fh.layers.Upper, err)
+			return nil
+		}
+		usage.UpperBytes = upperUsage.Bytes
+	}
+	for _, dir := range fh.layers.Lower {
+		lowerBytes, err := lowerLayers.usage(fh.fsInfo, dir)
+		if err != nil {
+			klog.V(4).Infof("Unable to get the usage of the lower layer %s: %v", dir, err)
+			return nil
+		}
+		usage.LowerBytes += lowerBytes
+	}
+	return usage
+}
+
+// How long the usage of a lower layer no container uses is remembered.
+const lowerLayerExpiry = time.Hour
+
+// lowerLayerCache remembers the usage of lower layers. They are image layers,
+// shared by containers and never written, so each is measured once.
+type lowerLayerCache struct {
+	sync.Mutex
+	layers    map[string]*lowerLayer
+	lastSweep time.Time
+}
+
+type lowerLayer struct {
+	bytes    uint64
+	lastUsed time.Time
+}
+
+var lowerLayers = &lowerLayerCache{layers: make(map[string]*lowerLayer)}
+
+// usage returns the bytes used by the lower layer dir, which runtimes may
+// name through a symlink.
+func (c *lowerLayerCache) usage(fsInfo fs.FsInfo, dir string) (uint64, error) {
+	now := time.Now()
+	c.Lock()
+	if now.Sub(c.lastSweep) > lowerLayerExpiry {
+		for d, layer := range c.layers {
+			if now.Sub(layer.lastUsed) > lowerLayerExpiry {
+				delete(c.layers, d)
+			}
+		}
+		c.lastSweep = now
+	}
+	if layer, ok := c.layers[dir]; ok {
+		layer.lastUsed = now
+		c.Unlock()
+		return layer.bytes, nil
+	}
+	c.Unlock()
+
+	// Layers shared by containers starting together may be measured more
+	// than once, rather than holding the lock while walking them.
+	target, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return 0, err
+	}
+	usage, err := fsInfo.GetDirUsage(target)
+	if err != nil {
+		return 0, err
+	}
+	c.Lock()
+	c.layers[dir] = &lowerLayer{bytes: usage.Bytes, lastUsed: now}
+	c.Unlock()
+	return usage.Bytes, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/lib/fs"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// dirUsageFsInfo is an FsInfo that only knows the usage of directories.
+type dirUsageFsInfo struct {
+	fs.FsInfo
+	usage map[string]fs.UsageInfo
+}
+
+func (f *dirUsageFsInfo) GetDirUsage(dir string) (fs.UsageInfo, error) {
+	return f.usage[dir], nil
+}
+
+// limitFsInfo is a dirUsageFsInfo that records the limits the usage of
+// directories was requested with.
+type limitFsInfo struct {
+	dirUsageFsInfo
+	limits map[string]uint64
+}
+
+func (f *limitFsInfo) GetDirUsageWithLimit(dir string, limit uint64) (fs.UsageInfo, error) {
+	f.limits[dir] = limit
+	return f.usage[dir], nil
+}
+
+func TestFsHandlerQgroupUsage(t *testing.T) {
+	fsInfo := &dirUsageFsInfo{usage: map[string]fs.UsageInfo{
+		"/var/lib/docker/btrfs/subvolumes/abc": {
+			Bytes:  16384,
+			Qgroup: &fs.QgroupUsage{Referenced: 1048576, Exclusive: 16384},
+		},
+		"/var/lib/docker/containers/abc": {Bytes: 4096},
+	}}
+	fh := NewFsHandler(DefaultPeriod, "/var/lib/docker/btrfs/subvolumes/abc", "/var/lib/docker/containers/abc", fsInfo).(*realFsHandler)
+	require.NoError(t, fh.update())
+
+	usage := fh.Usage()
+	assert.Equal(t, uint64(16384), usage.BaseUsageBytes)
+	assert.Equal(t, uint64(20480), usage.TotalUsageBytes)
+	assert.Equal(t, &info.BtrfsQgroupStats{Referenced: 1048576, Exclusive: 16384}, QgroupStats(usage.Qgroup))
+
+	// Directories that are not subvolumes with a qgroup have no qgroup stats.
+	fh = NewFsHandler(DefaultPeriod, "/var/lib/docker/containers/abc", "", fsInfo).(*realFsHandler)
+	require.NoError(t, fh.update())
+	assert.Nil(t, QgroupStats(fh.Usage().Qgroup))
+}
+
+func TestFsHandlerLimit(t *testing.T) {
+	fsInfo := &limitFsInfo{limits: make(map[string]uint64)}
+	fh := NewFsHandler(DefaultPeriod, "/var/lib/docker/overlay2/abc/diff", "/var/lib/docker/containers/abc", fsInfo).(FsHandlerWithLimit)
+	fh.SetLimit(1 << 30)
+	require.NoError(t, fh.(*realFsHandler).update())
+
+	// Only the rootfs is measured against the limit of the container.
+	assert.Equal(t, map[string]uint64{"/var/lib/docker/overlay2/abc/diff": 1 << 30}, fsInfo.limits)
+}
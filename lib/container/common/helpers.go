@@ -457,3 +457,33 @@ func RemoveNetMetrics(metrics container.MetricSet, remove bool) container.Metric
 	// A copy of all metrics except for network ones.
 	return metrics.Difference(container.AllNetworkMetrics)
 }
+
+// SandboxOf returns the sandbox, one of the info.Sandbox* constants, that the
+// runtime with the given name runs containers in, or "" if it runs them
+// directly on the host kernel. It recognizes containerd runtime types such as
+// "io.containerd.kata-qemu.v2" and "io.containerd.runsc.v1" as well as the
+// runtime handlers configured in CRI runtimes, e.g. "kata" or "gvisor".
+func SandboxOf(runtime string) string {
+	runtime = strings.ToLower(runtime)
+	switch {
+	case strings.Contains(runtime, "kata"):
+		return info.SandboxKata
+	case strings.Contains(runtime, "runsc"), strings.Contains(runtime, "gvisor"):
+		return info.SandboxGVisor
+	}
+	return ""
+}
+
+// ParseStateTime parses a StartedAt/FinishedAt timestamp of a container state
+// as reported by the Docker-compatible inspect APIs, returning the zero time
+// for unset ("0001-01-01...") or malformed values.
+func ParseStateTime(value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil || t.Before(time.Unix(0, 0)) {
+		return time.Time{}
+	}
+	return t
+}
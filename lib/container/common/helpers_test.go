@@ -24,6 +24,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -285,3 +286,29 @@ func BenchmarkGetSpecCgroupV1(b *testing.B) {
 	}
 
 }
+
+func TestSandboxOf(t *testing.T) {
+	for runtime, sandbox := range map[string]string{
+		"io.containerd.runc.v2":      "",
+		"io.containerd.kata.v2":      info.SandboxKata,
+		"io.containerd.kata-qemu.v2": info.SandboxKata,
+		"io.containerd.runsc.v1":     info.SandboxGVisor,
+		"kata-clh":                   info.SandboxKata,
+		"gVisor":                     info.SandboxGVisor,
+		"crun":                       "",
+		"":                           "",
+	} {
+		assert.Equal(t, sandbox, SandboxOf(runtime), runtime)
+	}
+}
+
+func TestParseStateTime(t *testing.T) {
+	for value, expected := range map[string]time.Time{
+		"2026-03-01T10:20:30.123456789Z": time.Date(2026, 3, 1, 10, 20, 30, 123456789, time.UTC),
+		"0001-01-01T00:00:00Z":           {},
+		"not a time":                     {},
+		"":                               {},
+	} {
+		assert.True(t, expected.Equal(ParseStateTime(value)), value)
+	}
+}
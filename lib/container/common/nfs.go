@@ -0,0 +1,50 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package common
+
+import (
+	"github.com/google/cadvisor/lib/fs"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// NfsStats converts the client statistics of an NFS mount, if any, to those
+// reported with the stats of the filesystem.
+func NfsStats(stats *fs.NfsStats) *info.NfsStats {
+	if stats == nil {
+		return nil
+	}
+	out := &info.NfsStats{
+		Server:     stats.Server,
+		Export:     stats.Export,
+		ReadBytes:  stats.ReadBytes,
+		WriteBytes: stats.WriteBytes,
+	}
+	for _, op := range stats.Ops {
+		var retransmissions uint64
+		if op.Transmissions > op.Requests {
+			retransmissions = op.Transmissions - op.Requests
+		}
+		out.Ops = append(out.Ops, info.NfsOpStats{
+			Op:              op.Op,
+			Requests:        op.Requests,
+			Retransmissions: retransmissions,
+			Rtt:             op.Rtt,
+			ExecuteTime:     op.Execute,
+		})
+	}
+	return out
+}
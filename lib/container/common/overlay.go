@@ -0,0 +1,55 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package common
+
+import (
+	"path"
+	"strconv"
+
+	"github.com/google/cadvisor/lib/fs/overlay"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// RootLayersFunc returns a LayersFunc reading the overlay layers of the root
+// filesystem of the container whose init process is pid. rootFs is where the
+// host filesystem is mounted and base the directory of the storage driver,
+// which relative layers are under.
+func RootLayersFunc(rootFs string, pid int, base string) LayersFunc {
+	return func() (overlay.Layers, bool, error) {
+		layers, ok, err := overlay.ReadRootLayers(path.Join(rootFs, "proc", strconv.Itoa(pid)))
+		if err != nil || !ok {
+			return overlay.Layers{}, ok, err
+		}
+		return layers.Resolve(rootFs, base), true, nil
+	}
+}
+
+// OverlayStats converts the usage of the overlay layers of a container, if
+// known, to that reported with the stats of its filesystem.
+func OverlayStats(usage *OverlayUsage) *info.OverlayStats {
+	if usage == nil {
+		return nil
+	}
+	threshold := *overlay.ArgCopyUpRateThreshold
+	return &info.OverlayStats{
+		UpperUsage:      usage.UpperBytes,
+		LowerUsage:      usage.LowerBytes,
+		LowerLayers:     usage.LowerLayers,
+		UpperGrowthRate: usage.UpperGrowthRate,
+		CopyUpHeavy:     threshold > 0 && usage.UpperGrowthRate > float64(threshold),
+	}
+}
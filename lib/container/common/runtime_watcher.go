@@ -0,0 +1,225 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opencontainers/cgroups"
+
+	"github.com/google/cadvisor/lib/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+// Bounds of the delay before resubscribing to a runtime's event stream.
+const (
+	runtimeEventsMinBackoff = time.Second
+	runtimeEventsMaxBackoff = 30 * time.Second
+)
+
+// RuntimeEvent is a container lifecycle event reported by a container runtime.
+type RuntimeEvent struct {
+	// The kind of change: ContainerAdd when the container started,
+	// ContainerDelete when it exited and ContainerUpdate when its metadata
+	// changed.
+	Type watcher.ContainerEventType
+
+	// ID of the container in the runtime.
+	ID string
+
+	// PID of a process of the container, used to find its cgroup. Only needed
+	// for containers not seen before, 0 if unknown.
+	Pid int
+}
+
+// RuntimeEventSource subscribes to a runtime's event stream and passes its
+// container events to handle until ctx is cancelled or the stream fails.
+type RuntimeEventSource func(ctx context.Context, handle func(RuntimeEvent)) error
+
+// RuntimeEventWatcher is a watcher.ContainerWatcher fed by a runtime's event
+// stream rather than by the cgroup hierarchy, so that containers are added as
+// soon as the runtime starts them and their metadata refreshed when it
+// changes. Containers are named after the cgroup of the process the runtime
+// reports for them. Events about containers whose cgroup cannot be found are
+// dropped: the raw watcher still reports them once their cgroup appears or
+// disappears.
+//
+// Implementation is thread-safe.
+type RuntimeEventWatcher struct {
+	// Name of the runtime, for logging.
+	runtime string
+	source  RuntimeEventSource
+	// Root filesystem of the host, "/" or "/rootfs".
+	rootFs string
+
+	lock sync.Mutex
+	// Container names by runtime ID.
+	names map[string]string
+	// Stops the subscription, nil when not started.
+	cancel context.CancelFunc
+	// Closed once the subscription has stopped.
+	done chan struct{}
+}
+
+var _ watcher.ContainerWatcher = &RuntimeEventWatcher{}
+
+// HostRootFs returns where the host's root filesystem is seen: "/rootfs" when
+// cAdvisor runs in a container with it mounted there, as the manager assumes,
+// "/" otherwise.
+func HostRootFs() string {
+	if _, err := os.Stat("/rootfs/proc"); err == nil {
+		return "/rootfs"
+	}
+	return "/"
+}
+
+// NewRuntimeEventWatcher returns a watcher for the events of the named runtime
+// read from source. rootFs is where the host's /proc is found.
+func NewRuntimeEventWatcher(runtime string, source RuntimeEventSource, rootFs string) *RuntimeEventWatcher {
+	return &RuntimeEventWatcher{
+		runtime: runtime,
+		source:  source,
+		rootFs:  rootFs,
+		names:   make(map[string]string),
+	}
+}
+
+func (w *RuntimeEventWatcher) Start(events chan watcher.ContainerEvent) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.cancel != nil {
+		return fmt.Errorf("%s event watcher already started", w.runtime)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+	go w.run(ctx, events, w.done)
+	return nil
+}
+
+func (w *RuntimeEventWatcher) Stop() error {
+	w.lock.Lock()
+	cancel, done := w.cancel, w.done
+	w.cancel = nil
+	w.lock.Unlock()
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+	<-done
+	return nil
+}
+
+// run subscribes to the event stream until ctx is cancelled, resubscribing
+// with exponential backoff whenever the stream fails.
+func (w *RuntimeEventWatcher) run(ctx context.Context, events chan watcher.ContainerEvent, done chan struct{}) {
+	defer close(done)
+	backoff := runtimeEventsMinBackoff
+	for {
+		subscribed := time.Now()
+		err := w.source(ctx, func(event RuntimeEvent) {
+			if containerEvent, ok := w.translate(event); ok {
+				select {
+				case events <- containerEvent:
+				case <-ctx.Done():
+				}
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		// A stream that lasted a while was healthy; start over.
+		if time.Since(subscribed) > runtimeEventsMaxBackoff {
+			backoff = runtimeEventsMinBackoff
+		}
+		klog.Warningf("Lost %s event stream, resubscribing in %v: %v", w.runtime, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+		backoff = min(2*backoff, runtimeEventsMaxBackoff)
+	}
+}
+
+// translate returns the container event for a runtime event, and whether
+// there is one.
+func (w *RuntimeEventWatcher) translate(event RuntimeEvent) (watcher.ContainerEvent, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	name, known := w.names[event.ID]
+	if !known && event.Type != watcher.ContainerDelete && event.Pid > 0 {
+		var err error
+		name, err = w.cgroupName(event.Pid)
+		if err != nil {
+			klog.V(4).Infof("Failed to find the cgroup of %s container %q: %v", w.runtime, event.ID, err)
+		} else {
+			known = true
+			w.names[event.ID] = name
+		}
+	}
+	if !known {
+		klog.V(5).Infof("Ignoring %s event for unknown container %q", w.runtime, event.ID)
+		return watcher.ContainerEvent{}, false
+	}
+	if event.Type == watcher.ContainerDelete {
+		delete(w.names, event.ID)
+	}
+	return watcher.ContainerEvent{
+		EventType:   event.Type,
+		Name:        name,
+		WatchSource: watcher.Runtime,
+	}, true
+}
+
+// cgroupName returns the name of the container of the process pid: its cgroup
+// in the unified hierarchy or, with cgroup v1, in the cpu or memory one.
+func (w *RuntimeEventWatcher) cgroupName(pid int) (string, error) {
+	paths, err := cgroups.ParseCgroupFile(filepath.Join(w.rootFs, "proc", strconv.Itoa(pid), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	return cgroupNameFromPaths(paths, cgroups.IsCgroup2UnifiedMode())
+}
+
+func cgroupNameFromPaths(paths map[string]string, unified bool) (string, error) {
+	subsystems := []string{"cpu", "memory"}
+	if unified {
+		subsystems = []string{""}
+	}
+	for _, subsystem := range subsystems {
+		name, ok := paths[subsystem]
+		if !ok {
+			continue
+		}
+		// Paths outside of our cgroup namespace cannot be monitored.
+		if !strings.HasPrefix(name, "/") || strings.HasPrefix(name, "/..") {
+			return "", fmt.Errorf("cgroup %q is not visible", name)
+		}
+		return name, nil
+	}
+	return "", fmt.Errorf("no cgroup found among %v", subsystems)
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package common
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/lib/watcher"
+)
+
+func TestCgroupNameFromPaths(t *testing.T) {
+	name, err := cgroupNameFromPaths(map[string]string{"": "/system.slice/docker-abc.scope"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "/system.slice/docker-abc.scope", name)
+
+	name, err = cgroupNameFromPaths(map[string]string{"memory": "/docker/abc", "name=systemd": "/"}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "/docker/abc", name)
+
+	_, err = cgroupNameFromPaths(map[string]string{"": "/../system.slice/docker-abc.scope"}, true)
+	assert.Error(t, err)
+	_, err = cgroupNameFromPaths(map[string]string{"pids": "/docker/abc"}, false)
+	assert.Error(t, err)
+}
+
+func TestRuntimeEventWatcher(t *testing.T) {
+	rootFs := t.TempDir()
+	procDir := filepath.Join(rootFs, "proc", "42")
+	require.NoError(t, os.MkdirAll(procDir, 0o755))
+	// The same cgroup in every hierarchy, whichever mode the host is in.
+	require.NoError(t, os.WriteFile(filepath.Join(procDir, "cgroup"), []byte("4:cpu,cpuacct:/docker/abc\n3:memory:/docker/abc\n0::/docker/abc\n"), 0o644))
+
+	runtimeEvents := []RuntimeEvent{
+		// Unknown container without a PID: dropped.
+		{Type: watcher.ContainerUpdate, ID: "def"},
+		{Type: watcher.ContainerAdd, ID: "abc", Pid: 42},
+		{Type: watcher.ContainerUpdate, ID: "abc"},
+		{Type: watcher.ContainerDelete, ID: "abc"},
+		// Forgotten once deleted.
+		{Type: watcher.ContainerDelete, ID: "abc"},
+	}
+	source := func(ctx context.Context, handle func(RuntimeEvent)) error {
+		for _, event := range runtimeEvents {
+			handle(event)
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	w := NewRuntimeEventWatcher("test", source, rootFs)
+	events := make(chan watcher.ContainerEvent)
+	require.NoError(t, w.Start(events))
+	assert.Error(t, w.Start(events))
+
+	for _, eventType := range []watcher.ContainerEventType{watcher.ContainerAdd, watcher.ContainerUpdate, watcher.ContainerDelete} {
+		assert.Equal(t, watcher.ContainerEvent{
+			EventType:   eventType,
+			Name:        "/docker/abc",
+			WatchSource: watcher.Runtime,
+		}, <-events)
+	}
+	assert.NoError(t, w.Stop())
+	assert.NoError(t, w.Stop())
+}
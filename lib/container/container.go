@@ -16,7 +16,11 @@
 // defines an interface for container operation handlers.
 package container
 
-import info "github.com/google/cadvisor/lib/model"
+import (
+	"time"
+
+	info "github.com/google/cadvisor/lib/model"
+)
 
 // ListType describes whether listing should be just for a
 // specific container or performed recursively.
@@ -35,6 +39,9 @@ const (
 	ContainerTypeCrio
 	ContainerTypeContainerd
 	ContainerTypePodman
+	ContainerTypeSystemd
+	ContainerTypeCRI
+	ContainerTypeLXC
 )
 
 // Interface for container operation handlers.
@@ -81,3 +88,42 @@ type ContainerHandler interface {
 	// Type of handler
 	Type() ContainerType
 }
+
+// ExitStatus describes how a container's main process terminated.
+type ExitStatus struct {
+	// ExitCode is the exit code of the main process, -1 if unknown.
+	ExitCode int
+
+	// Signal is the signal that terminated the main process, 0 if it exited
+	// on its own or the signal is unknown.
+	Signal int
+
+	// StartedAt and FinishedAt bound the container's last run. Either may be
+	// zero if the runtime does not report it.
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// ExitStatusHandler is implemented by handlers whose runtime reports more
+// about a container's exit than GetExitCode does. The manager prefers it over
+// GetExitCode when recording container deletions.
+type ExitStatusHandler interface {
+	GetExitStatus() (ExitStatus, error)
+}
+
+// MetadataRefresher is implemented by handlers that cache metadata, such as
+// labels or aliases, looked up from their runtime when they were created. The
+// manager calls RefreshMetadata when the runtime reports that the container
+// changed.
+type MetadataRefresher interface {
+	RefreshMetadata() error
+}
+
+// SignalFromExitCode returns the signal encoded in a shell-style exit code
+// (128+n), as reported by runc-based runtimes for signalled processes, or 0.
+func SignalFromExitCode(exitCode int) int {
+	if exitCode > 128 && exitCode < 128+65 {
+		return exitCode - 128
+	}
+	return 0
+}
@@ -18,13 +18,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"sync"
 	"time"
 
 	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	contentapi "github.com/containerd/containerd/api/services/content/v1"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	namespacesapi "github.com/containerd/containerd/api/services/namespaces/v1"
 	tasksapi "github.com/containerd/containerd/api/services/tasks/v1"
 	versionapi "github.com/containerd/containerd/api/services/version/v1"
+	"github.com/containerd/containerd/api/types"
 	tasktypes "github.com/containerd/containerd/api/types/task"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
@@ -38,9 +44,13 @@ import (
 )
 
 type client struct {
-	containerService containersapi.ContainersClient
-	taskService      tasksapi.TasksClient
-	versionService   versionapi.VersionClient
+	containerService  containersapi.ContainersClient
+	contentService    contentapi.ContentClient
+	eventsService     eventsapi.EventsClient
+	imagesService     imagesapi.ImagesClient
+	namespacesService namespacesapi.NamespacesClient
+	taskService       tasksapi.TasksClient
+	versionService    versionapi.VersionClient
 }
 
 type ContainerdClient interface {
@@ -48,7 +58,20 @@ type ContainerdClient interface {
 	TaskPid(ctx context.Context, id string) (uint32, error)
 	LoadTaskProcess(ctx context.Context, id string) (*tasktypes.Process, error)
 	TaskExitStatus(ctx context.Context, id string) (uint32, error)
+	// TaskMetrics returns the metrics the shim of a task reports, which for
+	// sandboxed runtimes come from inside the sandbox.
+	TaskMetrics(ctx context.Context, id string) (*types.Metric, error)
 	Version(ctx context.Context) (string, error)
+	// Namespaces lists the containerd namespaces.
+	Namespaces(ctx context.Context) ([]string, error)
+	// ListImages lists the images of the namespace of ctx.
+	ListImages(ctx context.Context) ([]*imagesapi.Image, error)
+	// ReadContent returns the blob with the given digest from the content
+	// store, e.g. an image manifest.
+	ReadContent(ctx context.Context, digest string) ([]byte, error)
+	// Subscribe passes the events of the given topics, from every namespace,
+	// to handle until ctx is cancelled or the stream fails.
+	Subscribe(ctx context.Context, handle func(*types.Envelope), topics ...string) error
 }
 
 var (
@@ -118,9 +141,13 @@ func Client(address, namespace string) (ContainerdClient, error) {
 			return
 		}
 		ctrdClient = &client{
-			containerService: containersapi.NewContainersClient(conn),
-			taskService:      tasksapi.NewTasksClient(conn),
-			versionService:   versionapi.NewVersionClient(conn),
+			containerService:  containersapi.NewContainersClient(conn),
+			contentService:    contentapi.NewContentClient(conn),
+			eventsService:     eventsapi.NewEventsClient(conn),
+			imagesService:     imagesapi.NewImagesClient(conn),
+			namespacesService: namespacesapi.NewNamespacesClient(conn),
+			taskService:       tasksapi.NewTasksClient(conn),
+			versionService:    versionapi.NewVersionClient(conn),
 		}
 	})
 	return ctrdClient, ctrdClientErr
@@ -173,6 +200,21 @@ func (c *client) TaskExitStatus(ctx context.Context, id string) (uint32, error)
 	return response.Process.ExitStatus, nil
 }
 
+func (c *client) TaskMetrics(ctx context.Context, id string) (*types.Metric, error) {
+	response, err := c.taskService.Metrics(ctx, &tasksapi.MetricsRequest{
+		Filters: []string{"id==" + id},
+	})
+	if err != nil {
+		return nil, toNative(err)
+	}
+	for _, metric := range response.Metrics {
+		if metric.ID == id {
+			return metric, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no metrics for task %q", ErrNotFound, id)
+}
+
 func (c *client) Version(ctx context.Context) (string, error) {
 	response, err := c.versionService.Version(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -181,6 +223,44 @@ func (c *client) Version(ctx context.Context) (string, error) {
 	return response.Version, nil
 }
 
+func (c *client) Namespaces(ctx context.Context) ([]string, error) {
+	response, err := c.namespacesService.List(ctx, &namespacesapi.ListNamespacesRequest{})
+	if err != nil {
+		return nil, toNative(err)
+	}
+	names := make([]string, 0, len(response.Namespaces))
+	for _, ns := range response.Namespaces {
+		names = append(names, ns.Name)
+	}
+	return names, nil
+}
+
+func (c *client) ListImages(ctx context.Context) ([]*imagesapi.Image, error) {
+	response, err := c.imagesService.List(ctx, &imagesapi.ListImagesRequest{})
+	if err != nil {
+		return nil, toNative(err)
+	}
+	return response.Images, nil
+}
+
+func (c *client) ReadContent(ctx context.Context, digest string) ([]byte, error) {
+	stream, err := c.contentService.Read(ctx, &contentapi.ReadContentRequest{Digest: digest})
+	if err != nil {
+		return nil, toNative(err)
+	}
+	var data []byte
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return data, nil
+		}
+		if err != nil {
+			return nil, toNative(err)
+		}
+		data = append(data, response.Data...)
+	}
+}
+
 func containerFromProto(containerpb *containersapi.Container) *containers.Container {
 	var runtime containers.RuntimeInfo
 	var createdAt time.Time
@@ -206,3 +286,21 @@ func containerFromProto(containerpb *containersapi.Container) *containers.Contai
 		CreatedAt:   createdAt,
 	}
 }
+
+func (c *client) Subscribe(ctx context.Context, handle func(*types.Envelope), topics ...string) error {
+	filters := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		filters = append(filters, fmt.Sprintf("topic==%q", topic))
+	}
+	stream, err := c.eventsService.Subscribe(ctx, &eventsapi.SubscribeRequest{Filters: filters})
+	if err != nil {
+		return toNative(err)
+	}
+	for {
+		envelope, err := stream.Recv()
+		if err != nil {
+			return toNative(err)
+		}
+		handle(envelope)
+	}
+}
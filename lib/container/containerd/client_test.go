@@ -17,10 +17,14 @@ package containerd
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"github.com/containerd/containerd/api/types"
 	"github.com/containerd/containerd/api/types/task"
 
 	"github.com/google/cadvisor/lib/container/containerd/containers"
+	"github.com/google/cadvisor/lib/container/containerd/namespaces"
 )
 
 type containerdClientMock struct {
@@ -28,19 +32,58 @@ type containerdClientMock struct {
 	returnErr  error
 	tasks      map[string]*task.Process
 	exitStatus uint32
+	// Containers by namespace and ID. If set, cntrs is ignored.
+	namespaced map[string]map[string]*containers.Container
+	// Events passed to Subscribe handlers, whatever the topics.
+	events []*types.Envelope
+	// Task metrics by container ID.
+	metrics map[string]*types.Metric
+	// Images by namespace, and the content store by digest.
+	images  map[string][]*imagesapi.Image
+	content map[string][]byte
 }
 
 func (c *containerdClientMock) LoadContainer(ctx context.Context, id string) (*containers.Container, error) {
 	if c.returnErr != nil {
 		return nil, c.returnErr
 	}
-	cntr, ok := c.cntrs[id]
+	cntrs := c.cntrs
+	if c.namespaced != nil {
+		ns, _ := namespaces.Namespace(ctx)
+		cntrs = c.namespaced[ns]
+	}
+	cntr, ok := cntrs[id]
 	if !ok {
 		return nil, fmt.Errorf("unable to find container %q", id)
 	}
 	return cntr, nil
 }
 
+func (c *containerdClientMock) Namespaces(ctx context.Context) ([]string, error) {
+	if c.returnErr != nil {
+		return nil, c.returnErr
+	}
+	names := []string{"k8s.io"}
+	if c.namespaced != nil {
+		names = names[:0]
+		for ns := range c.namespaced {
+			names = append(names, ns)
+		}
+		sort.Strings(names)
+	}
+	return names, nil
+}
+
+func (c *containerdClientMock) Subscribe(ctx context.Context, handle func(*types.Envelope), topics ...string) error {
+	if c.returnErr != nil {
+		return c.returnErr
+	}
+	for _, envelope := range c.events {
+		handle(envelope)
+	}
+	return nil
+}
+
 func (c *containerdClientMock) Version(ctx context.Context) (string, error) {
 	return "test-v0.0.0", nil
 }
@@ -67,6 +110,36 @@ func (c *containerdClientMock) TaskExitStatus(ctx context.Context, id string) (u
 	return c.exitStatus, nil
 }
 
+func (c *containerdClientMock) TaskMetrics(ctx context.Context, id string) (*types.Metric, error) {
+	if c.returnErr != nil {
+		return nil, c.returnErr
+	}
+	metric, ok := c.metrics[id]
+	if !ok {
+		return nil, fmt.Errorf("unable to find metrics for task %q", id)
+	}
+	return metric, nil
+}
+
+func (c *containerdClientMock) ListImages(ctx context.Context) ([]*imagesapi.Image, error) {
+	if c.returnErr != nil {
+		return nil, c.returnErr
+	}
+	ns, _ := namespaces.Namespace(ctx)
+	return c.images[ns], nil
+}
+
+func (c *containerdClientMock) ReadContent(ctx context.Context, digest string) ([]byte, error) {
+	if c.returnErr != nil {
+		return nil, c.returnErr
+	}
+	data, ok := c.content[digest]
+	if !ok {
+		return nil, fmt.Errorf("%w: content %s", ErrNotFound, digest)
+	}
+	return data, nil
+}
+
 func mockcontainerdClient(cntrs map[string]*containers.Container, returnErr error) ContainerdClient {
 	tasks := make(map[string]*task.Process)
 
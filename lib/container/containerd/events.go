@@ -0,0 +1,94 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package containerd
+
+import (
+	"context"
+	"flag"
+	"slices"
+
+	eventtypes "github.com/containerd/containerd/api/events"
+	"github.com/containerd/containerd/api/types"
+
+	"github.com/google/cadvisor/lib/container/common"
+	"github.com/google/cadvisor/lib/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var argContainerdEvents = flag.Bool("containerd_events", false, "Subscribe to containerd's event stream to pick up containers as soon as their task starts and their label changes, on top of watching the cgroup hierarchy")
+
+// Topics of the containerd events that affect monitored containers.
+const (
+	taskStartTopic       = "/tasks/start"
+	taskExitTopic        = "/tasks/exit"
+	containerUpdateTopic = "/containers/update"
+)
+
+// newEventWatcher returns a watcher of the containers of the monitored
+// namespaces reported by containerd's event stream.
+func newEventWatcher(client ContainerdClient, namespaces namespaceSet) watcher.ContainerWatcher {
+	return common.NewRuntimeEventWatcher("containerd", eventSource(client, namespaces), common.HostRootFs())
+}
+
+func eventSource(client ContainerdClient, namespaces namespaceSet) common.RuntimeEventSource {
+	return func(ctx context.Context, handle func(common.RuntimeEvent)) error {
+		return client.Subscribe(ctx, func(envelope *types.Envelope) {
+			if event, ok := runtimeEvent(envelope, namespaces); ok {
+				handle(event)
+			}
+		}, taskStartTopic, taskExitTopic, containerUpdateTopic)
+	}
+}
+
+// runtimeEvent returns the container event an envelope carries, if it is
+// about a container of one of the monitored namespaces. Container IDs are
+// qualified by their namespace, as they are only unique within it.
+func runtimeEvent(envelope *types.Envelope, namespaces namespaceSet) (common.RuntimeEvent, bool) {
+	if !namespaces.all && !slices.Contains(namespaces.names, envelope.Namespace) {
+		return common.RuntimeEvent{}, false
+	}
+	if envelope.Event == nil {
+		return common.RuntimeEvent{}, false
+	}
+	message, err := envelope.Event.UnmarshalNew()
+	if err != nil {
+		klog.V(4).Infof("Failed to decode containerd %q event: %v", envelope.Topic, err)
+		return common.RuntimeEvent{}, false
+	}
+	event := common.RuntimeEvent{}
+	switch e := message.(type) {
+	case *eventtypes.TaskStart:
+		event.Type = watcher.ContainerAdd
+		event.ID = e.ContainerID
+		event.Pid = int(e.Pid)
+	case *eventtypes.TaskExit:
+		// Exec'd processes exit too; only the init process ends the container.
+		if e.ID != "" && e.ID != e.ContainerID {
+			return common.RuntimeEvent{}, false
+		}
+		event.Type = watcher.ContainerDelete
+		event.ID = e.ContainerID
+	case *eventtypes.ContainerUpdate:
+		event.Type = watcher.ContainerUpdate
+		event.ID = e.ID
+	default:
+		return common.RuntimeEvent{}, false
+	}
+	event.ID = envelope.Namespace + "/" + event.ID
+	return event, true
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package containerd
+
+import (
+	"context"
+	"testing"
+
+	eventtypes "github.com/containerd/containerd/api/events"
+	"github.com/containerd/containerd/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/google/cadvisor/lib/container/common"
+	"github.com/google/cadvisor/lib/watcher"
+)
+
+func envelope(t *testing.T, namespace, topic string, event proto.Message) *types.Envelope {
+	any, err := anypb.New(event)
+	require.NoError(t, err)
+	return &types.Envelope{Namespace: namespace, Topic: topic, Event: any}
+}
+
+func TestEventSource(t *testing.T) {
+	client := &containerdClientMock{
+		events: []*types.Envelope{
+			envelope(t, "k8s.io", taskStartTopic, &eventtypes.TaskStart{ContainerID: "abc", Pid: 42}),
+			// Not a monitored namespace.
+			envelope(t, "moby", taskStartTopic, &eventtypes.TaskStart{ContainerID: "def", Pid: 43}),
+			// An exec'd process exiting.
+			envelope(t, "k8s.io", taskExitTopic, &eventtypes.TaskExit{ContainerID: "abc", ID: "exec-1"}),
+			envelope(t, "k8s.io", containerUpdateTopic, &eventtypes.ContainerUpdate{ID: "abc", Labels: map[string]string{"a": "b"}}),
+			envelope(t, "k8s.io", taskExitTopic, &eventtypes.TaskExit{ContainerID: "abc", ID: "abc", ExitStatus: 1}),
+		},
+	}
+
+	var got []common.RuntimeEvent
+	err := eventSource(client, parseNamespaceSet("k8s.io"))(context.Background(), func(event common.RuntimeEvent) {
+		got = append(got, event)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []common.RuntimeEvent{
+		{Type: watcher.ContainerAdd, ID: "k8s.io/abc", Pid: 42},
+		{Type: watcher.ContainerUpdate, ID: "k8s.io/abc"},
+		{Type: watcher.ContainerDelete, ID: "k8s.io/abc"},
+	}, got)
+
+	got = nil
+	err = eventSource(client, parseNamespaceSet("*"))(context.Background(), func(event common.RuntimeEvent) {
+		got = append(got, event)
+	})
+	assert.NoError(t, err)
+	assert.Len(t, got, 4)
+	assert.Equal(t, common.RuntimeEvent{Type: watcher.ContainerAdd, ID: "moby/def", Pid: 43}, got[1])
+}
@@ -18,15 +18,20 @@ package containerd
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"path"
 	"regexp"
+	"slices"
 	"strings"
 
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"k8s.io/klog/v2"
 
 	"github.com/google/cadvisor/lib/container"
+	"github.com/google/cadvisor/lib/container/containerd/containers"
+	"github.com/google/cadvisor/lib/container/containerd/namespaces"
 	"github.com/google/cadvisor/lib/container/libcontainer"
 	"github.com/google/cadvisor/lib/fs"
 	info "github.com/google/cadvisor/lib/model"
@@ -34,13 +39,19 @@ import (
 )
 
 var ArgContainerdEndpoint = flag.String("containerd", "/run/containerd/containerd.sock", "containerd endpoint")
-var ArgContainerdNamespace = flag.String("containerd-namespace", "k8s.io", "containerd namespace")
+var ArgContainerdNamespace = flag.String("containerd-namespace", "k8s.io", "comma-separated list of containerd namespaces to monitor containers in, \"*\" for all of them. Containers in the first namespace listed can also be looked up by their bare ID")
+
+var argSandboxShimStats = flag.Bool("containerd_sandbox_shim_stats", false, "Read the cpu, memory, process and network stats of containers that run in a sandbox, such as Kata Containers VMs or gVisor, from their shim rather than from their host cgroup, which accounts for the sandbox as a whole")
 
 var containerdEnvMetadataWhiteList = flag.String("containerd_env_metadata_whitelist", "", "DEPRECATED: this flag will be removed, please use `env_metadata_whitelist`. A comma-separated list of environment variable keys matched with specified prefix that needs to be collected for containerd containers")
 
 // The namespace under which containerd aliases are unique.
 const k8sContainerdNamespace = "containerd"
 
+// NamespaceLabel is the label set on containerd containers to the containerd
+// namespace they are in.
+const NamespaceLabel = "io.containerd.namespace"
+
 // Regexp that identifies containerd cgroups, containers started with
 // --cgroup-parent have another prefix than 'containerd'
 var containerdCgroupRegexp = regexp.MustCompile(`([a-z0-9]{64})`)
@@ -54,6 +65,56 @@ type containerdFactory struct {
 	// Information about mounted filesystems.
 	fsInfo          fs.FsInfo
 	includedMetrics container.MetricSet
+	// The containerd namespaces containers are looked up in.
+	namespaces namespaceSet
+}
+
+// namespaceSet is the set of containerd namespaces to monitor.
+type namespaceSet struct {
+	// The primary namespace, whose containers are also aliased by their bare
+	// ID, or "" if there is none.
+	primary string
+	// Namespaces to monitor, primary first, unless all is set.
+	names []string
+	// Whether to monitor all namespaces.
+	all bool
+}
+
+// parseNamespaceSet parses the value of --containerd-namespace.
+func parseNamespaceSet(value string) namespaceSet {
+	var set namespaceSet
+	for _, ns := range strings.Split(value, ",") {
+		ns = strings.TrimSpace(ns)
+		switch {
+		case ns == "":
+		case ns == "*":
+			set.all = true
+		case !slices.Contains(set.names, ns):
+			set.names = append(set.names, ns)
+		}
+	}
+	if len(set.names) > 0 && !strings.HasPrefix(strings.TrimSpace(value), "*") {
+		set.primary = set.names[0]
+	}
+	return set
+}
+
+// candidates returns the namespaces to look a container up in, primary first.
+func (s namespaceSet) candidates(ctx context.Context, client ContainerdClient) ([]string, error) {
+	if !s.all {
+		return s.names, nil
+	}
+	all, err := client.Namespaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containerd namespaces: %v", err)
+	}
+	candidates := slices.Clone(s.names)
+	for _, ns := range all {
+		if !slices.Contains(candidates, ns) {
+			candidates = append(candidates, ns)
+		}
+	}
+	return candidates, nil
 }
 
 func (f *containerdFactory) String() string {
@@ -61,7 +122,9 @@ func (f *containerdFactory) String() string {
 }
 
 func (f *containerdFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (handler container.ContainerHandler, err error) {
-	client, err := Client(*ArgContainerdEndpoint, *ArgContainerdNamespace)
+	ctx, cancel := context.WithTimeout(context.Background(), connectionTimeout)
+	defer cancel()
+	ns, _, err := f.lookupContainer(ctx, name)
 	if err != nil {
 		return
 	}
@@ -74,8 +137,10 @@ func (f *containerdFactory) NewContainerHandler(name string, metadataEnvAllowLis
 	}
 
 	return newContainerdContainerHandler(
-		client,
+		f.client,
 		name,
+		ns,
+		ns == f.namespaces.primary,
 		f.machineInfoFactory,
 		f.fsInfo,
 		f.cgroupSubsystems,
@@ -111,42 +176,125 @@ func (f *containerdFactory) CanHandleAndAccept(name string) (bool, bool, error)
 		return false, false, nil
 	}
 	// Check if the container is known to containerd and it is running.
-	id := ContainerNameToContainerdID(name)
 	// If container and task lookup in containerd fails then we assume
 	// that the container state is not known to containerd
 	ctx, cancel := context.WithTimeout(context.Background(), connectionTimeout)
 	defer cancel()
-	_, err := f.client.LoadContainer(ctx, id)
+	_, _, err := f.lookupContainer(ctx, name)
 	if err != nil {
-		return false, false, fmt.Errorf("failed to load container: %v", err)
+		return false, false, err
 	}
 
 	return true, true, nil
 }
 
+// lookupContainer finds the container whose cgroup is name and the namespace
+// it is in. Container IDs are only unique within a namespace, so if several
+// monitored namespaces have a container with the ID in name, the one whose
+// cgroup is name is chosen, and the lookup fails unless exactly one is.
+func (f *containerdFactory) lookupContainer(ctx context.Context, name string) (string, *containers.Container, error) {
+	id := ContainerNameToContainerdID(name)
+	candidates, err := f.namespaces.candidates(ctx, f.client)
+	if err != nil {
+		return "", nil, err
+	}
+	var (
+		foundNs, matchedNs []string
+		found, matched     []*containers.Container
+		lastErr            error
+	)
+	for _, ns := range candidates {
+		cntr, err := f.client.LoadContainer(namespaces.WithNamespace(ctx, ns), id)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		foundNs, found = append(foundNs, ns), append(found, cntr)
+		if cgroupMatches(cntr, name) {
+			matchedNs, matched = append(matchedNs, ns), append(matched, cntr)
+		}
+	}
+	switch {
+	case len(found) == 1:
+		return foundNs[0], found[0], nil
+	case len(matched) == 1:
+		return matchedNs[0], matched[0], nil
+	case len(found) > 1:
+		return "", nil, fmt.Errorf("container %q exists in containerd namespaces %v and %d of them have cgroup %q", id, foundNs, len(matched), name)
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no containerd namespace to look %q up in", id)
+	}
+	return "", nil, fmt.Errorf("failed to load container: %v", lastErr)
+}
+
+// cgroupMatches returns whether name is the cgroup of cntr according to its
+// OCI spec. cgroupsPath is either a path, or "slice:prefix:name" with the
+// systemd cgroup driver, which systemd turns into a "prefix-name.scope" unit.
+func cgroupMatches(cntr *containers.Container, name string) bool {
+	if cntr.Spec == nil {
+		return false
+	}
+	var spec specs.Spec
+	if err := json.Unmarshal(cntr.Spec.Value, &spec); err != nil || spec.Linux == nil || spec.Linux.CgroupsPath == "" {
+		return false
+	}
+	cgroupsPath := spec.Linux.CgroupsPath
+	if parts := strings.Split(cgroupsPath, ":"); len(parts) == 3 {
+		unit := parts[2]
+		if parts[1] != "" {
+			unit = parts[1] + "-" + parts[2]
+		}
+		return path.Base(name) == unit+".scope"
+	}
+	cgroupsPath = path.Clean("/" + cgroupsPath)
+	if name == cgroupsPath {
+		return true
+	}
+	// cAdvisor may see the cgroup relative to the root of its cgroup
+	// namespace, i.e. as the trailing path elements of cgroupsPath.
+	return name != "/" && strings.HasSuffix(cgroupsPath, name) && strings.HasPrefix(name, "/")
+}
+
 func (f *containerdFactory) DebugInfo() map[string][]string {
 	return map[string][]string{}
 }
 
 // Register root container before running this function!
 func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
-	client, err := Client(*ArgContainerdEndpoint, *ArgContainerdNamespace)
+	_, err := register(factory, fsInfo, includedMetrics)
+	return err
+}
+
+// register registers the containerd factory and returns the watcher of
+// containerd's event stream, nil unless --containerd_events is set.
+func register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) (watcher.ContainerWatcher, error) {
+	namespaceSet := parseNamespaceSet(*ArgContainerdNamespace)
+	if len(namespaceSet.names) == 0 && !namespaceSet.all {
+		return nil, fmt.Errorf("no containerd namespace to monitor")
+	}
+	defaultNamespace := namespaceSet.primary
+	if defaultNamespace == "" {
+		defaultNamespace = namespaces.Default
+	}
+	client, err := Client(*ArgContainerdEndpoint, defaultNamespace)
 	if err != nil {
-		return fmt.Errorf("unable to create containerd client: %v", err)
+		return nil, fmt.Errorf("unable to create containerd client: %v", err)
 	}
 
 	containerdVersion, err := client.Version(context.Background())
 	if err != nil {
-		return fmt.Errorf("failed to fetch containerd client version: %v", err)
+		return nil, fmt.Errorf("failed to fetch containerd client version: %v", err)
 	}
 
 	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
 	if err != nil {
-		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+		return nil, fmt.Errorf("failed to get cgroup subsystems: %v", err)
 	}
 
-	klog.V(1).Infof("Registering containerd factory")
+	klog.V(1).Infof("Registering containerd factory for namespaces %q", *ArgContainerdNamespace)
 	f := &containerdFactory{
+		namespaces:         namespaceSet,
 		cgroupSubsystems:   cgroupSubsystems,
 		client:             client,
 		fsInfo:             fsInfo,
@@ -155,6 +303,9 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics
 		includedMetrics:    includedMetrics,
 	}
 
-	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
-	return nil
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw, watcher.Runtime})
+	if !*argContainerdEvents {
+		return nil, nil
+	}
+	return newEventWatcher(client, namespaceSet), nil
 }
@@ -17,6 +17,7 @@
 package containerd
 
 import (
+	"context"
 	"testing"
 
 	"github.com/containerd/typeurl/v2"
@@ -64,6 +65,7 @@ func TestCanHandleAndAccept(t *testing.T) {
 		fsInfo:             nil,
 		machineInfoFactory: nil,
 		includedMetrics:    nil,
+		namespaces:         parseNamespaceSet("k8s.io"),
 	}
 	for k, v := range map[string]bool{
 		"/kubepods/besteffort/podd76e26fba3bf2bfd215eb29011d55250/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9":                        true,
@@ -75,3 +77,89 @@ func TestCanHandleAndAccept(t *testing.T) {
 		as.Equal(b2, v)
 	}
 }
+
+func TestParseNamespaceSet(t *testing.T) {
+	for value, expected := range map[string]namespaceSet{
+		"k8s.io":             {primary: "k8s.io", names: []string{"k8s.io"}},
+		"k8s.io, moby,moby,": {primary: "k8s.io", names: []string{"k8s.io", "moby"}},
+		"k8s.io,*":           {primary: "k8s.io", names: []string{"k8s.io"}, all: true},
+		"*":                  {all: true},
+		"*,default":          {names: []string{"default"}, all: true},
+		"":                   {},
+	} {
+		assert.Equal(t, expected, parseNamespaceSet(value), value)
+	}
+}
+
+func newTestContainer(t *testing.T, id, cgroupsPath string) *containers.Container {
+	cntr := &containers.Container{ID: id}
+	spec := &specs.Spec{Process: &specs.Process{}, Linux: &specs.Linux{CgroupsPath: cgroupsPath}}
+	var err error
+	cntr.Spec, err = typeurl.MarshalAnyToProto(spec)
+	assert.NoError(t, err)
+	return cntr
+}
+
+func TestCgroupMatches(t *testing.T) {
+	const id = "40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9"
+	for _, tc := range []struct {
+		cgroupsPath string
+		name        string
+		expected    bool
+	}{
+		{"/default/" + id, "/default/" + id, true},
+		{"/default/" + id, "/moby/" + id, false},
+		{"system.slice:nerdctl:" + id, "/system.slice/nerdctl-" + id + ".scope", true},
+		{"system.slice:docker:" + id, "/system.slice/nerdctl-" + id + ".scope", false},
+		// Seen from a cgroup namespace rooted at /kubepods.
+		{"/kubepods/besteffort/pod1/" + id, "/besteffort/pod1/" + id, true},
+		{"/kubepods/besteffort/pod1/" + id, "/" + id, true},
+		{"/kubepods/besteffort/pod1/" + id, "/1/" + id, false},
+		{"/kubepods/besteffort/pod1/" + id, "/", false},
+		// Only trailing path elements of cgroupsPath match, not the reverse.
+		{"/pod1/" + id, "/besteffort/pod1/" + id, false},
+		{"", "/default/" + id, false},
+	} {
+		assert.Equal(t, tc.expected, cgroupMatches(newTestContainer(t, id, tc.cgroupsPath), tc.name), "%s vs %s", tc.cgroupsPath, tc.name)
+	}
+}
+
+func TestLookupContainerAcrossNamespaces(t *testing.T) {
+	const (
+		id      = "40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9"
+		otherID = "14ae50f1d3ada102aec3ab00168fdafb2dc0986d79ca9e8d5b75581fa89e9fea"
+	)
+	client := &containerdClientMock{namespaced: map[string]map[string]*containers.Container{
+		"k8s.io":  {otherID: newTestContainer(t, otherID, "/kubepods/pod1/"+otherID)},
+		"default": {id: newTestContainer(t, id, "/default/"+id)},
+		"moby":    {id: newTestContainer(t, id, "system.slice:docker:"+id)},
+	}}
+
+	f := &containerdFactory{client: client, namespaces: parseNamespaceSet("k8s.io,*")}
+	for name, expectedNs := range map[string]string{
+		"/kubepods/pod1/" + otherID:                   "k8s.io",
+		"/default/" + id:                              "default",
+		"/system.slice/docker-" + id + ".scope":       "moby",
+		"/system.slice/nerdctl-" + otherID + ".scope": "k8s.io",
+	} {
+		ns, cntr, err := f.lookupContainer(context.Background(), name)
+		assert.NoError(t, err, name)
+		assert.Equal(t, expectedNs, ns, name)
+		assert.Equal(t, ContainerNameToContainerdID(name), cntr.ID, name)
+	}
+
+	// A container found in several namespaces needs exactly one of them to
+	// have its cgroup.
+	_, _, err := f.lookupContainer(context.Background(), "/other/"+id)
+	assert.Error(t, err)
+
+	// Only the namespaces listed are searched, and the only container found
+	// is used even if its cgroup does not match.
+	f.namespaces = parseNamespaceSet("k8s.io,moby")
+	ns, _, err := f.lookupContainer(context.Background(), "/default/"+id)
+	assert.NoError(t, err)
+	assert.Equal(t, "moby", ns)
+	f.namespaces = parseNamespaceSet("k8s.io")
+	_, _, err = f.lookupContainer(context.Background(), "/default/"+id)
+	assert.Error(t, err)
+}
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/opencontainers/cgroups"
@@ -30,6 +31,8 @@ import (
 
 	"github.com/google/cadvisor/lib/container"
 	"github.com/google/cadvisor/lib/container/common"
+	"github.com/google/cadvisor/lib/container/containerd/containers"
+	"github.com/google/cadvisor/lib/container/containerd/namespaces"
 	containerlibcontainer "github.com/google/cadvisor/lib/container/libcontainer"
 	"github.com/google/cadvisor/lib/fs"
 	info "github.com/google/cadvisor/lib/model"
@@ -44,7 +47,10 @@ type containerdContainerHandler struct {
 	// Metadata associated with the container.
 	reference info.ContainerReference
 	envs      map[string]string
-	labels    map[string]string
+	// Lock for labels and image, which change when containerd reports an
+	// update.
+	metadataLock sync.RWMutex
+	labels       map[string]string
 	// Time at which this container was created.
 	creationTime time.Time
 	// Image name used for this container.
@@ -54,6 +60,12 @@ type containerdContainerHandler struct {
 
 	libcontainerHandler *containerlibcontainer.Handler
 	client              ContainerdClient
+	// The containerd namespace the container is in.
+	namespace string
+	// The sandbox the container runs in, if any, see info.ContainerSpec.Sandbox.
+	sandbox string
+	// Whether to read the stats of the sandboxed container from its shim.
+	shimStats bool
 }
 
 var _ container.ContainerHandler = &containerdContainerHandler{}
@@ -62,6 +74,8 @@ var _ container.ContainerHandler = &containerdContainerHandler{}
 func newContainerdContainerHandler(
 	client ContainerdClient,
 	name string,
+	namespace string,
+	primaryNamespace bool,
 	machineInfoFactory info.MachineInfoFactory,
 	fsInfo fs.FsInfo,
 	cgroupSubsystems map[string]string,
@@ -80,7 +94,7 @@ func newContainerdContainerHandler(
 
 	id := ContainerNameToContainerdID(name)
 	// We assume that if load fails then the container is not known to containerd.
-	ctx := context.Background()
+	ctx := namespaces.WithNamespace(context.Background(), namespace)
 	cntr, err := client.LoadContainer(ctx, id)
 	if err != nil {
 		return nil, err
@@ -122,11 +136,18 @@ func newContainerdContainerHandler(
 		rootfs = "/rootfs"
 	}
 
+	// IDs are only unique within a containerd namespace, so every container
+	// is aliased by its namespaced ID. Containers in the primary namespace are
+	// also aliased by their bare ID, as they were before several namespaces
+	// could be monitored.
 	containerReference := info.ContainerReference{
 		Id:        id,
 		Name:      name,
 		Namespace: k8sContainerdNamespace,
-		Aliases:   []string{id, name},
+		Aliases:   []string{namespace + "/" + id, name},
+	}
+	if primaryNamespace {
+		containerReference.Aliases = []string{id, name, namespace + "/" + id}
 	}
 
 	// Containers that don't have their own network -- this includes
@@ -143,12 +164,15 @@ func newContainerdContainerHandler(
 		cgroupPaths:         cgroupPaths,
 		fsInfo:              fsInfo,
 		envs:                make(map[string]string),
-		labels:              cntr.Labels,
+		labels:              containerLabels(cntr, namespace),
 		includedMetrics:     metrics,
 		reference:           containerReference,
 		libcontainerHandler: libcontainerHandler,
 		client:              client,
+		namespace:           namespace,
+		sandbox:             common.SandboxOf(cntr.Runtime.Name),
 	}
+	handler.shimStats = handler.sandbox != "" && *argSandboxShimStats
 	if !cntr.CreatedAt.IsZero() && !cntr.CreatedAt.Before(time.Unix(0, 0)) {
 		handler.creationTime = cntr.CreatedAt
 	}
@@ -174,6 +198,17 @@ func newContainerdContainerHandler(
 	return handler, nil
 }
 
+// containerLabels returns the labels of cntr, in namespace, along with the
+// NamespaceLabel.
+func containerLabels(cntr *containers.Container, namespace string) map[string]string {
+	labels := make(map[string]string, len(cntr.Labels)+1)
+	for k, v := range cntr.Labels {
+		labels[k] = v
+	}
+	labels[NamespaceLabel] = namespace
+	return labels
+}
+
 func (h *containerdContainerHandler) ContainerReference() (info.ContainerReference, error) {
 	return h.reference, nil
 }
@@ -184,9 +219,12 @@ func (h *containerdContainerHandler) GetSpec() (info.ContainerSpec, error) {
 	hasFilesystem := false
 	hasNet := h.includedMetrics.Has(container.NetworkUsageMetrics)
 	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNet, hasFilesystem)
+	h.metadataLock.RLock()
 	spec.Labels = h.labels
-	spec.Envs = h.envs
 	spec.Image = h.image
+	h.metadataLock.RUnlock()
+	spec.Envs = h.envs
+	spec.Sandbox = h.sandbox
 	startTime := spec.CreationTime
 	if !h.creationTime.IsZero() {
 		spec.CreationTime = h.creationTime
@@ -216,6 +254,17 @@ func (h *containerdContainerHandler) GetStats() (*info.ContainerStats, error) {
 		return stats, err
 	}
 
+	if h.shimStats {
+		ctx := namespaces.WithNamespace(context.Background(), h.namespace)
+		metric, err := h.client.TaskMetrics(ctx, h.reference.Id)
+		if err != nil {
+			return stats, fmt.Errorf("failed to get the shim metrics of %q: %v", h.reference.Name, err)
+		}
+		if err := setShimStats(metric, h.includedMetrics, stats); err != nil {
+			return stats, err
+		}
+	}
+
 	// Get filesystem stats.
 	err = h.getFsStats(stats)
 	return stats, err
@@ -238,9 +287,26 @@ func (h *containerdContainerHandler) GetCgroupPath(resource string) (string, err
 }
 
 func (h *containerdContainerHandler) GetContainerLabels() map[string]string {
+	h.metadataLock.RLock()
+	defer h.metadataLock.RUnlock()
 	return h.labels
 }
 
+// RefreshMetadata implements container.MetadataRefresher: it reloads the
+// labels and image of the container, which containerd lets clients update.
+func (h *containerdContainerHandler) RefreshMetadata() error {
+	ctx := namespaces.WithNamespace(context.Background(), h.namespace)
+	cntr, err := h.client.LoadContainer(ctx, h.reference.Id)
+	if err != nil {
+		return err
+	}
+	h.metadataLock.Lock()
+	defer h.metadataLock.Unlock()
+	h.labels = containerLabels(cntr, h.namespace)
+	h.image = cntr.Image
+	return nil
+}
+
 func (h *containerdContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
 	return h.libcontainerHandler.GetProcesses()
 }
@@ -265,10 +331,24 @@ func (h *containerdContainerHandler) GetContainerIPAddress() string {
 }
 
 func (h *containerdContainerHandler) GetExitCode() (int, error) {
-	ctx := context.Background()
+	ctx := namespaces.WithNamespace(context.Background(), h.namespace)
 	exitStatus, err := h.client.TaskExitStatus(ctx, h.reference.Id)
 	if err != nil {
 		return -1, err
 	}
 	return int(exitStatus), nil
 }
+
+// GetExitStatus implements container.ExitStatusHandler. containerd reports
+// signalled tasks with a 128+n exit status, from which the signal is derived.
+func (h *containerdContainerHandler) GetExitStatus() (container.ExitStatus, error) {
+	exitCode, err := h.GetExitCode()
+	if err != nil {
+		return container.ExitStatus{ExitCode: -1}, err
+	}
+	return container.ExitStatus{
+		ExitCode:  exitCode,
+		Signal:    container.SignalFromExitCode(exitCode),
+		StartedAt: h.creationTime,
+	}, nil
+}
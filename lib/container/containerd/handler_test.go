@@ -98,7 +98,7 @@ func TestHandler(t *testing.T) {
 			&info.ContainerReference{
 				Id:        "40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9",
 				Name:      "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9",
-				Aliases:   []string{"40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9", "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9"},
+				Aliases:   []string{"40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9", "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9", "k8s.io/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9"},
 				Namespace: k8sContainerdNamespace,
 			},
 			map[string]string{},
@@ -117,13 +117,13 @@ func TestHandler(t *testing.T) {
 			&info.ContainerReference{
 				Id:        "40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9",
 				Name:      "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9",
-				Aliases:   []string{"40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9", "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9"},
+				Aliases:   []string{"40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9", "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9", "k8s.io/40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9"},
 				Namespace: k8sContainerdNamespace,
 			},
 			map[string]string{"TEST_REGION": "FRA", "TEST_ZONE": "A"},
 		},
 	} {
-		handler, err := newContainerdContainerHandler(ts.client, ts.name, ts.machineInfoFactory, ts.fsInfo, ts.cgroupSubsystems, ts.inHostNamespace, ts.metadataEnvAllowList, ts.includedMetrics)
+		handler, err := newContainerdContainerHandler(ts.client, ts.name, "k8s.io", true, ts.machineInfoFactory, ts.fsInfo, ts.cgroupSubsystems, ts.inHostNamespace, ts.metadataEnvAllowList, ts.includedMetrics)
 		if ts.hasErr {
 			as.NotNil(err)
 			if ts.errContains != "" {
@@ -203,3 +203,47 @@ func TestGetExitCode(t *testing.T) {
 		})
 	}
 }
+
+func TestRefreshMetadata(t *testing.T) {
+	mockClient := &containerdClientMock{
+		namespaced: map[string]map[string]*containers.Container{
+			"default": {
+				"abc": {ID: "abc", Image: "busybox:1.37", Labels: map[string]string{"app": "new"}},
+			},
+		},
+	}
+	h := &containerdContainerHandler{
+		client:    mockClient,
+		namespace: "default",
+		labels:    map[string]string{"app": "old"},
+		image:     "busybox:1.36",
+		reference: info.ContainerReference{
+			Id: "abc",
+		},
+	}
+
+	assert.NoError(t, h.RefreshMetadata())
+	assert.Equal(t, map[string]string{"app": "new", NamespaceLabel: "default"}, h.GetContainerLabels())
+	assert.Equal(t, "busybox:1.37", h.image)
+
+	h.reference.Id = "missing"
+	assert.Error(t, h.RefreshMetadata())
+}
+
+func TestHandlerSandbox(t *testing.T) {
+	spec, err := typeurl.MarshalAnyToProto(&specs.Spec{Process: &specs.Process{}})
+	assert.NoError(t, err)
+	client := mockcontainerdClient(map[string]*containers.Container{
+		"abc": {ID: "abc", Spec: spec, Runtime: containers.RuntimeInfo{Name: "io.containerd.kata-qemu.v2"}},
+		"def": {ID: "def", Spec: spec, Runtime: containers.RuntimeInfo{Name: "io.containerd.runc.v2"}},
+	}, nil)
+
+	for id, sandbox := range map[string]string{"abc": info.SandboxKata, "def": ""} {
+		handler, err := newContainerdContainerHandler(client, "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/"+id, "k8s.io", true, &mockedMachineInfo{}, nil, nil, false, nil, nil)
+		assert.NoError(t, err)
+		h := handler.(*containerdContainerHandler)
+		assert.Equal(t, sandbox, h.sandbox, id)
+		// Stats are only read from the shim when asked to.
+		assert.False(t, h.shimStats, id)
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package containerd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/api/types"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/lib/container"
+	"github.com/google/cadvisor/lib/container/containerd/namespaces"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+var _ container.ImageStoreProvider = &containerdFactory{}
+
+// Timeout of listing the images of every monitored namespace.
+const imagesTimeout = 30 * time.Second
+
+// Media types of the Docker image manifests, which are laid out as their OCI
+// counterparts.
+const (
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+)
+
+// GetImageStore implements container.ImageStoreProvider, for the images of
+// the monitored namespaces. Images are identified by the digest of their
+// config, as the CRI plugin does, and described by their manifest for the
+// platform of the host.
+func (f *containerdFactory) GetImageStore() (info.ImageStore, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), imagesTimeout)
+	defer cancel()
+	nsList, err := f.namespaces.candidates(ctx, f.client)
+	if err != nil {
+		return info.ImageStore{}, err
+	}
+
+	images := make(map[string]*info.ImageInfo)
+	// The layers of each image, and the size of every blob, to account for
+	// the blobs images share once.
+	imageLayers := make(map[string][]string)
+	blobs := make(map[string]uint64)
+	for _, ns := range nsList {
+		nsCtx := namespaces.WithNamespace(ctx, ns)
+		list, err := f.client.ListImages(nsCtx)
+		if err != nil {
+			return info.ImageStore{}, fmt.Errorf("failed to list the images of namespace %q: %v", ns, err)
+		}
+		for _, image := range list {
+			if image.Target == nil {
+				continue
+			}
+			manifest, err := f.platformManifest(nsCtx, image.Target.MediaType, image.Target.Digest)
+			if err != nil {
+				// The image may be partially pulled.
+				klog.V(4).Infof("Failed to read the manifest of image %q: %v", image.Name, err)
+				continue
+			}
+			id := manifest.Config.Digest.String()
+			imageInfo, ok := images[id]
+			if !ok {
+				imageInfo = &info.ImageInfo{ID: id, Layers: len(manifest.Layers)}
+				imageInfo.Size = uint64(manifest.Config.Size)
+				blobs[id] = uint64(manifest.Config.Size)
+				for _, layer := range manifest.Layers {
+					imageInfo.Size += uint64(layer.Size)
+					blobs[layer.Digest.String()] = uint64(layer.Size)
+					imageLayers[id] = append(imageLayers[id], layer.Digest.String())
+				}
+				imageInfo.Created = f.imageCreated(nsCtx, id)
+				images[id] = imageInfo
+			}
+			addImageName(imageInfo, image.Name, image.Target)
+		}
+	}
+
+	store := info.ImageStore{
+		Runtime: k8sContainerdNamespace,
+		Images:  make([]info.ImageInfo, 0, len(images)),
+	}
+	for _, size := range blobs {
+		store.Usage += size
+	}
+	refs := make(map[string]int)
+	for _, layers := range imageLayers {
+		for _, layer := range layers {
+			refs[layer]++
+		}
+	}
+	for id, image := range images {
+		for _, layer := range imageLayers[id] {
+			if refs[layer] > 1 {
+				image.SharedSize += blobs[layer]
+			}
+		}
+		store.Images = append(store.Images, *image)
+	}
+	sort.Slice(store.Images, func(i, j int) bool { return store.Images[i].ID < store.Images[j].ID })
+	return store, nil
+}
+
+// platformManifest returns the manifest of the image whose target has the
+// given media type and digest, for the platform of the host if the target is
+// an index.
+func (f *containerdFactory) platformManifest(ctx context.Context, mediaType, digest string) (*ocispec.Manifest, error) {
+	data, err := f.client.ReadContent(ctx, digest)
+	if err != nil {
+		return nil, err
+	}
+	switch mediaType {
+	case ocispec.MediaTypeImageManifest, mediaTypeDockerManifest:
+		var manifest ocispec.Manifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("failed to decode manifest %s: %v", digest, err)
+		}
+		return &manifest, nil
+	case ocispec.MediaTypeImageIndex, mediaTypeDockerManifestList:
+		var index ocispec.Index
+		if err := json.Unmarshal(data, &index); err != nil {
+			return nil, fmt.Errorf("failed to decode index %s: %v", digest, err)
+		}
+		// Only the manifest of the platform the image was pulled for need
+		// be in the content store.
+		for _, descriptor := range index.Manifests {
+			platform := descriptor.Platform
+			if platform != nil && (platform.OS != runtime.GOOS || platform.Architecture != runtime.GOARCH) {
+				continue
+			}
+			manifest, err := f.platformManifest(ctx, descriptor.MediaType, descriptor.Digest.String())
+			if err == nil {
+				return manifest, nil
+			}
+		}
+		return nil, fmt.Errorf("no manifest for %s/%s in index %s", runtime.GOOS, runtime.GOARCH, digest)
+	default:
+		return nil, fmt.Errorf("unsupported media type %q of %s", mediaType, digest)
+	}
+}
+
+// imageCreated returns the time at which the image whose config has the given
+// digest was built, or the zero time if its config does not say.
+func (f *containerdFactory) imageCreated(ctx context.Context, configDigest string) time.Time {
+	data, err := f.client.ReadContent(ctx, configDigest)
+	if err != nil {
+		klog.V(4).Infof("Failed to read the config of image %q: %v", configDigest, err)
+		return time.Time{}
+	}
+	var config ocispec.Image
+	if err := json.Unmarshal(data, &config); err != nil || config.Created == nil {
+		return time.Time{}
+	}
+	return config.Created.UTC()
+}
+
+// addImageName adds the name of a containerd image, e.g.
+// "docker.io/library/nginx:1.27" or "docker.io/library/nginx@sha256:...", to
+// the tags or digests of the image it refers to. Tags also make for a digest
+// of the image in its repository.
+func addImageName(image *info.ImageInfo, name string, target *types.Descriptor) {
+	switch {
+	case strings.HasPrefix(name, "sha256:"):
+		// The CRI plugin also names images by their ID.
+		return
+	case strings.Contains(name, "@"):
+		if !slices.Contains(image.RepoDigests, name) {
+			image.RepoDigests = append(image.RepoDigests, name)
+		}
+		return
+	}
+	if !slices.Contains(image.RepoTags, name) {
+		image.RepoTags = append(image.RepoTags, name)
+	}
+	repository := name
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		repository = name[:i]
+	}
+	if digest := repository + "@" + target.Digest; !slices.Contains(image.RepoDigests, digest) {
+		image.RepoDigests = append(image.RepoDigests, digest)
+	}
+}
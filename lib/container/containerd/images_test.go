@@ -0,0 +1,117 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package containerd
+
+import (
+	"encoding/json"
+	"runtime"
+	"testing"
+	"time"
+
+	imagesapi "github.com/containerd/containerd/api/services/images/v1"
+	"github.com/containerd/containerd/api/types"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/lib/model"
+)
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return data
+}
+
+func TestGetImageStore(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	nginxManifest := ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    ocispec.Descriptor{Digest: "sha256:config-nginx", Size: 10},
+		Layers: []ocispec.Descriptor{
+			{Digest: "sha256:layer-base", Size: 100},
+			{Digest: "sha256:layer-nginx", Size: 200},
+		},
+	}
+	pauseManifest := ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    ocispec.Descriptor{Digest: "sha256:config-pause", Size: 5},
+		Layers:    []ocispec.Descriptor{{Digest: "sha256:layer-base", Size: 100}},
+	}
+	pauseIndex := ocispec.Index{
+		MediaType: ocispec.MediaTypeImageIndex,
+		Manifests: []ocispec.Descriptor{
+			{Digest: "sha256:manifest-other", Platform: &ocispec.Platform{OS: "plan9", Architecture: "mips"}},
+			{MediaType: ocispec.MediaTypeImageManifest, Digest: "sha256:manifest-pause", Platform: &ocispec.Platform{OS: runtime.GOOS, Architecture: runtime.GOARCH}},
+		},
+	}
+
+	client := mockcontainerdClient(nil, nil).(*containerdClientMock)
+	client.content = map[string][]byte{
+		"sha256:manifest-nginx": mustMarshal(t, nginxManifest),
+		"sha256:config-nginx":   mustMarshal(t, ocispec.Image{Created: &created}),
+		"sha256:index-pause":    mustMarshal(t, pauseIndex),
+		"sha256:manifest-pause": mustMarshal(t, pauseManifest),
+	}
+	client.images = map[string][]*imagesapi.Image{
+		"k8s.io": {
+			{Name: "docker.io/library/nginx:1.27", Target: &types.Descriptor{MediaType: ocispec.MediaTypeImageManifest, Digest: "sha256:manifest-nginx"}},
+			{Name: "sha256:config-nginx", Target: &types.Descriptor{MediaType: ocispec.MediaTypeImageManifest, Digest: "sha256:manifest-nginx"}},
+			{Name: "registry.k8s.io/pause:3.10", Target: &types.Descriptor{MediaType: ocispec.MediaTypeImageIndex, Digest: "sha256:index-pause"}},
+			// Not pulled completely.
+			{Name: "docker.io/library/redis:7", Target: &types.Descriptor{MediaType: ocispec.MediaTypeImageManifest, Digest: "sha256:manifest-redis"}},
+		},
+		"default": {
+			{Name: "localhost:5000/nginx@sha256:manifest-nginx", Target: &types.Descriptor{MediaType: ocispec.MediaTypeImageManifest, Digest: "sha256:manifest-nginx"}},
+		},
+	}
+	f := &containerdFactory{client: client, namespaces: parseNamespaceSet("k8s.io,default")}
+
+	store, err := f.GetImageStore()
+	require.NoError(t, err)
+	assert.Equal(t, info.ImageStore{
+		Runtime: "containerd",
+		Usage:   315,
+		Images: []info.ImageInfo{
+			{
+				ID:          "sha256:config-nginx",
+				RepoTags:    []string{"docker.io/library/nginx:1.27"},
+				RepoDigests: []string{"docker.io/library/nginx@sha256:manifest-nginx", "localhost:5000/nginx@sha256:manifest-nginx"},
+				Size:        310,
+				SharedSize:  100,
+				Created:     created,
+				Layers:      2,
+			},
+			{
+				ID:          "sha256:config-pause",
+				RepoTags:    []string{"registry.k8s.io/pause:3.10"},
+				RepoDigests: []string{"registry.k8s.io/pause@sha256:index-pause"},
+				Size:        105,
+				SharedSize:  100,
+				Layers:      1,
+			},
+		},
+	}, store)
+}
+
+func TestPlatformManifestUnsupported(t *testing.T) {
+	client := mockcontainerdClient(nil, nil).(*containerdClientMock)
+	client.content = map[string][]byte{"sha256:blob": []byte("{}")}
+	f := &containerdFactory{client: client}
+	_, err := f.platformManifest(t.Context(), "application/octet-stream", "sha256:blob")
+	assert.Error(t, err)
+}
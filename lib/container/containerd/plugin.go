@@ -35,6 +35,5 @@ func (p *plugin) InitializeFSContext(context *fs.Context) error {
 }
 
 func (p *plugin) Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) (watcher.ContainerWatcher, error) {
-	err := Register(factory, fsInfo, includedMetrics)
-	return nil, err
+	return register(factory, fsInfo, includedMetrics)
 }
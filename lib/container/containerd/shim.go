@@ -0,0 +1,178 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerd
+
+import (
+	"fmt"
+
+	v1 "github.com/containerd/cgroups/v3/cgroup1/stats"
+	v2 "github.com/containerd/cgroups/v3/cgroup2/stats"
+	"github.com/containerd/containerd/api/types"
+
+	"github.com/google/cadvisor/lib/container"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// setShimStats replaces the cpu, memory, process and network stats of a
+// sandboxed container, which the host cgroup accounts to the sandbox as a
+// whole, with those its shim reports from inside the sandbox. Disk I/O and
+// the other stats are left as the host sees them.
+func setShimStats(metric *types.Metric, includedMetrics container.MetricSet, stats *info.ContainerStats) error {
+	if metric.GetData() == nil {
+		return fmt.Errorf("no metrics reported for task %q", metric.GetID())
+	}
+	data, err := metric.Data.UnmarshalNew()
+	if err != nil {
+		return fmt.Errorf("failed to decode the metrics of task %q: %v", metric.ID, err)
+	}
+	if stats.Cpu == nil {
+		stats.Cpu = &info.CpuStats{}
+	}
+	if stats.Processes == nil {
+		stats.Processes = &info.ProcessStats{}
+	}
+	var networks []info.InterfaceStats
+	switch m := data.(type) {
+	case *v1.Metrics:
+		setShimStatsV1(m, stats)
+		for _, n := range m.Network {
+			networks = append(networks, info.InterfaceStats{
+				Name: n.Name, RxBytes: n.RxBytes, RxPackets: n.RxPackets, RxErrors: n.RxErrors, RxDropped: n.RxDropped,
+				TxBytes: n.TxBytes, TxPackets: n.TxPackets, TxErrors: n.TxErrors, TxDropped: n.TxDropped,
+			})
+		}
+	case *v2.Metrics:
+		setShimStatsV2(m, stats)
+		for _, n := range m.Network {
+			networks = append(networks, info.InterfaceStats{
+				Name: n.Name, RxBytes: n.RxBytes, RxPackets: n.RxPackets, RxErrors: n.RxErrors, RxDropped: n.RxDropped,
+				TxBytes: n.TxBytes, TxPackets: n.TxPackets, TxErrors: n.TxErrors, TxDropped: n.TxDropped,
+			})
+		}
+	default:
+		return fmt.Errorf("unsupported metrics type %q for task %q", metric.Data.TypeUrl, metric.ID)
+	}
+	if metric.Timestamp != nil {
+		stats.Timestamp = metric.Timestamp.AsTime()
+	}
+	// Only the containers that have a network of their own have network
+	// metrics, as on the host.
+	if includedMetrics.Has(container.NetworkUsageMetrics) && len(networks) > 0 {
+		if stats.Network == nil {
+			stats.Network = &info.NetworkStats{}
+		}
+		stats.Network.Interfaces = networks
+		stats.Network.InterfaceStats = networks[0]
+	}
+	return nil
+}
+
+func setShimStatsV1(m *v1.Metrics, stats *info.ContainerStats) {
+	if cpu := m.GetCPU(); cpu != nil {
+		stats.Cpu.Usage = info.CpuUsage{
+			Total:  cpu.GetUsage().GetTotal(),
+			PerCpu: cpu.GetUsage().GetPerCPU(),
+			User:   cpu.GetUsage().GetUser(),
+			System: cpu.GetUsage().GetKernel(),
+		}
+		stats.Cpu.CFS = info.CpuCFS{
+			Periods:          cpu.GetThrottling().GetPeriods(),
+			ThrottledPeriods: cpu.GetThrottling().GetThrottledPeriods(),
+			ThrottledTime:    cpu.GetThrottling().GetThrottledTime(),
+		}
+	}
+	if mem := m.GetMemory(); mem != nil {
+		memory := info.MemoryStats{
+			Usage:             mem.GetUsage().GetUsage(),
+			MaxUsage:          mem.GetUsage().GetMax(),
+			Failcnt:           mem.GetUsage().GetFailcnt(),
+			KernelUsage:       mem.GetKernel().GetUsage(),
+			Cache:             mem.TotalCache,
+			RSS:               mem.TotalRSS,
+			MappedFile:        mem.TotalMappedFile,
+			TotalActiveFile:   mem.TotalActiveFile,
+			TotalInactiveFile: mem.TotalInactiveFile,
+			ContainerData:     info.MemoryStatsMemoryData{Pgfault: mem.PgFault, Pgmajfault: mem.PgMajFault},
+			HierarchicalData:  info.MemoryStatsMemoryData{Pgfault: mem.TotalPgFault, Pgmajfault: mem.TotalPgMajFault},
+		}
+		// The swap entry accounts for memory and swap together.
+		if swap := mem.GetSwap().GetUsage(); swap > memory.Usage {
+			memory.Swap = swap - memory.Usage
+		}
+		setWorkingSet(&memory)
+		stats.Memory = &memory
+	}
+	if pids := m.GetPids(); pids != nil {
+		stats.Processes.ThreadsCurrent = pids.Current
+		stats.Processes.ThreadsMax = pids.Limit
+	}
+}
+
+func setShimStatsV2(m *v2.Metrics, stats *info.ContainerStats) {
+	if cpu := m.GetCPU(); cpu != nil {
+		// cgroup v2 accounts CPU time in microseconds.
+		stats.Cpu.Usage = info.CpuUsage{
+			Total:  cpu.UsageUsec * 1000,
+			User:   cpu.UserUsec * 1000,
+			System: cpu.SystemUsec * 1000,
+		}
+		stats.Cpu.CFS = info.CpuCFS{
+			Periods:          cpu.NrPeriods,
+			ThrottledPeriods: cpu.NrThrottled,
+			ThrottledTime:    cpu.ThrottledUsec * 1000,
+			BurstsPeriods:    cpu.NrBursts,
+			BurstTime:        cpu.BurstUsec * 1000,
+		}
+	}
+	if mem := m.GetMemory(); mem != nil {
+		memory := info.MemoryStats{
+			Usage:             mem.Usage,
+			MaxUsage:          mem.MaxUsage,
+			Cache:             mem.File,
+			RSS:               mem.Anon,
+			Swap:              mem.SwapUsage,
+			MappedFile:        mem.FileMapped,
+			KernelUsage:       mem.KernelStack + mem.Slab + mem.Sock,
+			TotalActiveFile:   mem.ActiveFile,
+			TotalInactiveFile: mem.InactiveFile,
+			FileDirty:         mem.FileDirty,
+			FileWriteback:     mem.FileWriteback,
+			Pgscan:            mem.Pgscan,
+			Pgsteal:           mem.Pgsteal,
+			ContainerData:     info.MemoryStatsMemoryData{Pgfault: mem.Pgfault, Pgmajfault: mem.Pgmajfault},
+			HierarchicalData:  info.MemoryStatsMemoryData{Pgfault: mem.Pgfault, Pgmajfault: mem.Pgmajfault},
+		}
+		if events := m.GetMemoryEvents(); events != nil {
+			memory.Events = info.MemoryEvents{High: events.High, Max: events.Max}
+		}
+		setWorkingSet(&memory)
+		stats.Memory = &memory
+	}
+	if pids := m.GetPids(); pids != nil {
+		stats.Processes.ThreadsCurrent = pids.Current
+		stats.Processes.ThreadsMax = pids.Limit
+	}
+}
+
+// setWorkingSet sets the working set of memory as the libcontainer handler
+// does: the usage, less the inactive file pages.
+func setWorkingSet(memory *info.MemoryStats) {
+	memory.WorkingSet = memory.Usage
+	if memory.WorkingSet < memory.TotalInactiveFile {
+		memory.WorkingSet = 0
+	} else {
+		memory.WorkingSet -= memory.TotalInactiveFile
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerd
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/containerd/cgroups/v3/cgroup1/stats"
+	v2 "github.com/containerd/cgroups/v3/cgroup2/stats"
+	"github.com/containerd/containerd/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/google/cadvisor/lib/container"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+func shimMetric(t *testing.T, data proto.Message) *types.Metric {
+	t.Helper()
+	value, err := anypb.New(data)
+	require.NoError(t, err)
+	return &types.Metric{
+		ID:        "abc",
+		Timestamp: timestamppb.New(time.Unix(1700000000, 0)),
+		Data:      value,
+	}
+}
+
+func TestSetShimStatsV1(t *testing.T) {
+	metric := shimMetric(t, &v1.Metrics{
+		Pids: &v1.PidsStat{Current: 12, Limit: 100},
+		CPU: &v1.CPUStat{
+			Usage:      &v1.CPUUsage{Total: 3000, Kernel: 1000, User: 2000, PerCPU: []uint64{1000, 2000}},
+			Throttling: &v1.Throttle{Periods: 10, ThrottledPeriods: 2, ThrottledTime: 500},
+		},
+		Memory: &v1.MemoryStat{
+			TotalCache:        300,
+			TotalRSS:          700,
+			TotalInactiveFile: 200,
+			Usage:             &v1.MemoryEntry{Usage: 1000, Max: 1500, Failcnt: 1},
+			Swap:              &v1.MemoryEntry{Usage: 1100},
+		},
+		Network: []*v1.NetworkStat{{Name: "eth0", RxBytes: 42, TxBytes: 24}},
+	})
+	// As read from the host cgroup of the sandbox.
+	stats := &info.ContainerStats{
+		Cpu:     &info.CpuStats{Usage: info.CpuUsage{Total: 1 << 40}},
+		Memory:  &info.MemoryStats{Usage: 1 << 30},
+		DiskIo:  &info.DiskIoStats{},
+		Network: &info.NetworkStats{},
+	}
+
+	require.NoError(t, setShimStats(metric, container.AllMetrics, stats))
+	assert.True(t, time.Unix(1700000000, 0).Equal(stats.Timestamp))
+	assert.Equal(t, info.CpuUsage{Total: 3000, PerCpu: []uint64{1000, 2000}, User: 2000, System: 1000}, stats.Cpu.Usage)
+	assert.Equal(t, uint64(2), stats.Cpu.CFS.ThrottledPeriods)
+	assert.Equal(t, uint64(1000), stats.Memory.Usage)
+	assert.Equal(t, uint64(800), stats.Memory.WorkingSet)
+	assert.Equal(t, uint64(100), stats.Memory.Swap)
+	assert.Equal(t, uint64(300), stats.Memory.Cache)
+	assert.Equal(t, uint64(12), stats.Processes.ThreadsCurrent)
+	assert.Equal(t, []info.InterfaceStats{{Name: "eth0", RxBytes: 42, TxBytes: 24}}, stats.Network.Interfaces)
+	assert.Equal(t, "eth0", stats.Network.Name)
+	assert.NotNil(t, stats.DiskIo)
+}
+
+func TestSetShimStatsV2(t *testing.T) {
+	metric := shimMetric(t, &v2.Metrics{
+		CPU:          &v2.CPUStat{UsageUsec: 3, UserUsec: 2, SystemUsec: 1, NrThrottled: 4},
+		Memory:       &v2.MemoryStat{Usage: 1000, Anon: 600, File: 400, InactiveFile: 1200, SwapUsage: 10},
+		MemoryEvents: &v2.MemoryEvents{Max: 5},
+		Network:      []*v2.NetworkStat{{Name: "eth0", RxBytes: 42}},
+	})
+	stats := &info.ContainerStats{}
+
+	// Containers of a pod do not have network stats of their own.
+	require.NoError(t, setShimStats(metric, container.AllMetrics.Difference(container.AllNetworkMetrics), stats))
+	assert.Equal(t, info.CpuUsage{Total: 3000, User: 2000, System: 1000}, stats.Cpu.Usage)
+	assert.Equal(t, uint64(4), stats.Cpu.CFS.ThrottledPeriods)
+	assert.Equal(t, uint64(600), stats.Memory.RSS)
+	assert.Equal(t, uint64(10), stats.Memory.Swap)
+	assert.Equal(t, uint64(0), stats.Memory.WorkingSet)
+	assert.Equal(t, uint64(5), stats.Memory.Events.Max)
+	assert.Nil(t, stats.Network)
+}
+
+func TestSetShimStatsUnsupported(t *testing.T) {
+	assert.Error(t, setShimStats(shimMetric(t, &types.Platform{OS: "linux"}), container.AllMetrics, &info.ContainerStats{}))
+	assert.Error(t, setShimStats(&types.Metric{ID: "abc"}, container.AllMetrics, &info.ContainerStats{}))
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cri
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+var (
+	ArgCRIEndpoint   = flag.String("cri", "", "CRI runtime endpoint (e.g. unix:///run/containerd/containerd.sock) through which to monitor the containers of any CRI-compliant runtime. Empty disables the CRI factory")
+	criClientTimeout = flag.Duration("cri_client_timeout", 5*time.Second, "Timeout of each call to the CRI runtime")
+	argSandboxStats  = flag.Bool("cri_sandbox_stats", false, "Read the cpu and memory stats of CRI containers that run in a sandbox, such as Kata Containers VMs or gVisor, from the CRI runtime rather than from their host cgroup, which accounts for the sandbox as a whole")
+)
+
+const maxMsgSize = 16 * 1024 * 1024 // 16MB
+
+// ErrNotFound is returned when the runtime knows no container or pod sandbox
+// with the requested ID.
+var ErrNotFound = errors.New("not found")
+
+// client looks up containers and pod sandboxes through the CRI
+// RuntimeService, and images through the ImageService.
+type client struct {
+	runtime runtimeapi.RuntimeServiceClient
+	images  runtimeapi.ImageServiceClient
+	timeout time.Duration
+}
+
+// newClient connects to the CRI runtime listening on endpoint, a Unix socket
+// path with or without the unix:// scheme. The connection is established on
+// first use.
+func newClient(endpoint string, timeout time.Duration) (*client, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("no CRI endpoint")
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "unix://" + endpoint
+	}
+	if !strings.HasPrefix(endpoint, "unix://") {
+		return nil, fmt.Errorf("unsupported CRI endpoint %q: only unix sockets are supported", endpoint)
+	}
+	conn, err := grpc.NewClient(endpoint,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &client{
+		runtime: runtimeapi.NewRuntimeServiceClient(conn),
+		images:  runtimeapi.NewImageServiceClient(conn),
+		timeout: timeout,
+	}, nil
+}
+
+// context returns the context of a call, which has no deadline if the timeout
+// is zero.
+func (c *client) context() (context.Context, context.CancelFunc) {
+	if c.timeout == 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), c.timeout)
+}
+
+// Version returns the name and version of the runtime.
+func (c *client) Version() (*runtimeapi.VersionResponse, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+	return c.runtime.Version(ctx, &runtimeapi.VersionRequest{})
+}
+
+// Container returns the container with the given ID.
+func (c *client) Container(id string) (*runtimeapi.Container, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+	response, err := c.runtime.ListContainers(ctx, &runtimeapi.ListContainersRequest{
+		Filter: &runtimeapi.ContainerFilter{Id: id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, cntr := range response.Containers {
+		if cntr.Id == id {
+			return cntr, nil
+		}
+	}
+	return nil, fmt.Errorf("container %q: %w", id, ErrNotFound)
+}
+
+// ContainerStatus returns the status of the container with the given ID.
+func (c *client) ContainerStatus(id string) (*runtimeapi.ContainerStatus, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+	response, err := c.runtime.ContainerStatus(ctx, &runtimeapi.ContainerStatusRequest{ContainerId: id})
+	if err != nil {
+		return nil, err
+	}
+	if response.Status == nil {
+		return nil, fmt.Errorf("container %q: %w", id, ErrNotFound)
+	}
+	return response.Status, nil
+}
+
+// ContainerStats returns the stats the runtime reports for the container with
+// the given ID.
+func (c *client) ContainerStats(id string) (*runtimeapi.ContainerStats, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+	response, err := c.runtime.ContainerStats(ctx, &runtimeapi.ContainerStatsRequest{ContainerId: id})
+	if err != nil {
+		return nil, err
+	}
+	if response.Stats == nil {
+		return nil, fmt.Errorf("container %q: %w", id, ErrNotFound)
+	}
+	return response.Stats, nil
+}
+
+// PodSandbox returns the pod sandbox with the given ID.
+func (c *client) PodSandbox(id string) (*runtimeapi.PodSandbox, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+	response, err := c.runtime.ListPodSandbox(ctx, &runtimeapi.ListPodSandboxRequest{
+		Filter: &runtimeapi.PodSandboxFilter{Id: id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, sandbox := range response.Items {
+		if sandbox.Id == id {
+			return sandbox, nil
+		}
+	}
+	return nil, fmt.Errorf("pod sandbox %q: %w", id, ErrNotFound)
+}
+
+// Images returns the images the runtime stores.
+func (c *client) Images() ([]*runtimeapi.Image, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+	response, err := c.images.ListImages(ctx, &runtimeapi.ListImagesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Images, nil
+}
+
+// ImageStatus returns the verbose status of the image with the given ID.
+func (c *client) ImageStatus(id string) (*runtimeapi.ImageStatusResponse, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+	response, err := c.images.ImageStatus(ctx, &runtimeapi.ImageStatusRequest{
+		Image:   &runtimeapi.ImageSpec{Image: id},
+		Verbose: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.Image == nil {
+		return nil, fmt.Errorf("image %q: %w", id, ErrNotFound)
+	}
+	return response, nil
+}
+
+// ImageFsUsage returns the bytes used on the filesystems holding the images.
+func (c *client) ImageFsUsage() (uint64, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+	response, err := c.images.ImageFsInfo(ctx, &runtimeapi.ImageFsInfoRequest{})
+	if err != nil {
+		return 0, err
+	}
+	var usage uint64
+	for _, fs := range response.ImageFilesystems {
+		if fs.UsedBytes != nil {
+			usage += fs.UsedBytes.Value
+		}
+	}
+	return usage, nil
+}
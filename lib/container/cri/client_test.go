@@ -0,0 +1,191 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cri
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+const (
+	testContainerID = "40af7cdcbe507acad47a5a62025743ad3ddc6ab93b77b21363aa1c1d641047c9"
+	testSandboxID   = "7f1e2bd7a4d9c1a2b8e3f0c5d6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5"
+)
+
+// fakeRuntime is a CRI RuntimeService serving a fixed set of containers and
+// pod sandboxes.
+type fakeRuntime struct {
+	runtimeapi.UnimplementedRuntimeServiceServer
+
+	containers []*runtimeapi.Container
+	statuses   map[string]*runtimeapi.ContainerStatus
+	stats      map[string]*runtimeapi.ContainerStats
+	sandboxes  []*runtimeapi.PodSandbox
+}
+
+func (r *fakeRuntime) Version(ctx context.Context, req *runtimeapi.VersionRequest) (*runtimeapi.VersionResponse, error) {
+	return &runtimeapi.VersionResponse{RuntimeName: "fake", RuntimeVersion: "v1.0.0", RuntimeApiVersion: "v1"}, nil
+}
+
+func (r *fakeRuntime) ListContainers(ctx context.Context, req *runtimeapi.ListContainersRequest) (*runtimeapi.ListContainersResponse, error) {
+	response := &runtimeapi.ListContainersResponse{}
+	for _, cntr := range r.containers {
+		if req.Filter == nil || req.Filter.Id == "" || req.Filter.Id == cntr.Id {
+			response.Containers = append(response.Containers, cntr)
+		}
+	}
+	return response, nil
+}
+
+func (r *fakeRuntime) ContainerStatus(ctx context.Context, req *runtimeapi.ContainerStatusRequest) (*runtimeapi.ContainerStatusResponse, error) {
+	status, ok := r.statuses[req.ContainerId]
+	if !ok {
+		return nil, errNotFound(req.ContainerId)
+	}
+	return &runtimeapi.ContainerStatusResponse{Status: status}, nil
+}
+
+func (r *fakeRuntime) ContainerStats(ctx context.Context, req *runtimeapi.ContainerStatsRequest) (*runtimeapi.ContainerStatsResponse, error) {
+	stats, ok := r.stats[req.ContainerId]
+	if !ok {
+		return nil, errNotFound(req.ContainerId)
+	}
+	return &runtimeapi.ContainerStatsResponse{Stats: stats}, nil
+}
+
+func (r *fakeRuntime) ListPodSandbox(ctx context.Context, req *runtimeapi.ListPodSandboxRequest) (*runtimeapi.ListPodSandboxResponse, error) {
+	response := &runtimeapi.ListPodSandboxResponse{}
+	for _, sandbox := range r.sandboxes {
+		if req.Filter == nil || req.Filter.Id == "" || req.Filter.Id == sandbox.Id {
+			response.Items = append(response.Items, sandbox)
+		}
+	}
+	return response, nil
+}
+
+func errNotFound(id string) error {
+	return status.Errorf(codes.NotFound, "an error occurred when try to find container %q: not found", id)
+}
+
+func newFakeRuntime() *fakeRuntime {
+	return &fakeRuntime{
+		containers: []*runtimeapi.Container{{
+			Id:           testContainerID,
+			PodSandboxId: testSandboxID,
+			Metadata:     &runtimeapi.ContainerMetadata{Name: "app", Attempt: 2},
+			Image:        &runtimeapi.ImageSpec{Image: "registry.k8s.io/pause:3.10"},
+			State:        runtimeapi.ContainerState_CONTAINER_RUNNING,
+			CreatedAt:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC).UnixNano(),
+			Labels:       map[string]string{"app.kubernetes.io/name": "app"},
+		}},
+		statuses: map[string]*runtimeapi.ContainerStatus{
+			testContainerID: {
+				Id:         testContainerID,
+				State:      runtimeapi.ContainerState_CONTAINER_EXITED,
+				StartedAt:  time.Date(2026, 1, 2, 3, 4, 6, 0, time.UTC).UnixNano(),
+				FinishedAt: time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC).UnixNano(),
+				ExitCode:   137,
+			},
+		},
+		stats: map[string]*runtimeapi.ContainerStats{
+			testContainerID: {
+				Cpu: &runtimeapi.CpuUsage{UsageCoreNanoSeconds: &runtimeapi.UInt64Value{Value: 123456789}},
+				Memory: &runtimeapi.MemoryUsage{
+					UsageBytes:      &runtimeapi.UInt64Value{Value: 64 << 20},
+					WorkingSetBytes: &runtimeapi.UInt64Value{Value: 48 << 20},
+					RssBytes:        &runtimeapi.UInt64Value{Value: 32 << 20},
+					PageFaults:      &runtimeapi.UInt64Value{Value: 1000},
+					MajorPageFaults: &runtimeapi.UInt64Value{Value: 10},
+				},
+				Swap: &runtimeapi.SwapUsage{SwapUsageBytes: &runtimeapi.UInt64Value{Value: 1 << 20}},
+			},
+		},
+		sandboxes: []*runtimeapi.PodSandbox{{
+			Id:        testSandboxID,
+			Metadata:  &runtimeapi.PodSandboxMetadata{Name: "web-0", Namespace: "shop", Uid: "068e8fa0-9213-11e7-a01f-507b9d4141fa"},
+			State:     runtimeapi.PodSandboxState_SANDBOX_READY,
+			CreatedAt: time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC).UnixNano(),
+			Labels:    map[string]string{"io.kubernetes.pod.name": "web-0"},
+		}},
+	}
+}
+
+// startFakeRuntime serves runtime on a Unix socket and returns a client of it.
+func startFakeRuntime(t *testing.T, runtime *fakeRuntime) *client {
+	socket := filepath.Join(t.TempDir(), "cri.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	runtimeapi.RegisterRuntimeServiceServer(server, runtime)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	c, err := newClient(socket, 5*time.Second)
+	require.NoError(t, err)
+	return c
+}
+
+func TestClient(t *testing.T) {
+	c := startFakeRuntime(t, newFakeRuntime())
+
+	version, err := c.Version()
+	require.NoError(t, err)
+	assert.Equal(t, "fake", version.RuntimeName)
+
+	cntr, err := c.Container(testContainerID)
+	require.NoError(t, err)
+	assert.Equal(t, testSandboxID, cntr.PodSandboxId)
+	_, err = c.Container(testSandboxID)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	sandbox, err := c.PodSandbox(testSandboxID)
+	require.NoError(t, err)
+	assert.Equal(t, "web-0", sandbox.Metadata.Name)
+	_, err = c.PodSandbox(testContainerID)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	status, err := c.ContainerStatus(testContainerID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(137), status.ExitCode)
+	_, err = c.ContainerStatus(testSandboxID)
+	assert.Error(t, err)
+
+	stats, err := c.ContainerStats(testContainerID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(123456789), stats.Cpu.UsageCoreNanoSeconds.Value)
+	_, err = c.ContainerStats(testSandboxID)
+	assert.Error(t, err)
+}
+
+func TestNewClient(t *testing.T) {
+	_, err := newClient("", time.Second)
+	assert.Error(t, err)
+	_, err = newClient("tcp://localhost:1234", time.Second)
+	assert.Error(t, err)
+	_, err = newClient("unix:///run/containerd/containerd.sock", time.Second)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cri
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/google/cadvisor/lib/container"
+	"github.com/google/cadvisor/lib/container/libcontainer"
+	"github.com/google/cadvisor/lib/fs"
+	info "github.com/google/cadvisor/lib/model"
+	"github.com/google/cadvisor/lib/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+// The namespace under which CRI aliases are unique.
+const CRINamespace = "cri"
+
+// Regexp matching the last element of the cgroups CRI runtimes create for
+// containers and pod sandboxes, e.g. "cri-containerd-<id>.scope",
+// "crio-<id>.scope" or "<id>", capturing the ID.
+var criCgroupRegexp = regexp.MustCompile(`(?:^|-)([a-f0-9]{64})(?:\.scope)?$`)
+
+type criFactory struct {
+	machineInfoFactory info.MachineInfoFactory
+
+	// Information about the mounted cgroup subsystems.
+	cgroupSubsystems map[string]string
+
+	includedMetrics container.MetricSet
+
+	client *client
+	images *ImageLister
+}
+
+func (f *criFactory) String() string {
+	return CRINamespace
+}
+
+func (f *criFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	return newCRIContainerHandler(f.client, name, f.machineInfoFactory, f.cgroupSubsystems, inHostNamespace, f.includedMetrics)
+}
+
+// ContainerNameToCRIID returns the ID of the container or pod sandbox whose
+// cgroup is name, or "" if name is not the cgroup of one.
+func ContainerNameToCRIID(name string) string {
+	base := path.Base(name)
+	// Conmon, CRI-O's container monitor, gets a cgroup of its own.
+	if strings.HasPrefix(base, "crio-conmon-") {
+		return ""
+	}
+	if matches := criCgroupRegexp.FindStringSubmatch(base); matches != nil {
+		return matches[1]
+	}
+	return ""
+}
+
+// The CRI factory handles the cgroups of running containers and ready pod
+// sandboxes the runtime knows about, leaving any other cgroup to the other
+// factories.
+func (f *criFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	// On systemd each mount into a container may get a cgroup, ignore them.
+	if strings.HasSuffix(name, ".mount") {
+		return false, false, nil
+	}
+	id := ContainerNameToCRIID(name)
+	if id == "" {
+		return false, false, nil
+	}
+
+	cntr, err := f.client.Container(id)
+	if err == nil {
+		return cntr.State == runtimeapi.ContainerState_CONTAINER_RUNNING, true, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return false, false, fmt.Errorf("failed to look up container %q: %v", id, err)
+	}
+	sandbox, err := f.client.PodSandbox(id)
+	if err == nil {
+		return sandbox.State == runtimeapi.PodSandboxState_SANDBOX_READY, true, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return false, false, fmt.Errorf("failed to look up pod sandbox %q: %v", id, err)
+	}
+	return false, false, nil
+}
+
+func (f *criFactory) DebugInfo() map[string][]string {
+	return map[string][]string{}
+}
+
+// Register registers the CRI container factory if --cri is set.
+func Register(machineInfoFactory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
+	client, err := newClient(*ArgCRIEndpoint, *criClientTimeout)
+	if err != nil {
+		return err
+	}
+	version, err := client.Version()
+	if err != nil {
+		return fmt.Errorf("failed to fetch CRI runtime version: %v", err)
+	}
+
+	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
+	if err != nil {
+		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+	}
+
+	klog.V(1).Infof("Registering CRI factory for %s %s", version.RuntimeName, version.RuntimeVersion)
+	f := &criFactory{
+		machineInfoFactory: machineInfoFactory,
+		cgroupSubsystems:   cgroupSubsystems,
+		includedMetrics:    includedMetrics,
+		client:             client,
+		images:             &ImageLister{client: client},
+	}
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+func TestContainerNameToCRIID(t *testing.T) {
+	for name, id := range map[string]string{
+		"/kubepods/burstable/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/" + testContainerID:                                          testContainerID,
+		"/kubepods.slice/kubepods-pod068e8fa0.slice/cri-containerd-" + testContainerID + ".scope":                                 testContainerID,
+		"/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod068e8fa0.slice/crio-" + testContainerID + ".scope":        testContainerID,
+		"/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod068e8fa0.slice/crio-conmon-" + testContainerID + ".scope": "",
+		"/kubepods/burstable/pod068e8fa0-9213-11e7-a01f-507b9d4141fa":                                                             "",
+		"/system.slice/sshd.service": "",
+		"/kubepods/burstable/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/" + testContainerID + "x": "",
+	} {
+		assert.Equal(t, id, ContainerNameToCRIID(name), name)
+	}
+}
+
+func TestCanHandleAndAccept(t *testing.T) {
+	runtime := newFakeRuntime()
+	runtime.containers = append(runtime.containers, &runtimeapi.Container{
+		Id:    "0000000000000000000000000000000000000000000000000000000000000001",
+		State: runtimeapi.ContainerState_CONTAINER_EXITED,
+	})
+	f := &criFactory{client: startFakeRuntime(t, runtime)}
+
+	for _, tc := range []struct {
+		name   string
+		handle bool
+		accept bool
+	}{
+		{"/kubepods/pod068e8fa0/" + testContainerID, true, true},
+		{"/kubepods/pod068e8fa0/" + testSandboxID, true, true},
+		// Not running.
+		{"/kubepods/pod068e8fa0/0000000000000000000000000000000000000000000000000000000000000001", false, true},
+		// Unknown to the runtime.
+		{"/kubepods/pod068e8fa0/0000000000000000000000000000000000000000000000000000000000000002", false, false},
+		{"/kubepods/pod068e8fa0", false, false},
+		{"/system.slice/var-lib-" + testContainerID + ".mount", false, false},
+	} {
+		handle, accept, err := f.CanHandleAndAccept(tc.name)
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, tc.handle, handle, tc.name)
+		assert.Equal(t, tc.accept, accept, tc.name)
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Handler for containers of CRI runtimes.
+package cri
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opencontainers/cgroups"
+
+	"github.com/google/cadvisor/lib/container"
+	"github.com/google/cadvisor/lib/container/common"
+	containerlibcontainer "github.com/google/cadvisor/lib/container/libcontainer"
+	info "github.com/google/cadvisor/lib/model"
+
+	"k8s.io/klog/v2"
+)
+
+// Labels describing the pod and container, as the kubelet sets them on the
+// containers it creates. They are set from the CRI metadata, so that they are
+// present whatever the runtime does with the kubelet's labels.
+const (
+	PodNameLabel       = info.PodNameLabel
+	PodNamespaceLabel  = info.PodNamespaceLabel
+	PodUIDLabel        = info.PodUIDLabel
+	ContainerNameLabel = info.ContainerNameLabel
+	// The container name the kubelet gives pod sandboxes.
+	sandboxContainerName = "POD"
+)
+
+type criContainerHandler struct {
+	client *client
+	// ID of the container or pod sandbox in the runtime.
+	id string
+	// Whether this is a pod sandbox rather than a container.
+	sandbox bool
+	// The sandbox the runtime isolates the pod in, if any, see
+	// info.ContainerSpec.Sandbox.
+	sandboxRuntime string
+	// Whether to read the cpu and memory stats of the sandboxed container
+	// from the runtime.
+	sandboxStats bool
+
+	machineInfoFactory info.MachineInfoFactory
+
+	// Absolute path to the cgroup hierarchies of this container.
+	// (e.g.: "cpu" -> "/sys/fs/cgroup/cpu/kubepods/pod1234/<id>")
+	cgroupPaths map[string]string
+
+	// Metadata associated with the container.
+	reference    info.ContainerReference
+	labels       map[string]string
+	image        string
+	imageID      string
+	creationTime time.Time
+	restartCount int
+
+	includedMetrics container.MetricSet
+
+	libcontainerHandler *containerlibcontainer.Handler
+}
+
+var _ container.ContainerHandler = &criContainerHandler{}
+
+func newCRIContainerHandler(
+	client *client,
+	name string,
+	machineInfoFactory info.MachineInfoFactory,
+	cgroupSubsystems map[string]string,
+	inHostNamespace bool,
+	includedMetrics container.MetricSet,
+) (container.ContainerHandler, error) {
+	id := ContainerNameToCRIID(name)
+	if id == "" {
+		return nil, fmt.Errorf("%q is not the cgroup of a CRI container", name)
+	}
+
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
+	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := &criContainerHandler{
+		client:             client,
+		id:                 id,
+		machineInfoFactory: machineInfoFactory,
+		cgroupPaths:        cgroupPaths,
+		labels:             make(map[string]string),
+	}
+
+	var sandboxID string
+	cntr, err := client.Container(id)
+	switch {
+	case err == nil:
+		for k, v := range cntr.Labels {
+			handler.labels[k] = v
+		}
+		if cntr.Image != nil {
+			handler.image = cntr.Image.Image
+		}
+		handler.imageID = cntr.ImageRef
+		handler.creationTime = time.Unix(0, cntr.CreatedAt)
+		if cntr.Metadata != nil {
+			handler.labels[ContainerNameLabel] = cntr.Metadata.Name
+			// Attempt is 0 for the first run of a container in a pod and
+			// bumped on every restart.
+			handler.restartCount = int(cntr.Metadata.Attempt)
+		}
+		sandboxID = cntr.PodSandboxId
+	case errors.Is(err, ErrNotFound):
+		handler.sandbox = true
+		handler.labels[ContainerNameLabel] = sandboxContainerName
+		sandboxID = id
+	default:
+		return nil, err
+	}
+
+	sandbox, err := client.PodSandbox(sandboxID)
+	if err != nil {
+		return nil, err
+	}
+	if handler.sandbox {
+		for k, v := range sandbox.Labels {
+			handler.labels[k] = v
+		}
+		handler.creationTime = time.Unix(0, sandbox.CreatedAt)
+	}
+	// Every container of a pod runs with the runtime handler of its sandbox.
+	handler.sandboxRuntime = common.SandboxOf(sandbox.RuntimeHandler)
+	handler.sandboxStats = handler.sandboxRuntime != "" && !handler.sandbox && *argSandboxStats
+	if sandbox.Metadata != nil {
+		handler.labels[PodNameLabel] = sandbox.Metadata.Name
+		handler.labels[PodNamespaceLabel] = sandbox.Metadata.Namespace
+		handler.labels[PodUIDLabel] = sandbox.Metadata.Uid
+	}
+
+	handler.reference = info.ContainerReference{
+		Id:        id,
+		Name:      name,
+		Aliases:   []string{id},
+		Namespace: CRINamespace,
+	}
+	if sandbox.Metadata != nil {
+		// Unique among running containers, as the kubelet names them.
+		alias := fmt.Sprintf("%s/%s/%s", sandbox.Metadata.Namespace, sandbox.Metadata.Name, handler.labels[ContainerNameLabel])
+		handler.reference.Aliases = append(handler.reference.Aliases, alias)
+	}
+
+	// Only pod sandboxes have network stats of their own: the containers of
+	// a pod share its network namespace.
+	handler.includedMetrics = common.RemoveNetMetrics(includedMetrics, !handler.sandbox)
+
+	rootFs := "/"
+	if !inHostNamespace {
+		rootFs = "/rootfs"
+	}
+	// The CRI does not report the PID of containers; any process of the
+	// cgroup sees the container's network namespace.
+	pid := 0
+	if pids, err := cgroupManager.GetPids(); err == nil && len(pids) > 0 {
+		pid = pids[0]
+	} else if err != nil {
+		klog.V(4).Infof("Failed to list the processes of %q: %v", name, err)
+	}
+	handler.libcontainerHandler = containerlibcontainer.NewHandler(cgroupManager, rootFs, pid, handler.includedMetrics)
+
+	return handler, nil
+}
+
+func (h *criContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return h.reference, nil
+}
+
+// Nothing to start up.
+func (h *criContainerHandler) Start() {}
+
+// Nothing to clean up.
+func (h *criContainerHandler) Cleanup() {}
+
+func (h *criContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	// Filesystem usage is the runtime's business; CRI does not say where a
+	// container's writable layer is.
+	const hasFilesystem = false
+	hasNetwork := h.includedMetrics.Has(container.NetworkUsageMetrics)
+	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNetwork, hasFilesystem)
+	spec.Labels = h.labels
+	spec.Image = h.image
+	spec.ImageID = h.imageID
+	spec.Sandbox = h.sandboxRuntime
+	spec.RestartCount = h.restartCount
+	if !h.creationTime.IsZero() {
+		spec.CreationTime = h.creationTime
+	}
+	return spec, err
+}
+
+func (h *criContainerHandler) GetStats() (*info.ContainerStats, error) {
+	stats, err := h.libcontainerHandler.GetStats()
+	if err != nil {
+		return stats, err
+	}
+	if h.includedMetrics.Has(container.DiskIOMetrics) {
+		mi, err := h.machineInfoFactory.GetMachineInfo()
+		if err != nil {
+			return stats, err
+		}
+		common.AssignDeviceNamesToDiskStats((*common.MachineInfoNamer)(mi), stats.DiskIo)
+	}
+	if h.sandboxStats {
+		s, err := h.client.ContainerStats(h.id)
+		if err != nil {
+			return stats, fmt.Errorf("failed to get the CRI stats of %q: %v", h.reference.Name, err)
+		}
+		setSandboxStats(s, stats)
+	}
+	return stats, nil
+}
+
+func (h *criContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	return []info.ContainerReference{}, nil
+}
+
+func (h *criContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return h.libcontainerHandler.GetProcesses()
+}
+
+func (h *criContainerHandler) GetCgroupPath(resource string) (string, error) {
+	var res string
+	if !cgroups.IsCgroup2UnifiedMode() {
+		res = resource
+	}
+	path, ok := h.cgroupPaths[res]
+	if !ok {
+		return "", fmt.Errorf("could not find path for resource %q for container %q", resource, h.reference.Name)
+	}
+	return path, nil
+}
+
+func (h *criContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *criContainerHandler) GetContainerIPAddress() string {
+	// The CRI only reports the IP addresses of pod sandboxes.
+	return ""
+}
+
+func (h *criContainerHandler) Exists() bool {
+	return common.CgroupExists(h.cgroupPaths)
+}
+
+func (h *criContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeCRI
+}
+
+func (h *criContainerHandler) GetExitCode() (int, error) {
+	status, err := h.GetExitStatus()
+	return status.ExitCode, err
+}
+
+// GetExitStatus implements container.ExitStatusHandler from the container's
+// CRI status.
+func (h *criContainerHandler) GetExitStatus() (container.ExitStatus, error) {
+	if h.sandbox {
+		return container.ExitStatus{ExitCode: -1}, fmt.Errorf("exit codes not available for pod sandboxes")
+	}
+	return exitStatus(h.client, h.id)
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cri
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/lib/container"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+type machineInfo struct{}
+
+func (m *machineInfo) GetMachineInfo() (*info.MachineInfo, error) {
+	return &info.MachineInfo{}, nil
+}
+
+func (m *machineInfo) GetVersionInfo() (*info.VersionInfo, error) {
+	return &info.VersionInfo{}, nil
+}
+
+func TestContainerHandler(t *testing.T) {
+	c := startFakeRuntime(t, newFakeRuntime())
+	name := "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/" + testContainerID
+
+	handler, err := newCRIContainerHandler(c, name, &machineInfo{}, nil, true, container.AllMetrics)
+	require.NoError(t, err)
+
+	ref, err := handler.ContainerReference()
+	require.NoError(t, err)
+	assert.Equal(t, info.ContainerReference{
+		Id:        testContainerID,
+		Name:      name,
+		Aliases:   []string{testContainerID, "shop/web-0/app"},
+		Namespace: CRINamespace,
+	}, ref)
+
+	assert.Equal(t, map[string]string{
+		"app.kubernetes.io/name": "app",
+		ContainerNameLabel:       "app",
+		PodNameLabel:             "web-0",
+		PodNamespaceLabel:        "shop",
+		PodUIDLabel:              "068e8fa0-9213-11e7-a01f-507b9d4141fa",
+	}, handler.GetContainerLabels())
+
+	spec, err := handler.GetSpec()
+	assert.NoError(t, err)
+	assert.Equal(t, "registry.k8s.io/pause:3.10", spec.Image)
+	assert.Equal(t, 2, spec.RestartCount)
+	assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), spec.CreationTime.UTC())
+	// Containers share the network of their pod sandbox.
+	assert.False(t, spec.HasNetwork)
+	assert.Empty(t, spec.Sandbox)
+
+	exitStatus, err := handler.(container.ExitStatusHandler).GetExitStatus()
+	require.NoError(t, err)
+	assert.Equal(t, 137, exitStatus.ExitCode)
+	assert.Equal(t, 9, exitStatus.Signal)
+	assert.Equal(t, 55*time.Minute+54*time.Second, exitStatus.FinishedAt.Sub(exitStatus.StartedAt))
+}
+
+func TestSandboxHandler(t *testing.T) {
+	c := startFakeRuntime(t, newFakeRuntime())
+	name := "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/" + testSandboxID
+
+	handler, err := newCRIContainerHandler(c, name, &machineInfo{}, nil, true, container.AllMetrics)
+	require.NoError(t, err)
+
+	ref, err := handler.ContainerReference()
+	require.NoError(t, err)
+	assert.Equal(t, []string{testSandboxID, "shop/web-0/POD"}, ref.Aliases)
+	assert.Equal(t, "POD", handler.GetContainerLabels()[ContainerNameLabel])
+	assert.Equal(t, "068e8fa0-9213-11e7-a01f-507b9d4141fa", handler.GetContainerLabels()[PodUIDLabel])
+
+	_, err = handler.GetExitCode()
+	assert.Error(t, err)
+}
+
+func TestSandboxedHandler(t *testing.T) {
+	runtime := newFakeRuntime()
+	runtime.sandboxes[0].RuntimeHandler = "kata-qemu"
+	c := startFakeRuntime(t, runtime)
+
+	for _, id := range []string{testContainerID, testSandboxID} {
+		handler, err := newCRIContainerHandler(c, "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/"+id, &machineInfo{}, nil, true, container.AllMetrics)
+		require.NoError(t, err)
+		spec, err := handler.GetSpec()
+		assert.NoError(t, err)
+		assert.Equal(t, info.SandboxKata, spec.Sandbox, id)
+	}
+}
+
+func TestHandlerUnknownContainer(t *testing.T) {
+	c := startFakeRuntime(t, newFakeRuntime())
+	_, err := newCRIContainerHandler(c, "/kubepods/pod068e8fa0/0000000000000000000000000000000000000000000000000000000000000002", &machineInfo{}, nil, true, container.AllMetrics)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = newCRIContainerHandler(c, "/kubepods/pod068e8fa0", &machineInfo{}, nil, true, container.AllMetrics)
+	assert.Error(t, err)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cri
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/lib/container"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+var _ container.ImageStoreProvider = &criFactory{}
+
+// imageMetadata is what the ImageService only tells in the verbose status of
+// an image.
+type imageMetadata struct {
+	created time.Time
+	layers  int
+}
+
+// ImageLister lists the images of a CRI runtime through its ImageService.
+type ImageLister struct {
+	client *client
+
+	lock sync.Mutex
+	// Metadata of the images listed last, by ID, which cannot change for a
+	// given ID, so that the status of each image is only fetched once.
+	metadata map[string]imageMetadata
+}
+
+// NewImageLister returns an ImageLister of the CRI runtime listening on
+// endpoint. A zero timeout means no timeout.
+func NewImageLister(endpoint string, timeout time.Duration) (*ImageLister, error) {
+	client, err := newClient(endpoint, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return &ImageLister{client: client}, nil
+}
+
+// ImageStore returns the images of the runtime, on behalf of the factory
+// named runtime.
+func (l *ImageLister) ImageStore(runtime string) (info.ImageStore, error) {
+	images, err := l.client.Images()
+	if err != nil {
+		return info.ImageStore{}, err
+	}
+	usage, err := l.client.ImageFsUsage()
+	if err != nil {
+		klog.V(4).Infof("Failed to get the image filesystem usage of %s: %v", runtime, err)
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	metadata := make(map[string]imageMetadata, len(images))
+	store := info.ImageStore{
+		Runtime: runtime,
+		Usage:   usage,
+		Images:  make([]info.ImageInfo, 0, len(images)),
+	}
+	for _, image := range images {
+		m, ok := l.metadata[image.Id]
+		if !ok {
+			m = l.imageMetadata(image.Id)
+		}
+		metadata[image.Id] = m
+		store.Images = append(store.Images, info.ImageInfo{
+			ID:          image.Id,
+			RepoTags:    image.RepoTags,
+			RepoDigests: image.RepoDigests,
+			Size:        image.Size,
+			Created:     m.created,
+			Layers:      m.layers,
+		})
+	}
+	l.metadata = metadata
+	sort.Slice(store.Images, func(i, j int) bool { return store.Images[i].ID < store.Images[j].ID })
+	return store, nil
+}
+
+// imageMetadata returns the metadata of the image with the given ID from the
+// OCI config runtimes put in the verbose status of images, or zero values if
+// the runtime does not.
+func (l *ImageLister) imageMetadata(id string) imageMetadata {
+	status, err := l.client.ImageStatus(id)
+	if err != nil {
+		klog.V(4).Infof("Failed to get the status of image %q: %v", id, err)
+		return imageMetadata{}
+	}
+	return parseImageInfo(status.Info["info"])
+}
+
+// parseImageInfo parses the verbose information of an image, which containerd
+// and CRI-O both give as a JSON object holding the image config as
+// "imageSpec".
+func parseImageInfo(data string) imageMetadata {
+	var verbose struct {
+		ImageSpec *ocispec.Image `json:"imageSpec"`
+	}
+	if data == "" || json.Unmarshal([]byte(data), &verbose) != nil || verbose.ImageSpec == nil {
+		return imageMetadata{}
+	}
+	m := imageMetadata{layers: len(verbose.ImageSpec.RootFS.DiffIDs)}
+	if verbose.ImageSpec.Created != nil {
+		m.created = verbose.ImageSpec.Created.UTC()
+	}
+	return m
+}
+
+// GetImageStore implements container.ImageStoreProvider.
+func (f *criFactory) GetImageStore() (info.ImageStore, error) {
+	return f.images.ImageStore(CRINamespace)
+}
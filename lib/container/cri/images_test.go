@@ -0,0 +1,109 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cri
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// fakeImages is a CRI ImageService serving a fixed set of images.
+type fakeImages struct {
+	runtimeapi.UnimplementedImageServiceServer
+
+	images []*runtimeapi.Image
+	// Verbose information by image ID.
+	info map[string]string
+	// Number of ImageStatus calls.
+	statusCalls int
+}
+
+func (s *fakeImages) ListImages(ctx context.Context, req *runtimeapi.ListImagesRequest) (*runtimeapi.ListImagesResponse, error) {
+	return &runtimeapi.ListImagesResponse{Images: s.images}, nil
+}
+
+func (s *fakeImages) ImageStatus(ctx context.Context, req *runtimeapi.ImageStatusRequest) (*runtimeapi.ImageStatusResponse, error) {
+	s.statusCalls++
+	for _, image := range s.images {
+		if image.Id == req.Image.Image {
+			return &runtimeapi.ImageStatusResponse{Image: image, Info: map[string]string{"info": s.info[image.Id]}}, nil
+		}
+	}
+	return &runtimeapi.ImageStatusResponse{}, nil
+}
+
+func (s *fakeImages) ImageFsInfo(ctx context.Context, req *runtimeapi.ImageFsInfoRequest) (*runtimeapi.ImageFsInfoResponse, error) {
+	return &runtimeapi.ImageFsInfoResponse{
+		ImageFilesystems: []*runtimeapi.FilesystemUsage{{UsedBytes: &runtimeapi.UInt64Value{Value: 1000}}},
+	}, nil
+}
+
+func TestImageLister(t *testing.T) {
+	images := &fakeImages{
+		images: []*runtimeapi.Image{
+			{Id: "sha256:bbb", RepoTags: []string{"docker.io/library/nginx:1.27"}, RepoDigests: []string{"docker.io/library/nginx@sha256:ccc"}, Size: 700},
+			{Id: "sha256:aaa", RepoTags: []string{"registry.k8s.io/pause:3.10"}, Size: 300},
+		},
+		info: map[string]string{
+			"sha256:bbb": `{"chainID":"sha256:ddd","imageSpec":{"created":"2026-01-02T03:04:05Z","rootfs":{"type":"layers","diff_ids":["sha256:1","sha256:2"]}}}`,
+		},
+	}
+	socket := filepath.Join(t.TempDir(), "cri.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	runtimeapi.RegisterImageServiceServer(server, images)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	lister, err := NewImageLister(socket, 5*time.Second)
+	require.NoError(t, err)
+	store, err := lister.ImageStore("crio")
+	require.NoError(t, err)
+	assert.Equal(t, "crio", store.Runtime)
+	assert.Equal(t, uint64(1000), store.Usage)
+	require.Len(t, store.Images, 2)
+	assert.Equal(t, "sha256:aaa", store.Images[0].ID)
+	assert.Equal(t, 0, store.Images[0].Layers)
+	assert.True(t, store.Images[0].Created.IsZero())
+	assert.Equal(t, "sha256:bbb", store.Images[1].ID)
+	assert.Equal(t, []string{"docker.io/library/nginx:1.27"}, store.Images[1].RepoTags)
+	assert.Equal(t, uint64(700), store.Images[1].Size)
+	assert.Equal(t, 2, store.Images[1].Layers)
+	assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), store.Images[1].Created)
+
+	// The status of known images is not fetched again.
+	_, err = lister.ImageStore("crio")
+	require.NoError(t, err)
+	assert.Equal(t, 2, images.statusCalls)
+}
+
+func TestParseImageInfo(t *testing.T) {
+	for _, data := range []string{"", "not json", `{"labels":{}}`} {
+		assert.Equal(t, imageMetadata{}, parseImageInfo(data), data)
+	}
+	m := parseImageInfo(`{"imageSpec":{"rootfs":{"type":"layers","diff_ids":["sha256:1"]}}}`)
+	assert.Equal(t, imageMetadata{layers: 1}, m)
+}
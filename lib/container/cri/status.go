@@ -0,0 +1,112 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cri
+
+import (
+	"fmt"
+	"time"
+
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/google/cadvisor/lib/container"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// StatusReader reads the status and stats of the containers of a CRI runtime
+// through its RuntimeService, for runtimes whose own API reports neither how
+// their containers exited nor their stats from inside sandboxes.
+type StatusReader struct {
+	client *client
+}
+
+// NewStatusReader returns a StatusReader of the CRI runtime listening on
+// endpoint. A zero timeout means no timeout.
+func NewStatusReader(endpoint string, timeout time.Duration) (*StatusReader, error) {
+	client, err := newClient(endpoint, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return &StatusReader{client: client}, nil
+}
+
+// ExitStatus returns the exit status of the exited container with the given
+// ID.
+func (r *StatusReader) ExitStatus(id string) (container.ExitStatus, error) {
+	return exitStatus(r.client, id)
+}
+
+// SetSandboxStats replaces the cpu and memory stats of the sandboxed container
+// with the given ID with those the runtime reports, see setSandboxStats.
+func (r *StatusReader) SetSandboxStats(id string, stats *info.ContainerStats) error {
+	s, err := r.client.ContainerStats(id)
+	if err != nil {
+		return err
+	}
+	setSandboxStats(s, stats)
+	return nil
+}
+
+// setSandboxStats replaces the cpu and memory stats of a sandboxed container,
+// which the host cgroup accounts to the sandbox as a whole, with those the
+// runtime reports from inside the sandbox. The CRI reports neither processes
+// nor networks, so those and the other stats are left as the host sees them.
+func setSandboxStats(s *runtimeapi.ContainerStats, stats *info.ContainerStats) {
+	if usage := s.GetCpu().GetUsageCoreNanoSeconds(); usage != nil {
+		if stats.Cpu == nil {
+			stats.Cpu = &info.CpuStats{}
+		}
+		// Only the total CPU time is reported.
+		stats.Cpu.Usage = info.CpuUsage{Total: usage.Value}
+	}
+	if mem := s.GetMemory(); mem != nil {
+		faults := info.MemoryStatsMemoryData{
+			Pgfault:    mem.GetPageFaults().GetValue(),
+			Pgmajfault: mem.GetMajorPageFaults().GetValue(),
+		}
+		stats.Memory = &info.MemoryStats{
+			Usage:            mem.GetUsageBytes().GetValue(),
+			WorkingSet:       mem.GetWorkingSetBytes().GetValue(),
+			RSS:              mem.GetRssBytes().GetValue(),
+			Swap:             s.GetSwap().GetSwapUsageBytes().GetValue(),
+			ContainerData:    faults,
+			HierarchicalData: faults,
+		}
+	}
+}
+
+// exitStatus returns the exit status of the container with the given ID from
+// its CRI status, or an error if it has not exited.
+func exitStatus(client *client, id string) (container.ExitStatus, error) {
+	status, err := client.ContainerStatus(id)
+	if err != nil {
+		return container.ExitStatus{ExitCode: -1}, err
+	}
+	if status.State != runtimeapi.ContainerState_CONTAINER_EXITED {
+		return container.ExitStatus{ExitCode: -1}, fmt.Errorf("container %s has not exited (state: %v)", id, status.State)
+	}
+	exitStatus := container.ExitStatus{
+		ExitCode: int(status.ExitCode),
+		Signal:   container.SignalFromExitCode(int(status.ExitCode)),
+	}
+	if status.StartedAt > 0 {
+		exitStatus.StartedAt = time.Unix(0, status.StartedAt)
+	}
+	if status.FinishedAt > 0 {
+		exitStatus.FinishedAt = time.Unix(0, status.FinishedAt)
+	}
+	return exitStatus, nil
+}
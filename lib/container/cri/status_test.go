@@ -0,0 +1,56 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package cri
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/lib/model"
+)
+
+func TestStatusReader(t *testing.T) {
+	r := &StatusReader{client: startFakeRuntime(t, newFakeRuntime())}
+
+	status, err := r.ExitStatus(testContainerID)
+	require.NoError(t, err)
+	assert.Equal(t, 137, status.ExitCode)
+	assert.Equal(t, time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC), status.FinishedAt.UTC())
+
+	// The cpu and memory stats the host sees are replaced, the others kept.
+	stats := &info.ContainerStats{
+		Cpu:     &info.CpuStats{Usage: info.CpuUsage{Total: 1, User: 1}},
+		Memory:  &info.MemoryStats{Usage: 1 << 30, Cache: 1 << 20},
+		Network: &info.NetworkStats{InterfaceStats: info.InterfaceStats{Name: "eth0", RxBytes: 42}},
+	}
+	require.NoError(t, r.SetSandboxStats(testContainerID, stats))
+	assert.Equal(t, info.CpuUsage{Total: 123456789}, stats.Cpu.Usage)
+	assert.Equal(t, &info.MemoryStats{
+		Usage:            64 << 20,
+		WorkingSet:       48 << 20,
+		RSS:              32 << 20,
+		Swap:             1 << 20,
+		ContainerData:    info.MemoryStatsMemoryData{Pgfault: 1000, Pgmajfault: 10},
+		HierarchicalData: info.MemoryStatsMemoryData{Pgfault: 1000, Pgmajfault: 10},
+	}, stats.Memory)
+	assert.Equal(t, uint64(42), stats.Network.RxBytes)
+
+	assert.Error(t, r.SetSandboxStats(testSandboxID, stats))
+}
@@ -17,12 +17,14 @@
 package crio
 
 import (
+	"flag"
 	"fmt"
 	"path"
 	"regexp"
 	"strings"
 
 	"github.com/google/cadvisor/lib/container"
+	"github.com/google/cadvisor/lib/container/cri"
 	"github.com/google/cadvisor/lib/container/libcontainer"
 	"github.com/google/cadvisor/lib/fs"
 	info "github.com/google/cadvisor/lib/model"
@@ -34,6 +36,8 @@ import (
 // The namespace under which crio aliases are unique.
 const CrioNamespace = "crio"
 
+var argSandboxStats = flag.Bool("crio_sandbox_stats", false, "Read the cpu and memory stats of CRI-O containers that run in a sandbox, such as Kata Containers VMs or gVisor, from CRI-O's CRI API rather than from their host cgroup, which accounts for the sandbox as a whole")
+
 // The namespace suffix under which crio aliases are unique when using systemd.
 const CrioNamespaceSuffix = ".scope"
 
@@ -67,6 +71,14 @@ type crioFactory struct {
 
 	client CrioClient
 
+	// Lists images through the CRI ImageService, which CRI-O serves on the
+	// same socket.
+	images *cri.ImageLister
+
+	// Reads how containers exited through the CRI RuntimeService, which the
+	// CRI-O API does not report.
+	statuses *cri.StatusReader
+
 	cgroupDriver string
 }
 
@@ -90,6 +102,7 @@ func (f *crioFactory) NewContainerHandler(name string, metadataEnvAllowList []st
 		inHostNamespace,
 		metadataEnvAllowList,
 		f.includedMetrics,
+		f.statuses,
 	)
 	return
 }
@@ -147,6 +160,11 @@ func (f *crioFactory) CanHandleAndAccept(name string) (bool, bool, error) {
 	return true, true, nil
 }
 
+// GetImageStore implements container.ImageStoreProvider.
+func (f *crioFactory) GetImageStore() (info.ImageStore, error) {
+	return f.images.ImageStore(CrioNamespace)
+}
+
 func (f *crioFactory) DebugInfo() map[string][]string {
 	return map[string][]string{}
 }
@@ -170,6 +188,16 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics
 		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
 	}
 
+	images, err := cri.NewImageLister(CrioSocket, *crioClientTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to create the CRI-O image client: %v", err)
+	}
+
+	statuses, err := cri.NewStatusReader(CrioSocket, *crioClientTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to create the CRI-O runtime client: %v", err)
+	}
+
 	klog.V(1).Infof("Registering CRI-O factory")
 	f := &crioFactory{
 		client:             client,
@@ -180,6 +208,8 @@ func Register(factory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics
 		storageDir:         info.StorageRoot,
 		includedMetrics:    includedMetrics,
 		cgroupDriver:       info.CgroupDriver,
+		images:             images,
+		statuses:           statuses,
 	}
 
 	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
@@ -29,6 +29,7 @@ import (
 
 	"github.com/google/cadvisor/lib/container"
 	"github.com/google/cadvisor/lib/container/common"
+	"github.com/google/cadvisor/lib/container/cri"
 	containerlibcontainer "github.com/google/cadvisor/lib/container/libcontainer"
 	"github.com/google/cadvisor/lib/fs"
 	info "github.com/google/cadvisor/lib/model"
@@ -61,6 +62,12 @@ type crioContainerHandler struct {
 	// Image name used for this container.
 	image string
 
+	// The sandbox the container runs in, if any, see info.ContainerSpec.Sandbox.
+	sandbox string
+	// Whether to read the cpu and memory stats of the sandboxed container
+	// through statuses.
+	sandboxStats bool
+
 	// The network mode of the container
 	// TODO
 
@@ -78,9 +85,20 @@ type crioContainerHandler struct {
 	cgroupManager       cgroups.Manager
 	rootFs              string
 	pidKnown            bool
+
+	// Reads the exit status and sandbox stats of the container through the
+	// CRI, nil if unavailable.
+	statuses *cri.StatusReader
 }
 
-var _ container.ContainerHandler = &crioContainerHandler{}
+var (
+	_ container.ContainerHandler  = &crioContainerHandler{}
+	_ container.ExitStatusHandler = &crioContainerHandler{}
+)
+
+// The annotation CRI-O sets to the runtime handler a container runs with,
+// e.g. "kata" or "runsc".
+const runtimeHandlerAnnotation = "io.kubernetes.cri-o.RuntimeHandler"
 
 // newCrioContainerHandler returns a new container.ContainerHandler
 func newCrioContainerHandler(
@@ -94,6 +112,7 @@ func newCrioContainerHandler(
 	inHostNamespace bool,
 	metadataEnvAllowList []string,
 	includedMetrics container.MetricSet,
+	statuses *cri.StatusReader,
 ) (container.ContainerHandler, error) {
 	// Create the cgroup paths.
 	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
@@ -199,9 +218,12 @@ func newCrioContainerHandler(
 		cgroupManager:       cgroupManager,
 		rootFs:              rootFs,
 		pidKnown:            pidKnown,
+		statuses:            statuses,
 	}
 
 	handler.image = cInfo.Image
+	handler.sandbox = common.SandboxOf(cInfo.Annotations[runtimeHandlerAnnotation])
+	handler.sandboxStats = handler.sandbox != "" && statuses != nil && *argSandboxStats
 	// TODO: we wantd to know graph driver DeviceId (dont think this is needed now)
 
 	// ignore err and get zero as default, this happens with sandboxes, not sure why...
@@ -250,6 +272,7 @@ func (h *crioContainerHandler) GetSpec() (info.ContainerSpec, error) {
 	spec.Labels = h.labels
 	spec.Envs = h.envs
 	spec.Image = h.image
+	spec.Sandbox = h.sandbox
 
 	return spec, err
 }
@@ -331,6 +354,11 @@ func (h *crioContainerHandler) GetStats() (*info.ContainerStats, error) {
 	if err != nil {
 		return stats, err
 	}
+	if h.sandboxStats {
+		if err := h.statuses.SetSandboxStats(h.reference.Id, stats); err != nil {
+			return stats, fmt.Errorf("failed to get the CRI stats of %q: %v", h.reference.Name, err)
+		}
+	}
 
 	if h.includedMetrics.Has(container.NetworkUsageMetrics) && (stats.Network == nil || len(stats.Network.Interfaces) == 0) {
 		// No network related information indicates that the pid of the
@@ -386,5 +414,15 @@ func (h *crioContainerHandler) Type() container.ContainerType {
 }
 
 func (h *crioContainerHandler) GetExitCode() (int, error) {
-	return -1, fmt.Errorf("exit code not available from CRI-O API")
+	status, err := h.GetExitStatus()
+	return status.ExitCode, err
+}
+
+// GetExitStatus implements container.ExitStatusHandler from the container's
+// CRI status, which CRI-O serves on the same socket as its own API.
+func (h *crioContainerHandler) GetExitStatus() (container.ExitStatus, error) {
+	if h.statuses == nil {
+		return container.ExitStatus{ExitCode: -1}, fmt.Errorf("exit code not available from CRI-O API")
+	}
+	return h.statuses.ExitStatus(h.reference.Id)
 }
@@ -104,7 +104,7 @@ func TestHandler(t *testing.T) {
 			},
 		},
 	} {
-		handler, err := newCrioContainerHandler(ts.client, ts.name, ts.machineInfoFactory, ts.fsInfo, ts.storageDriver, ts.storageDir, ts.cgroupSubsystems, ts.inHostNamespace, ts.metadataEnvAllowList, ts.includedMetrics)
+		handler, err := newCrioContainerHandler(ts.client, ts.name, ts.machineInfoFactory, ts.fsInfo, ts.storageDriver, ts.storageDir, ts.cgroupSubsystems, ts.inHostNamespace, ts.metadataEnvAllowList, ts.includedMetrics, nil)
 		if ts.hasErr {
 			as.NotNil(err)
 			if ts.errContains != "" {
@@ -118,3 +118,18 @@ func TestHandler(t *testing.T) {
 		}
 	}
 }
+
+func TestHandlerSandbox(t *testing.T) {
+	client := mockCrioClient(
+		Info{},
+		map[string]*ContainerInfo{"81e5c2990803c383229c9680ce964738d5e566d97f5bd436ac34808d2ec75d5f": {
+			Name:        "test",
+			Labels:      map[string]string{},
+			Annotations: map[string]string{"io.kubernetes.cri-o.RuntimeHandler": "runsc"},
+		}},
+		nil,
+	)
+	handler, err := newCrioContainerHandler(client, "/kubepods/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/crio-81e5c2990803c383229c9680ce964738d5e566d97f5bd436ac34808d2ec75d5f", nil, nil, "", "", nil, false, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, info.SandboxGVisor, handler.(*crioContainerHandler).sandbox)
+}
@@ -41,6 +41,12 @@ type ContainerHandlerFactory interface {
 	DebugInfo() map[string][]string
 }
 
+// ImageStoreProvider is implemented by the factories of runtimes that store
+// container images, to describe them.
+type ImageStoreProvider interface {
+	GetImageStore() (info.ImageStore, error)
+}
+
 // MetricKind represents the kind of metrics that cAdvisor exposes.
 type MetricKind string
 
@@ -67,6 +73,7 @@ const (
 	CPUSetMetrics                  MetricKind = "cpuset"
 	OOMMetrics                     MetricKind = "oom_event"
 	PressureMetrics                MetricKind = "pressure"
+	ImageMetrics                   MetricKind = "image"
 )
 
 // AllMetrics represents all kinds of metrics that cAdvisor supported.
@@ -93,6 +100,7 @@ var AllMetrics = MetricSet{
 	CPUSetMetrics:                  struct{}{},
 	OOMMetrics:                     struct{}{},
 	PressureMetrics:                struct{}{},
+	ImageMetrics:                   struct{}{},
 }
 
 // AllNetworkMetrics represents all network metrics that cAdvisor supports.
@@ -307,6 +315,35 @@ func DebugInfo() map[string][]string {
 	return out
 }
 
+// ImageStores returns the image stores of the registered factories that
+// provide one, and the errors of those that failed to.
+func ImageStores() ([]info.ImageStore, []error) {
+	factoriesLock.RLock()
+	defer factoriesLock.RUnlock()
+
+	// Factories are registered for several watch sources.
+	seen := make(map[ContainerHandlerFactory]bool)
+	var stores []info.ImageStore
+	var errs []error
+	for _, factoriesSlice := range factories {
+		for _, factory := range factoriesSlice {
+			provider, ok := factory.(ImageStoreProvider)
+			if !ok || seen[factory] {
+				continue
+			}
+			seen[factory] = true
+			store, err := provider.GetImageStore()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to get the images of %s: %v", factory, err))
+				continue
+			}
+			stores = append(stores, store)
+		}
+	}
+	sort.Slice(stores, func(i, j int) bool { return stores[i].Runtime < stores[j].Runtime })
+	return stores, errs
+}
+
 // GetReorderedFactoryList returns the list of ContainerHandlerFactory where the
 // RawContainerHandler is always the last element.
 func GetReorderedFactoryList(watchType watcher.ContainerWatchSource) []ContainerHandlerFactory {
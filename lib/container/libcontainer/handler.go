@@ -60,6 +60,10 @@ type Handler struct {
 	// pidMetricsSaved holds accumulated CPU scheduler stats for processes that no longer exist.
 	pidMetricsSaved info.CpuSchedstat
 	cycles          uint64
+	// The volumes last read from the mount namespace of volumesPid, and when.
+	volumes        []info.VolumeStats
+	volumesPid     int
+	volumesUpdated time.Time
 }
 
 func NewHandler(cgroupManager cgroups.Manager, rootFs string, pid int, includedMetrics container.MetricSet) *Handler {
@@ -72,6 +76,28 @@ func NewHandler(cgroupManager cgroups.Manager, rootFs string, pid int, includedM
 	}
 }
 
+// SetPid sets the process whose namespaces network and volume stats are read
+// from, e.g. after the container's init process was restarted.
+func (h *Handler) SetPid(pid int) {
+	h.pid = pid
+}
+
+// volumeStats returns the stats of the volumes of the container. Like the
+// usage of its filesystems, they are read again at most once per
+// common.DefaultPeriod, as doing so stats every volume and parses the
+// mountstats of the container.
+func (h *Handler) volumeStats() []info.VolumeStats {
+	if h.volumesPid == h.pid && time.Since(h.volumesUpdated) < common.DefaultPeriod {
+		return h.volumes
+	}
+	volumes, err := volumeStatsFromProc(h.rootFs, h.pid)
+	if err != nil {
+		klog.V(4).Infof("Unable to get volume stats from pid %d: %v", h.pid, err)
+	}
+	h.volumes, h.volumesPid, h.volumesUpdated = volumes, h.pid, time.Now()
+	return volumes
+}
+
 // Get cgroup and networking stats of the specified container
 func (h *Handler) GetStats() (*info.ContainerStats, error) {
 	ignoreStatsError := false
@@ -168,6 +194,10 @@ func (h *Handler) GetStats() (*info.ContainerStats, error) {
 			}
 		}
 	}
+	// If we know the pid then get the volumes mounted in its mount namespace
+	if h.pid > 0 && h.includedMetrics.Has(container.DiskUsageMetrics) {
+		stats.Volumes = h.volumeStats()
+	}
 	// some process metrics are per container ( number of processes, number of
 	// file descriptors etc.) and not required a proper container's
 	// root PID (systemd services don't have the root PID atm)
@@ -0,0 +1,63 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/google/cadvisor/lib/container/common"
+	"github.com/google/cadvisor/lib/fs/nfs"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// nfsStatsFromProc returns the client statistics of the NFS mounts in the
+// mount namespace of pid, by mountpoint. It returns none if pid shares the
+// mount namespace of the host, whose NFS mounts are reported with the stats of
+// the root container.
+func nfsStatsFromProc(rootFs string, pid int) (map[string]*info.NfsStats, error) {
+	procDir := path.Join(rootFs, "proc", strconv.Itoa(pid))
+	inHost, err := inHostMountNamespace(rootFs, procDir)
+	if err != nil || inHost {
+		return nil, err
+	}
+
+	mounts, err := nfs.ReadMountStats(path.Join(procDir, "mountstats"))
+	if err != nil {
+		return nil, err
+	}
+	stats := make(map[string]*info.NfsStats, len(mounts))
+	for mountpoint, mount := range nfs.ByMountpoint(mounts) {
+		stats[mountpoint] = common.NfsStats(&mount.Stats)
+	}
+	return stats, nil
+}
+
+// inHostMountNamespace returns whether the process of procDir shares the
+// mount namespace of the host.
+func inHostMountNamespace(rootFs, procDir string) (bool, error) {
+	mountNs, err := os.Readlink(path.Join(procDir, "ns", "mnt"))
+	if err != nil {
+		return false, err
+	}
+	hostMountNs, err := os.Readlink(path.Join(rootFs, "proc", "1", "ns", "mnt"))
+	if err != nil {
+		return false, err
+	}
+	return mountNs == hostMountNs, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/lib/model"
+)
+
+const testMountStats = `device overlay mounted on / with fstype overlay
+device nfs.example.com:/srv mounted on /data with fstype nfs4 statvers=1.1
+	bytes:	0 0 0 0 100 200 0 0
+	per-op statistics
+	        READ: 4 5 0 0 0 0 10 12 0
+device nfs.example.com:/srv mounted on /data2 with fstype nfs4 statvers=1.1
+	bytes:	0 0 0 0 300 400 0 0
+`
+
+// fakeProc creates the proc directory of the host init and of process 42,
+// which is in the mount namespace mountNs.
+func fakeProc(t *testing.T, mountNs string) string {
+	rootFs := t.TempDir()
+	for pid, ns := range map[string]string{"1": "mnt:[4026531841]", "42": mountNs} {
+		nsDir := filepath.Join(rootFs, "proc", pid, "ns")
+		require.NoError(t, os.MkdirAll(nsDir, 0o755))
+		require.NoError(t, os.Symlink(ns, filepath.Join(nsDir, "mnt")))
+	}
+	procDir := filepath.Join(rootFs, "proc", "42")
+	require.NoError(t, os.WriteFile(filepath.Join(procDir, "mountstats"), []byte(testMountStats), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(procDir, "root", "data"), 0o755))
+	return rootFs
+}
+
+func TestNfsStatsFromProc(t *testing.T) {
+	stats, err := nfsStatsFromProc(fakeProc(t, "mnt:[4026532000]"), 42)
+	require.NoError(t, err)
+	// A device mounted twice is reported at each of its mountpoints.
+	require.Len(t, stats, 2)
+	assert.Equal(t, &info.NfsStats{
+		Server:     "nfs.example.com",
+		Export:     "/srv",
+		ReadBytes:  100,
+		WriteBytes: 200,
+		Ops:        []info.NfsOpStats{{Op: "READ", Requests: 4, Retransmissions: 1, Rtt: 10, ExecuteTime: 12}},
+	}, stats["/data"])
+	assert.Equal(t, uint64(300), stats["/data2"].ReadBytes)
+}
+
+func TestNfsStatsFromProcInHostMountNamespace(t *testing.T) {
+	stats, err := nfsStatsFromProc(fakeProc(t, "mnt:[4026531841]"), 42)
+	require.NoError(t, err)
+	assert.Empty(t, stats)
+}
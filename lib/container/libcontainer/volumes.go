@@ -0,0 +1,114 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	mount "github.com/moby/sys/mountinfo"
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/lib/fs"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// Mounts of the container runtime and the kernel, rather than volumes.
+var ignoredVolumePrefixes = []string{"/proc", "/sys", "/dev"}
+
+// volumeStatsFromProc returns the stats of the volumes mounted in the mount
+// namespace of pid. It returns none if pid shares the mount namespace of the
+// host, whose filesystems are reported with the stats of the root container.
+func volumeStatsFromProc(rootFs string, pid int) ([]info.VolumeStats, error) {
+	procDir := path.Join(rootFs, "proc", strconv.Itoa(pid))
+	inHost, err := inHostMountNamespace(rootFs, procDir)
+	if err != nil || inHost {
+		return nil, err
+	}
+
+	f, err := os.Open(path.Join(procDir, "mountinfo"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	// The mounts are reachable through the root of the process.
+	root := path.Join(procDir, "root")
+	mounts, err := mount.GetMountsFromReader(f, func(m *mount.Info) (bool, bool) {
+		return !isVolume(root, m), false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var nfsStats map[string]*info.NfsStats
+	for _, m := range mounts {
+		if strings.HasPrefix(m.FSType, "nfs") {
+			nfsStats, err = nfsStatsFromProc(rootFs, pid)
+			if err != nil {
+				klog.V(4).Infof("Unable to get nfs stats from pid %d: %v", pid, err)
+			}
+			break
+		}
+	}
+	return volumeStats(mounts, fs.GetMountStats(root, mounts), nfsStats), nil
+}
+
+// isVolume returns whether m, in the mount namespace whose root is root, is a
+// volume: a directory mounted into the container other than its root.
+func isVolume(root string, m *mount.Info) bool {
+	if m.Mountpoint == "/" {
+		return false
+	}
+	for _, prefix := range ignoredVolumePrefixes {
+		if m.Mountpoint == prefix || strings.HasPrefix(m.Mountpoint, prefix+"/") {
+			return false
+		}
+	}
+	// Files such as /etc/hosts are bind mounted too.
+	fi, err := os.Stat(path.Join(root, m.Mountpoint))
+	return err == nil && fi.IsDir()
+}
+
+// volumeStats combines the filesystem stats of the volumes mounted at mounts
+// with the client statistics of those on NFS, by mountpoint.
+func volumeStats(mounts []*mount.Info, fsStats map[string]*fs.FsStats, nfsStats map[string]*info.NfsStats) []info.VolumeStats {
+	var volumes []info.VolumeStats
+	for _, m := range mounts {
+		stats, ok := fsStats[m.Mountpoint]
+		if !ok {
+			continue
+		}
+		volume := info.VolumeStats{
+			MountPoint: m.Mountpoint,
+			Device:     m.Source,
+			Type:       stats.Type.String(),
+			Limit:      stats.Capacity,
+			Usage:      stats.Capacity - stats.Free,
+			Available:  stats.Available,
+		}
+		if stats.Inodes != nil && stats.InodesFree != nil {
+			volume.HasInodes = true
+			volume.Inodes = *stats.Inodes
+			volume.InodesFree = *stats.InodesFree
+		}
+		volume.Nfs = nfsStats[m.Mountpoint]
+		volumes = append(volumes, volume)
+	}
+	return volumes
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package libcontainer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	mount "github.com/moby/sys/mountinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/lib/container/common"
+	"github.com/google/cadvisor/lib/fs"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+func TestIsVolume(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "data"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "dev", "shm"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "devices"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "etc"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "etc", "hosts"), nil, 0o644))
+
+	for mountpoint, expected := range map[string]bool{
+		"/":          false,
+		"/data":      true,
+		"/dev/shm":   false,
+		"/devices":   true,
+		"/etc/hosts": false,
+		"/missing":   false,
+	} {
+		assert.Equal(t, expected, isVolume(root, &mount.Info{Mountpoint: mountpoint}), mountpoint)
+	}
+}
+
+func TestVolumeStats(t *testing.T) {
+	inodes, inodesFree := uint64(100), uint64(40)
+	ext4 := &fs.FsStats{Type: fs.VFS, Capacity: 1000, Free: 300, Available: 250, Inodes: &inodes, InodesFree: &inodesFree}
+	mounts := []*mount.Info{
+		{Mountpoint: "/data", Source: "/dev/sdb1", FSType: "ext4"},
+		{Mountpoint: "/logs", Source: "/dev/sdb1", FSType: "ext4"},
+		{Mountpoint: "/shared", Source: "nfs.example.com:/srv", FSType: "nfs4"},
+		{Mountpoint: "/unknown", Source: "fuse", FSType: "fuse"},
+	}
+	fsStats := map[string]*fs.FsStats{
+		"/data":   ext4,
+		"/logs":   ext4,
+		"/shared": {Type: fs.VFS, Capacity: 5000, Free: 1000, Available: 1000},
+	}
+	nfsStats := map[string]*info.NfsStats{
+		"/shared": {Server: "nfs.example.com", Export: "/srv", ReadBytes: 100, WriteBytes: 200},
+	}
+
+	assert.Equal(t, []info.VolumeStats{
+		{MountPoint: "/data", Device: "/dev/sdb1", Type: "vfs", Limit: 1000, Usage: 700, Available: 250, HasInodes: true, Inodes: 100, InodesFree: 40},
+		{MountPoint: "/logs", Device: "/dev/sdb1", Type: "vfs", Limit: 1000, Usage: 700, Available: 250, HasInodes: true, Inodes: 100, InodesFree: 40},
+		{
+			MountPoint: "/shared",
+			Device:     "nfs.example.com:/srv",
+			Type:       "vfs",
+			Limit:      5000,
+			Usage:      4000,
+			Available:  1000,
+			Nfs:        &info.NfsStats{Server: "nfs.example.com", Export: "/srv", ReadBytes: 100, WriteBytes: 200},
+		},
+	}, volumeStats(mounts, fsStats, nfsStats))
+}
+
+func TestVolumeStatsFromProcInHostMountNamespace(t *testing.T) {
+	// The mounts of the host are not read.
+	volumes, err := volumeStatsFromProc(fakeProc(t, "mnt:[4026531841]"), 42)
+	require.NoError(t, err)
+	assert.Empty(t, volumes)
+}
+
+func TestHandlerVolumeStatsCached(t *testing.T) {
+	rootFs := fakeProc(t, "mnt:[4026532000]")
+	require.NoError(t, os.WriteFile(filepath.Join(rootFs, "proc", "42", "mountinfo"), nil, 0o644))
+	h := &Handler{rootFs: rootFs, pid: 42}
+
+	h.volumeStats()
+	updated := h.volumesUpdated
+	require.False(t, updated.IsZero())
+
+	// The volumes are not read again within the period.
+	h.volumeStats()
+	assert.Equal(t, updated, h.volumesUpdated)
+
+	h.volumesUpdated = updated.Add(-common.DefaultPeriod)
+	h.volumeStats()
+	assert.True(t, h.volumesUpdated.After(updated))
+
+	// Nor are those of a previous init process reused.
+	h.SetPid(1)
+	h.volumeStats()
+	assert.Equal(t, 1, h.volumesPid)
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package lxc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNotFound is returned when no LXC path holds the configuration of a
+// container.
+var ErrNotFound = errors.New("lxc container not found")
+
+// containerConfig is what is known of a container from its configuration.
+type containerConfig struct {
+	// The path of the container's root filesystem on the host, or "" if it is
+	// not a directory, e.g. a ZFS dataset or an LVM volume.
+	rootfs string
+	image  string
+	labels map[string]string
+}
+
+// readConfig reads the configuration of the container with the given name
+// from the first LXC path that has it: the backup.yaml of Incus and LXD
+// instances, or the config file of plain LXC containers. rootFs is where the
+// host's root filesystem is seen.
+func readConfig(rootFs string, lxcPaths []string, name string) (*containerConfig, error) {
+	for _, lxcPath := range lxcPaths {
+		dir := filepath.Join(rootFs, lxcPath, name)
+		config, err := readIncusConfig(dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			config, err = readLXCConfig(rootFs, dir)
+		}
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the configuration of lxc container %q: %v", name, err)
+		}
+		return config, nil
+	}
+	return nil, fmt.Errorf("%w: %q in %v", ErrNotFound, name, lxcPaths)
+}
+
+// Keys of plain LXC configurations exported as labels, besides the cgroup
+// limits ("lxc.cgroup.*" and "lxc.cgroup2.*").
+var lxcLabelKeys = []string{"lxc.arch", "lxc.uts.name"}
+
+// readLXCConfig reads the "key = value" config file of a plain LXC container.
+func readLXCConfig(rootFs, dir string) (*containerConfig, error) {
+	file, err := os.Open(filepath.Join(dir, "config"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	config := &containerConfig{labels: make(map[string]string)}
+	var template, parameters string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		// The templates containers are created with are only recorded in
+		// comments.
+		if value, ok := strings.CutPrefix(line, "# Template used to create this container:"); ok {
+			template = strings.TrimPrefix(path.Base(strings.TrimSpace(value)), "lxc-")
+			continue
+		}
+		if value, ok := strings.CutPrefix(line, "# Parameters passed to the template:"); ok {
+			parameters = strings.TrimSpace(value)
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		switch {
+		case key == "lxc.rootfs.path" || key == "lxc.rootfs":
+			if rootfs := rootfsDir(value); rootfs != "" {
+				config.rootfs = filepath.Join(rootFs, rootfs)
+			}
+		case strings.HasPrefix(key, "lxc.cgroup.") || strings.HasPrefix(key, "lxc.cgroup2."):
+			config.labels[key] = value
+		default:
+			for _, labelKey := range lxcLabelKeys {
+				if key == labelKey {
+					config.labels[key] = value
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	config.image = strings.TrimSpace(template + " " + parameters)
+	return config, nil
+}
+
+// rootfsDir returns the directory of an lxc.rootfs.path, or "" if the root
+// filesystem is not a directory of the host.
+func rootfsDir(rootfsPath string) string {
+	storageType, location, ok := strings.Cut(rootfsPath, ":")
+	if !ok {
+		// A bare path is a directory.
+		return rootfsPath
+	}
+	switch storageType {
+	case "dir", "btrfs":
+		return location
+	case "overlay", "overlayfs":
+		// The changes made by the container are in the upper directory,
+		// after the lower one.
+		return location[strings.LastIndex(location, ":")+1:]
+	default:
+		// ZFS datasets, LVM volumes, loop files, RBD and NBD devices.
+		return ""
+	}
+}
+
+// incusBackup is what the handler reads of the backup.yaml Incus and LXD keep
+// in the directory of each instance.
+type incusBackup struct {
+	Container struct {
+		Config         map[string]string `yaml:"config"`
+		ExpandedConfig map[string]string `yaml:"expanded_config"`
+	} `yaml:"container"`
+}
+
+// Prefixes of the keys of Incus and LXD configurations exported as labels.
+var incusLabelPrefixes = []string{"user.", "limits.", "image.os", "image.release", "image.architecture"}
+
+// readIncusConfig reads the backup.yaml of an Incus or LXD instance.
+func readIncusConfig(dir string) (*containerConfig, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "backup.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	var backup incusBackup
+	if err := yaml.Unmarshal(data, &backup); err != nil {
+		return nil, err
+	}
+	// The expanded config includes what the instance inherits from its
+	// profiles.
+	instanceConfig := backup.Container.ExpandedConfig
+	if len(instanceConfig) == 0 {
+		instanceConfig = backup.Container.Config
+	}
+
+	config := &containerConfig{
+		// Whatever the storage pool, Incus mounts or links the instance's
+		// volume here.
+		rootfs: filepath.Join(dir, "rootfs"),
+		image:  instanceConfig["image.description"],
+		labels: make(map[string]string),
+	}
+	if config.image == "" {
+		config.image = instanceConfig["volatile.base_image"]
+	}
+	for key, value := range instanceConfig {
+		for _, prefix := range incusLabelPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				config.labels[key] = value
+			}
+		}
+	}
+	return config, nil
+}
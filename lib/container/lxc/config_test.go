@@ -0,0 +1,75 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package lxc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testLXCPaths = []string{"/var/lib/lxc", "/var/lib/incus/containers"}
+
+func TestReadConfig(t *testing.T) {
+	config, err := readConfig("testdata", testLXCPaths, "web01")
+	require.NoError(t, err)
+	assert.Equal(t, &containerConfig{
+		rootfs: "testdata/var/lib/lxc/web01/rootfs",
+		image:  "download --dist ubuntu --release jammy --arch amd64",
+		labels: map[string]string{
+			"lxc.arch":               "linux64",
+			"lxc.uts.name":           "web01",
+			"lxc.cgroup2.memory.max": "1G",
+			"lxc.cgroup2.cpu.max":    "200000 100000",
+		},
+	}, config)
+
+	config, err = readConfig("testdata", testLXCPaths, "shop_db01")
+	require.NoError(t, err)
+	assert.Equal(t, &containerConfig{
+		rootfs: "testdata/var/lib/incus/containers/shop_db01/rootfs",
+		image:  "Debian bookworm amd64 (20260101_05:24)",
+		labels: map[string]string{
+			"image.architecture": "amd64",
+			"image.os":           "Debian",
+			"image.release":      "bookworm",
+			"limits.cpu":         "2",
+			"limits.memory":      "2GiB",
+			"user.team":          "payments",
+		},
+	}, config)
+
+	_, err = readConfig("testdata", testLXCPaths, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = readConfig("testdata", testLXCPaths, "broken")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNotFound)
+}
+
+func TestRootfsDir(t *testing.T) {
+	for rootfsPath, dir := range map[string]string{
+		"/var/lib/lxc/web01/rootfs":                                  "/var/lib/lxc/web01/rootfs",
+		"dir:/var/lib/lxc/web01/rootfs":                              "/var/lib/lxc/web01/rootfs",
+		"btrfs:/var/lib/lxc/web01/rootfs":                            "/var/lib/lxc/web01/rootfs",
+		"overlay:/var/lib/lxc/base/rootfs:/var/lib/lxc/web01/delta0": "/var/lib/lxc/web01/delta0",
+		"zfs:lxc/web01":                                              "",
+		"lvm:/dev/lxc/web01":                                         "",
+	} {
+		assert.Equal(t, dir, rootfsDir(rootfsPath), rootfsPath)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package lxc
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/cadvisor/lib/container"
+	"github.com/google/cadvisor/lib/container/common"
+	"github.com/google/cadvisor/lib/container/libcontainer"
+	"github.com/google/cadvisor/lib/fs"
+	info "github.com/google/cadvisor/lib/model"
+	"github.com/google/cadvisor/lib/watcher"
+
+	"k8s.io/klog/v2"
+)
+
+var argLXCPaths = flag.String("lxc_path", "/var/lib/lxc,/var/lib/incus/containers,/var/snap/lxd/common/lxd/containers,/var/lib/lxd/containers", "comma-separated list of directories holding the configuration of LXC containers and Incus or LXD instances, one subdirectory per container")
+
+// The namespace under which LXC aliases are unique.
+const LXCNamespace = "lxc"
+
+type lxcFactory struct {
+	machineInfoFactory info.MachineInfoFactory
+
+	fsInfo fs.FsInfo
+
+	// Information about the mounted cgroup subsystems.
+	cgroupSubsystems map[string]string
+
+	includedMetrics container.MetricSet
+
+	// Where the host's root filesystem is seen, and the LXC paths in it.
+	rootFs   string
+	lxcPaths []string
+}
+
+func (f *lxcFactory) String() string {
+	return LXCNamespace
+}
+
+func (f *lxcFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
+	return newLXCContainerHandler(name, f.rootFs, f.lxcPaths, f.machineInfoFactory, f.fsInfo, f.cgroupSubsystems, inHostNamespace, f.includedMetrics)
+}
+
+// ContainerNameToLXCName returns the name of the LXC container whose cgroup is
+// name, or "" if name is not the cgroup of one. LXC places containers in
+// "lxc.payload.<name>" since 4.0, and in "lxc.payload/<name>" or
+// "lxc/<name>" before. Incus prefixes the names of instances outside of the
+// default project with "<project>_".
+func ContainerNameToLXCName(name string) string {
+	base := path.Base(name)
+	if lxcName, ok := strings.CutPrefix(base, "lxc.payload."); ok {
+		return lxcName
+	}
+	switch path.Dir(name) {
+	case "/lxc.payload", "/lxc":
+		return base
+	}
+	return ""
+}
+
+// The LXC factory handles the cgroups of the containers it finds the
+// configuration of, leaving the monitor processes and the cgroups created
+// inside containers to the raw factory.
+func (f *lxcFactory) CanHandleAndAccept(name string) (bool, bool, error) {
+	lxcName := ContainerNameToLXCName(name)
+	if lxcName == "" {
+		return false, false, nil
+	}
+	_, err := readConfig(f.rootFs, f.lxcPaths, lxcName)
+	if errors.Is(err, ErrNotFound) {
+		klog.V(4).Infof("%s not handled by lxc handler: %v", name, err)
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+	return true, true, nil
+}
+
+func (f *lxcFactory) DebugInfo() map[string][]string {
+	return map[string][]string{
+		"LXC paths": f.lxcPaths,
+	}
+}
+
+// Register registers the LXC container factory.
+func Register(machineInfoFactory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
+	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
+	if err != nil {
+		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
+	}
+
+	var lxcPaths []string
+	for _, lxcPath := range strings.Split(*argLXCPaths, ",") {
+		if lxcPath = strings.TrimSpace(lxcPath); lxcPath != "" {
+			lxcPaths = append(lxcPaths, lxcPath)
+		}
+	}
+	if len(lxcPaths) == 0 {
+		return fmt.Errorf("no lxc path to find containers in")
+	}
+
+	klog.V(1).Infof("Registering lxc factory for %q", lxcPaths)
+	f := &lxcFactory{
+		machineInfoFactory: machineInfoFactory,
+		fsInfo:             fsInfo,
+		cgroupSubsystems:   cgroupSubsystems,
+		includedMetrics:    includedMetrics,
+		// The paths are those of the host.
+		rootFs:   common.HostRootFs(),
+		lxcPaths: lxcPaths,
+	}
+	container.RegisterContainerHandlerFactory(f, []watcher.ContainerWatchSource{watcher.Raw})
+	return nil
+}
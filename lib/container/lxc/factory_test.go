@@ -0,0 +1,64 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package lxc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerNameToLXCName(t *testing.T) {
+	for name, lxcName := range map[string]string{
+		"/lxc.payload.web01":     "web01",
+		"/lxc.payload.shop_db01": "shop_db01",
+		"/lxc.payload/web01":     "web01",
+		"/lxc/web01":             "web01",
+		"/user.slice/user-1000.slice/user@1000.service/app.slice/lxc.payload.web01": "web01",
+		"/lxc.monitor.web01":              "",
+		"/lxc.payload.web01/system.slice": "",
+		"/lxc/web01/init.scope":           "",
+		"/lxc.pivot":                      "",
+		"/lxc":                            "",
+		"/system.slice/sshd.service":      "",
+	} {
+		assert.Equal(t, lxcName, ContainerNameToLXCName(name), name)
+	}
+}
+
+func TestCanHandleAndAccept(t *testing.T) {
+	f := &lxcFactory{rootFs: "testdata", lxcPaths: testLXCPaths}
+	for _, tc := range []struct {
+		name   string
+		handle bool
+	}{
+		{"/lxc.payload.web01", true},
+		{"/lxc.payload.shop_db01", true},
+		// Not configured in any LXC path.
+		{"/lxc.payload.missing", false},
+		{"/lxc.monitor.web01", false},
+		{"/lxc.payload.web01/system.slice/sshd.service", false},
+	} {
+		handle, accept, err := f.CanHandleAndAccept(tc.name)
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, tc.handle, handle, tc.name)
+		assert.Equal(t, tc.handle, accept, tc.name)
+	}
+
+	_, _, err := f.CanHandleAndAccept("/lxc.payload.broken")
+	assert.Error(t, err)
+}
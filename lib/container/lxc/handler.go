@@ -0,0 +1,307 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Handler for LXC containers and Incus and LXD instances.
+package lxc
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/opencontainers/cgroups"
+
+	"github.com/google/cadvisor/lib/container"
+	"github.com/google/cadvisor/lib/container/common"
+	containerlibcontainer "github.com/google/cadvisor/lib/container/libcontainer"
+	"github.com/google/cadvisor/lib/fs"
+	info "github.com/google/cadvisor/lib/model"
+
+	"k8s.io/klog/v2"
+)
+
+type lxcContainerHandler struct {
+	machineInfoFactory info.MachineInfoFactory
+
+	// Absolute path to the cgroup hierarchies of this container.
+	// (e.g.: "cpu" -> "/sys/fs/cgroup/cpu/lxc.payload.web01")
+	cgroupPaths map[string]string
+
+	fsInfo fs.FsInfo
+	// The root filesystem of the container, if it is a directory.
+	rootfs string
+	// Filesystem handler, nil if the container has no rootfs directory.
+	fsHandler common.FsHandler
+
+	// Metadata associated with the container.
+	reference info.ContainerReference
+	labels    map[string]string
+	image     string
+
+	includedMetrics container.MetricSet
+
+	cgroupManager cgroups.Manager
+	// Where the host's /proc is seen.
+	procRootFs string
+	// The init process of the container and its start time, to tell when it
+	// was replaced.
+	pid          int
+	pidStartTime uint64
+
+	libcontainerHandler *containerlibcontainer.Handler
+}
+
+var _ container.ContainerHandler = &lxcContainerHandler{}
+
+func newLXCContainerHandler(
+	name string,
+	rootFs string,
+	lxcPaths []string,
+	machineInfoFactory info.MachineInfoFactory,
+	fsInfo fs.FsInfo,
+	cgroupSubsystems map[string]string,
+	inHostNamespace bool,
+	includedMetrics container.MetricSet,
+) (container.ContainerHandler, error) {
+	lxcName := ContainerNameToLXCName(name)
+	if lxcName == "" {
+		return nil, fmt.Errorf("%q is not the cgroup of an lxc container", name)
+	}
+	config, err := readConfig(rootFs, lxcPaths, lxcName)
+	if err != nil {
+		return nil, err
+	}
+
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
+	cgroupManager, err := containerlibcontainer.NewCgroupManager(name, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := &lxcContainerHandler{
+		machineInfoFactory: machineInfoFactory,
+		cgroupPaths:        cgroupPaths,
+		fsInfo:             fsInfo,
+		rootfs:             config.rootfs,
+		reference: info.ContainerReference{
+			Id:        lxcName,
+			Name:      name,
+			Aliases:   []string{lxcName},
+			Namespace: LXCNamespace,
+		},
+		labels:          config.labels,
+		image:           config.image,
+		includedMetrics: includedMetrics,
+	}
+	if handler.rootfs != "" && includedMetrics.Has(container.DiskUsageMetrics) {
+		handler.fsHandler = common.NewFsHandler(common.DefaultPeriod, handler.rootfs, "", fsInfo)
+	}
+
+	libcontainerRootFs := "/"
+	if !inHostNamespace {
+		libcontainerRootFs = "/rootfs"
+	}
+	handler.cgroupManager = cgroupManager
+	handler.procRootFs = libcontainerRootFs
+	handler.updateInitPid()
+	handler.libcontainerHandler = containerlibcontainer.NewHandler(cgroupManager, libcontainerRootFs, handler.pid, includedMetrics)
+
+	return handler, nil
+}
+
+func (h *lxcContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return h.reference, nil
+}
+
+func (h *lxcContainerHandler) Start() {
+	if h.fsHandler != nil {
+		h.fsHandler.Start()
+	}
+}
+
+func (h *lxcContainerHandler) Cleanup() {
+	if h.fsHandler != nil {
+		h.fsHandler.Stop()
+	}
+}
+
+func (h *lxcContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	hasFilesystem := h.fsHandler != nil
+	hasNetwork := h.includedMetrics.Has(container.NetworkUsageMetrics)
+	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNetwork, hasFilesystem)
+	spec.Labels = h.labels
+	spec.Image = h.image
+	return spec, err
+}
+
+func (h *lxcContainerHandler) getFsStats(stats *info.ContainerStats) error {
+	mi, err := h.machineInfoFactory.GetMachineInfo()
+	if err != nil {
+		return err
+	}
+
+	if h.includedMetrics.Has(container.DiskIOMetrics) {
+		common.AssignDeviceNamesToDiskStats((*common.MachineInfoNamer)(mi), stats.DiskIo)
+	}
+
+	if h.fsHandler == nil {
+		return nil
+	}
+	deviceInfo, err := h.fsInfo.GetDirFsDevice(h.rootfs)
+	if err != nil {
+		return fmt.Errorf("unable to determine device info for dir: %v: %v", h.rootfs, err)
+	}
+
+	// LXC does not limit the size of directory root filesystems, so use the
+	// capacity of their filesystem as limit.
+	fsStat := info.FsStats{Device: deviceInfo.Device}
+	for _, fs := range mi.Filesystems {
+		if fs.Device == deviceInfo.Device {
+			fsStat.Limit = fs.Capacity
+			fsStat.Type = fs.Type
+			break
+		}
+	}
+	usage := h.fsHandler.Usage()
+	fsStat.BaseUsage = usage.BaseUsageBytes
+	fsStat.Usage = usage.TotalUsageBytes
+	fsStat.Inodes = usage.InodeUsage
+	fsStat.Btrfs = common.QgroupStats(usage.Qgroup)
+
+	stats.Filesystem = append(stats.Filesystem, fsStat)
+	return nil
+}
+
+// updateInitPid looks the init process of the container up again unless the
+// one known is still running.
+func (h *lxcContainerHandler) updateInitPid() {
+	if h.pid > 0 {
+		if _, startTime, err := readProcStat(h.procRootFs, h.pid); err == nil && startTime == h.pidStartTime {
+			return
+		}
+	}
+	// Processes of the container live in child cgroups too, e.g. init in
+	// init.scope when the container runs systemd on cgroup v2.
+	pids, err := h.cgroupManager.GetAllPids()
+	if err != nil {
+		klog.V(4).Infof("Failed to list the processes of %q: %v", h.reference.Name, err)
+		return
+	}
+	h.pid, h.pidStartTime = findInitPid(h.procRootFs, pids)
+}
+
+// findInitPid returns the init process of a container among its processes,
+// the one whose parent is outside of the container, and its start time.
+func findInitPid(procRootFs string, pids []int) (int, uint64) {
+	inContainer := make(map[int]bool, len(pids))
+	for _, pid := range pids {
+		inContainer[pid] = true
+	}
+	for _, pid := range pids {
+		ppid, startTime, err := readProcStat(procRootFs, pid)
+		if err == nil && !inContainer[ppid] {
+			return pid, startTime
+		}
+	}
+	return 0, 0
+}
+
+// readProcStat returns the parent and the start time of a process from
+// /proc/<pid>/stat.
+func readProcStat(procRootFs string, pid int) (int, uint64, error) {
+	data, err := os.ReadFile(filepath.Join(procRootFs, "proc", strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return 0, 0, err
+	}
+	// The command name may contain spaces and parentheses, the fields
+	// following it are the state, the parent and, 19 fields later, the start
+	// time.
+	i := bytes.LastIndexByte(data, ')')
+	if i < 0 {
+		return 0, 0, fmt.Errorf("malformed stat of process %d", pid)
+	}
+	fields := strings.Fields(string(data[i+1:]))
+	if len(fields) < 20 {
+		return 0, 0, fmt.Errorf("malformed stat of process %d", pid)
+	}
+	ppid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("malformed stat of process %d: %v", pid, err)
+	}
+	startTime, err := strconv.ParseUint(fields[19], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("malformed stat of process %d: %v", pid, err)
+	}
+	return ppid, startTime, nil
+}
+
+func (h *lxcContainerHandler) GetStats() (*info.ContainerStats, error) {
+	h.updateInitPid()
+	h.libcontainerHandler.SetPid(h.pid)
+	stats, err := h.libcontainerHandler.GetStats()
+	if err != nil {
+		return stats, err
+	}
+	if err := h.getFsStats(stats); err != nil {
+		return stats, err
+	}
+	return stats, nil
+}
+
+func (h *lxcContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	// The cgroups created inside the container are left to the raw handler.
+	return []info.ContainerReference{}, nil
+}
+
+func (h *lxcContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return h.libcontainerHandler.GetProcesses()
+}
+
+func (h *lxcContainerHandler) GetCgroupPath(resource string) (string, error) {
+	var res string
+	if !cgroups.IsCgroup2UnifiedMode() {
+		res = resource
+	}
+	path, ok := h.cgroupPaths[res]
+	if !ok {
+		return "", fmt.Errorf("could not find path for resource %q for container %q", resource, h.reference.Name)
+	}
+	return path, nil
+}
+
+func (h *lxcContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *lxcContainerHandler) GetContainerIPAddress() string {
+	// LXC only records the addresses of containers it set up statically.
+	return ""
+}
+
+func (h *lxcContainerHandler) Exists() bool {
+	return common.CgroupExists(h.cgroupPaths)
+}
+
+func (h *lxcContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeLXC
+}
+
+func (h *lxcContainerHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("exit code not available for lxc containers")
+}
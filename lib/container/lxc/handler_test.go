@@ -0,0 +1,98 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package lxc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/lib/container"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+type machineInfo struct{}
+
+func (m *machineInfo) GetMachineInfo() (*info.MachineInfo, error) {
+	return &info.MachineInfo{}, nil
+}
+
+func (m *machineInfo) GetVersionInfo() (*info.VersionInfo, error) {
+	return &info.VersionInfo{}, nil
+}
+
+func TestHandler(t *testing.T) {
+	handler, err := newLXCContainerHandler("/lxc.payload.shop_db01", "testdata", testLXCPaths, &machineInfo{}, nil, nil, true, container.AllMetrics)
+	require.NoError(t, err)
+
+	ref, err := handler.ContainerReference()
+	require.NoError(t, err)
+	assert.Equal(t, info.ContainerReference{
+		Id:        "shop_db01",
+		Name:      "/lxc.payload.shop_db01",
+		Aliases:   []string{"shop_db01"},
+		Namespace: LXCNamespace,
+	}, ref)
+	assert.Equal(t, "payments", handler.GetContainerLabels()["user.team"])
+	assert.Equal(t, container.ContainerTypeLXC, handler.Type())
+
+	spec, err := handler.GetSpec()
+	assert.NoError(t, err)
+	assert.Equal(t, "Debian bookworm amd64 (20260101_05:24)", spec.Image)
+	assert.True(t, spec.HasFilesystem)
+
+	// Without disk usage metrics, the root filesystem is not walked.
+	handler, err = newLXCContainerHandler("/lxc.payload.web01", "testdata", testLXCPaths, &machineInfo{}, nil, nil, true, container.MetricSet{})
+	require.NoError(t, err)
+	spec, err = handler.GetSpec()
+	assert.NoError(t, err)
+	assert.False(t, spec.HasFilesystem)
+
+	_, err = newLXCContainerHandler("/lxc.payload.missing", "testdata", testLXCPaths, &machineInfo{}, nil, nil, true, container.AllMetrics)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func writeProcStat(t *testing.T, root string, pid, ppid int, startTime uint64) {
+	dir := filepath.Join(root, "proc", fmt.Sprint(pid))
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	stat := fmt.Sprintf("%d (sh (x)) S %d %d 0 0 -1 4194560 0 0 0 0 0 0 0 0 20 0 1 0 %d 0 0", pid, ppid, pid, startTime)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "stat"), []byte(stat), 0o644))
+}
+
+func TestFindInitPid(t *testing.T) {
+	root := t.TempDir()
+	// systemd in init.scope, started by the lxc monitor, and a service.
+	writeProcStat(t, root, 300, 1, 5000)
+	writeProcStat(t, root, 200, 100, 4000)
+	writeProcStat(t, root, 250, 200, 4100)
+
+	pid, startTime := findInitPid(root, []int{250, 200})
+	assert.Equal(t, 200, pid)
+	assert.Equal(t, uint64(4000), startTime)
+
+	ppid, startTime, err := readProcStat(root, 300)
+	require.NoError(t, err)
+	assert.Equal(t, 1, ppid)
+	assert.Equal(t, uint64(5000), startTime)
+
+	pid, _ = findInitPid(root, []int{404})
+	assert.Equal(t, 0, pid)
+}
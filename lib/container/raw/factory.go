@@ -52,8 +52,8 @@ type rawFactory struct {
 	// List of metrics to be included.
 	includedMetrics map[container.MetricKind]struct{}
 
-	// List of raw container cgroup path prefix whitelist.
-	rawPrefixWhiteList []string
+	// Returns the raw container cgroup path prefix whitelist in effect.
+	rawPrefixWhiteList func() []string
 }
 
 func (f *rawFactory) String() string {
@@ -73,10 +73,11 @@ func (f *rawFactory) CanHandleAndAccept(name string) (bool, bool, error) {
 	if name == "/" {
 		return true, true, nil
 	}
-	if *DockerOnly && f.rawPrefixWhiteList[0] == "" {
+	rawPrefixWhiteList := f.rawPrefixWhiteList()
+	if *DockerOnly && (len(rawPrefixWhiteList) == 0 || rawPrefixWhiteList[0] == "") {
 		return true, false, nil
 	}
-	for _, prefix := range f.rawPrefixWhiteList {
+	for _, prefix := range rawPrefixWhiteList {
 		if strings.HasPrefix(name, prefix) {
 			return true, true, nil
 		}
@@ -89,6 +90,13 @@ func (f *rawFactory) DebugInfo() map[string][]string {
 }
 
 func Register(machineInfoFactory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics map[container.MetricKind]struct{}, rawPrefixWhiteList []string) error {
+	return RegisterWithPrefixWhiteListFunc(machineInfoFactory, fsInfo, includedMetrics, func() []string { return rawPrefixWhiteList })
+}
+
+// RegisterWithPrefixWhiteListFunc is Register for a raw container cgroup path
+// prefix whitelist that may change while the factory is running: it is
+// consulted, through rawPrefixWhiteList, every time a container is checked.
+func RegisterWithPrefixWhiteListFunc(machineInfoFactory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics map[container.MetricKind]struct{}, rawPrefixWhiteList func() []string) error {
 	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
 	if err != nil {
 		return fmt.Errorf("failed to get cgroup subsystems: %v", err)
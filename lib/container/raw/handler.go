@@ -24,8 +24,11 @@ import (
 	"github.com/google/cadvisor/lib/container/common"
 	"github.com/google/cadvisor/lib/container/libcontainer"
 	"github.com/google/cadvisor/lib/fs"
+	"github.com/google/cadvisor/lib/fs/zfs"
 	"github.com/google/cadvisor/lib/machine"
 	info "github.com/google/cadvisor/lib/model"
+	"github.com/google/cadvisor/lib/utils/sysfs"
+	"github.com/google/cadvisor/lib/utils/sysinfo"
 
 	"github.com/opencontainers/cgroups"
 	"k8s.io/klog/v2"
@@ -45,6 +48,12 @@ type rawContainerHandler struct {
 	includedMetrics container.MetricSet
 
 	libcontainerHandler *libcontainer.Handler
+
+	// Reads the statistics of ZFS, for the root container only.
+	zfs *zfsReader
+
+	// Reads the health of the block devices, for the root container only.
+	sysFs sysfs.SysFs
 }
 
 func isRootCgroup(name string) bool {
@@ -52,7 +61,7 @@ func isRootCgroup(name string) bool {
 }
 
 func newRawContainerHandler(name string, cgroupSubsystems map[string]string, machineInfoFactory info.MachineInfoFactory, fsInfo fs.FsInfo, watcher *common.InotifyWatcher, rootFs string, includedMetrics container.MetricSet) (container.ContainerHandler, error) {
-	cHints, err := common.GetContainerHintsFromFile(*common.ArgContainerHints)
+	cHints, err := common.GetContainerHintsFromFile(common.ContainerHintsFile())
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +91,15 @@ func newRawContainerHandler(name string, cgroupSubsystems map[string]string, mac
 
 	handler := libcontainer.NewHandler(cgroupManager, rootFs, pid, includedMetrics)
 
+	var zfsStats *zfsReader
+	if isRootCgroup(name) && includedMetrics.Has(container.DiskUsageMetrics) {
+		zfsStats = newZfsReader()
+	}
+	var sysFs sysfs.SysFs
+	if isRootCgroup(name) && includedMetrics.Has(container.DiskIOMetrics) {
+		sysFs = sysfs.NewRealSysFs()
+	}
+
 	return &rawContainerHandler{
 		name:                name,
 		machineInfoFactory:  machineInfoFactory,
@@ -90,6 +108,8 @@ func newRawContainerHandler(name string, cgroupSubsystems map[string]string, mac
 		externalMounts:      externalMounts,
 		includedMetrics:     includedMetrics,
 		libcontainerHandler: handler,
+		zfs:                 zfsStats,
+		sysFs:               sysFs,
 	}, nil
 }
 
@@ -165,26 +185,45 @@ func fsToFsStats(fs *fs.Fs) info.FsStats {
 		inodesFree = *fs.InodesFree
 	}
 	return info.FsStats{
-		Device:          fs.Device,
-		Type:            fs.Type.String(),
-		Limit:           fs.Capacity,
-		Usage:           fs.Capacity - fs.Free,
-		HasInodes:       hasInodes,
-		Inodes:          inodes,
-		InodesFree:      inodesFree,
-		Available:       fs.Available,
-		ReadsCompleted:  fs.DiskStats.ReadsCompleted,
-		ReadsMerged:     fs.DiskStats.ReadsMerged,
-		SectorsRead:     fs.DiskStats.SectorsRead,
-		ReadTime:        fs.DiskStats.ReadTime,
-		WritesCompleted: fs.DiskStats.WritesCompleted,
-		WritesMerged:    fs.DiskStats.WritesMerged,
-		SectorsWritten:  fs.DiskStats.SectorsWritten,
-		WriteTime:       fs.DiskStats.WriteTime,
-		IoInProgress:    fs.DiskStats.IoInProgress,
-		IoTime:          fs.DiskStats.IoTime,
-		WeightedIoTime:  fs.DiskStats.WeightedIoTime,
+		Device:            fs.Device,
+		Type:              fs.Type.String(),
+		Limit:             fs.Capacity,
+		Usage:             fs.Capacity - fs.Free,
+		HasInodes:         hasInodes,
+		Inodes:            inodes,
+		InodesFree:        inodesFree,
+		Available:         fs.Available,
+		ReadsCompleted:    fs.DiskStats.ReadsCompleted,
+		ReadsMerged:       fs.DiskStats.ReadsMerged,
+		SectorsRead:       fs.DiskStats.SectorsRead,
+		ReadTime:          fs.DiskStats.ReadTime,
+		WritesCompleted:   fs.DiskStats.WritesCompleted,
+		WritesMerged:      fs.DiskStats.WritesMerged,
+		SectorsWritten:    fs.DiskStats.SectorsWritten,
+		WriteTime:         fs.DiskStats.WriteTime,
+		IoInProgress:      fs.DiskStats.IoInProgress,
+		IoTime:            fs.DiskStats.IoTime,
+		WeightedIoTime:    fs.DiskStats.WeightedIoTime,
+		DiscardsCompleted: fs.DiskStats.DiscardsCompleted,
+		DiscardsMerged:    fs.DiskStats.DiscardsMerged,
+		SectorsDiscarded:  fs.DiskStats.SectorsDiscarded,
+		DiscardTime:       fs.DiskStats.DiscardTime,
+		FlushesCompleted:  fs.DiskStats.FlushesCompleted,
+		FlushTime:         fs.DiskStats.FlushTime,
+		Allocations:       fsAllocations(fs.Allocations),
+		Nfs:               common.NfsStats(fs.Nfs),
+	}
+}
+
+func fsAllocations(allocations []fs.Allocation) []info.FsAllocation {
+	if len(allocations) == 0 {
+		return nil
 	}
+	out := make([]info.FsAllocation, 0, len(allocations))
+	for _, a := range allocations {
+		out = append(out, info.FsAllocation{Type: a.Type, Profile: a.Profile, Total: a.Total, Used: a.Used})
+	}
+	return out
 }
 
 func (h *rawContainerHandler) getFsStats(stats *info.ContainerStats) error {
@@ -243,9 +282,29 @@ func (h *rawContainerHandler) GetStats() (*info.ContainerStats, error) {
 		return stats, err
 	}
 
+	// The zfs and zpool commands fail until the ZFS module is loaded.
+	if h.zfs != nil && zfs.Available() {
+		stats.Zfs = h.zfs.stats()
+	}
+
+	if h.sysFs != nil {
+		h.getDiskHealth(stats)
+	}
+
 	return stats, nil
 }
 
+// getDiskHealth reads the health signals of the block devices of the
+// machine, which change too often to be part of its MachineInfo.
+func (h *rawContainerHandler) getDiskHealth(stats *info.ContainerStats) {
+	machineInfo, err := h.machineInfoFactory.GetMachineInfo()
+	if err != nil {
+		klog.Errorf("Failed to get machine info for the health of block devices: %v", err)
+		return
+	}
+	stats.DiskHealth = sysinfo.GetBlockDeviceHealth(h.sysFs, machineInfo.DiskMap)
+}
+
 func (h *rawContainerHandler) GetCgroupPath(resource string) (string, error) {
 	var res string
 	if !cgroups.IsCgroup2UnifiedMode() {
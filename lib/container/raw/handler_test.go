@@ -27,6 +27,7 @@ import (
 	"github.com/google/cadvisor/lib/container/common"
 	"github.com/google/cadvisor/lib/fs"
 	info "github.com/google/cadvisor/lib/model"
+	"github.com/google/cadvisor/lib/utils/sysfs/fakesysfs"
 )
 
 func TestFsToFsStats(t *testing.T) {
@@ -604,3 +605,27 @@ func (m machineInfo) GetMachineInfo() (*info.MachineInfo, error) {
 func (m machineInfo) GetVersionInfo() (*info.VersionInfo, error) {
 	panic("unsupported")
 }
+
+func TestGetDiskHealth(t *testing.T) {
+	sysFs := &fakesysfs.FakeSysFs{}
+	sysFs.SetBlockDeviceAttributes(map[string]string{
+		"sda/device/state": "running",
+		"sdb/device/state": "offline",
+	})
+	sysFs.SetBlockDeviceHwmonAttributes(map[string]string{
+		"sda/temp1_input": "41000",
+	})
+	handler := rawContainerHandler{
+		name:               "/",
+		machineInfoFactory: machineInfo{},
+		sysFs:              sysFs,
+	}
+	stats := &info.ContainerStats{}
+	handler.getDiskHealth(stats)
+
+	temperature := 41.0
+	assert.Equal(t, []info.DiskHealthStats{
+		{Name: "sda", State: "running", Temperature: &temperature},
+		{Name: "sdb", State: "offline"},
+	}, stats.DiskHealth)
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package raw
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/google/cadvisor/lib/fs/zfs"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// How often the imported ZFS pools are listed. Running `zpool list` at every
+// housekeeping of the root container would be wasteful, so pools are
+// refreshed as often as the datasets of containers are.
+const zfsPoolsInterval = 15 * time.Second
+
+// zfsReader reads the statistics of ZFS for the root container.
+type zfsReader struct {
+	// Overridable for tests.
+	run     zfs.Runner
+	readArc func() (*zfs.ArcStats, error)
+
+	lock     sync.Mutex
+	pools    []info.ZfsPoolStats
+	listedAt time.Time
+}
+
+func newZfsReader() *zfsReader {
+	return &zfsReader{run: zfs.ExecRunner, readArc: zfs.ReadArcStats}
+}
+
+// stats returns the health and usage of the imported pools, listed at most
+// once per zfsPoolsInterval, and the current statistics of the ARC.
+func (r *zfsReader) stats() *info.ZfsStats {
+	stats := &info.ZfsStats{Pools: r.listPools()}
+	arcStats, err := r.readArc()
+	if err != nil {
+		klog.Errorf("Failed to get zfs ARC stats: %v", err)
+		return stats
+	}
+	stats.Arc = &info.ZfsArcStats{
+		Hits:       arcStats.Hits,
+		Misses:     arcStats.Misses,
+		L2Hits:     arcStats.L2Hits,
+		L2Misses:   arcStats.L2Misses,
+		Size:       arcStats.Size,
+		TargetSize: arcStats.TargetSize,
+		MaxSize:    arcStats.MaxSize,
+	}
+	return stats
+}
+
+func (r *zfsReader) listPools() []info.ZfsPoolStats {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.listedAt.IsZero() && time.Since(r.listedAt) < zfsPoolsInterval {
+		return r.pools
+	}
+	// Failures are retried at the next interval too, rather than at every
+	// housekeeping.
+	r.listedAt = time.Now()
+	poolStats, err := zfs.ListPools(r.run)
+	if err != nil {
+		klog.Errorf("Failed to get zfs pools: %v", err)
+		r.pools = nil
+		return nil
+	}
+	pools := make([]info.ZfsPoolStats, 0, len(poolStats))
+	for _, pool := range poolStats {
+		pools = append(pools, info.ZfsPoolStats{
+			Name:          pool.Name,
+			Health:        pool.Health,
+			Size:          pool.Size,
+			Allocated:     pool.Allocated,
+			Free:          pool.Free,
+			Fragmentation: pool.Fragmentation,
+			Capacity:      pool.Capacity,
+		})
+	}
+	r.pools = pools
+	return pools
+}
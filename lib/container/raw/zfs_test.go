@@ -0,0 +1,58 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package raw
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/lib/fs/zfs"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+func TestZfsReader(t *testing.T) {
+	listings := 0
+	var listErr error
+	r := &zfsReader{
+		run: func(name string, arg ...string) ([]byte, error) {
+			listings++
+			return []byte("tank\tDEGRADED\t4000000\t3000000\t1000000\t12\t75\n"), listErr
+		},
+		readArc: func() (*zfs.ArcStats, error) {
+			return &zfs.ArcStats{Hits: 900, Misses: 100, Size: 2048, TargetSize: 4096, MaxSize: 8192}, nil
+		},
+	}
+
+	pools := []info.ZfsPoolStats{{Name: "tank", Health: "DEGRADED", Size: 4000000, Allocated: 3000000, Free: 1000000, Fragmentation: 12, Capacity: 75}}
+	assert.Equal(t, &info.ZfsStats{
+		Pools: pools,
+		Arc:   &info.ZfsArcStats{Hits: 900, Misses: 100, Size: 2048, TargetSize: 4096, MaxSize: 8192},
+	}, r.stats())
+
+	// Pools are listed once per interval, the ARC is read every time.
+	r.readArc = func() (*zfs.ArcStats, error) { return nil, errors.New("no arcstats") }
+	assert.Equal(t, &info.ZfsStats{Pools: pools}, r.stats())
+	assert.Equal(t, 1, listings)
+
+	// Pools that cannot be listed are not reported stale.
+	r.listedAt = r.listedAt.Add(-zfsPoolsInterval)
+	listErr = errors.New("zpool failed")
+	assert.Equal(t, &info.ZfsStats{}, r.stats())
+	assert.Equal(t, 2, listings)
+}
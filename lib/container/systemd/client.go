@@ -0,0 +1,292 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package systemd
+
+import (
+	"bufio"
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	sddbus "github.com/coreos/go-systemd/v22/dbus"
+
+	info "github.com/google/cadvisor/lib/model"
+
+	"k8s.io/klog/v2"
+)
+
+// Timeout of a single D-Bus query.
+const dbusTimeout = 2 * time.Second
+
+// Bounds of how long to wait before dialling D-Bus again after failing to,
+// doubling with every failure in a row.
+const (
+	minDialBackoff = 5 * time.Second
+	maxDialBackoff = 5 * time.Minute
+)
+
+// How long the metadata of a unit is reused for. The manager asks for the spec
+// of every unit on each housekeeping, which would otherwise be a round trip to
+// systemd per unit.
+const unitPropertiesTTL = 30 * time.Second
+
+// unitClient looks up the metadata of systemd units.
+type unitClient interface {
+	// UnitProperties returns the metadata of the named unit.
+	UnitProperties(unit string) (*info.SystemdUnitSpec, error)
+}
+
+// dbusClient queries systemd over D-Bus. When D-Bus cannot be reached, e.g.
+// because cAdvisor runs in a container without the system bus socket mounted,
+// it falls back to fallback.
+type dbusClient struct {
+	fallback unitClient
+	// Dials D-Bus; sddbus.NewWithContext but for tests.
+	dial func(ctx context.Context) (*sddbus.Conn, error)
+
+	// Lock for conn, dialErr, backoff and retryAt.
+	lock sync.Mutex
+	// The connection, (re)established on first use.
+	conn *sddbus.Conn
+	// The last failure to dial, retried no sooner than retryAt.
+	dialErr error
+	backoff time.Duration
+	retryAt time.Time
+}
+
+func (c *dbusClient) connection(ctx context.Context) (*sddbus.Conn, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.conn != nil && c.conn.Connected() {
+		return c.conn, nil
+	}
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
+	now := time.Now()
+	if now.Before(c.retryAt) {
+		return nil, c.dialErr
+	}
+	dial := c.dial
+	if dial == nil {
+		dial = sddbus.NewWithContext
+	}
+	conn, err := dial(ctx)
+	if err != nil {
+		c.backoff = min(max(2*c.backoff, minDialBackoff), maxDialBackoff)
+		c.dialErr, c.retryAt = err, now.Add(c.backoff)
+		return nil, err
+	}
+	c.dialErr, c.backoff, c.retryAt = nil, 0, time.Time{}
+	c.conn = conn
+	return conn, nil
+}
+
+func (c *dbusClient) UnitProperties(unit string) (*info.SystemdUnitSpec, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), dbusTimeout)
+	defer cancel()
+	conn, err := c.connection(ctx)
+	if err != nil {
+		klog.V(4).Infof("Cannot connect to systemd over D-Bus, falling back to its runtime directory: %v", err)
+		return c.fallback.UnitProperties(unit)
+	}
+	unitProperties, err := conn.GetUnitPropertiesContext(ctx, unit)
+	if err != nil {
+		return nil, err
+	}
+	serviceProperties, err := conn.GetUnitTypePropertiesContext(ctx, unit, "Service")
+	if err != nil {
+		return nil, err
+	}
+	return unitSpecFromProperties(unit, unitProperties, serviceProperties), nil
+}
+
+// cachingClient reuses the metadata of each unit looked up by client for ttl.
+type cachingClient struct {
+	client unitClient
+	ttl    time.Duration
+
+	// Lock for units and lastSweep.
+	lock sync.Mutex
+	// Metadata of units, by unit name.
+	units     map[string]cachedUnit
+	lastSweep time.Time
+}
+
+type cachedUnit struct {
+	spec    *info.SystemdUnitSpec
+	fetched time.Time
+}
+
+func newCachingClient(client unitClient, ttl time.Duration) *cachingClient {
+	return &cachingClient{
+		client: client,
+		ttl:    ttl,
+		units:  make(map[string]cachedUnit),
+	}
+}
+
+// UnitProperties returns a copy of the cached metadata of the unit, looking it
+// up again once older than ttl. Failed lookups are not cached.
+func (c *cachingClient) UnitProperties(unit string) (*info.SystemdUnitSpec, error) {
+	now := time.Now()
+	c.lock.Lock()
+	cached, ok := c.units[unit]
+	c.lock.Unlock()
+	if !ok || now.Sub(cached.fetched) >= c.ttl {
+		spec, err := c.client.UnitProperties(unit)
+		if err != nil {
+			return nil, err
+		}
+		cached = cachedUnit{spec: spec, fetched: now}
+		c.lock.Lock()
+		c.sweep(now)
+		c.units[unit] = cached
+		c.lock.Unlock()
+	}
+	spec := *cached.spec
+	return &spec, nil
+}
+
+// sweep forgets the metadata of units no longer asked about, such as those of
+// stopped services. Must be called with the lock held.
+func (c *cachingClient) sweep(now time.Time) {
+	if now.Sub(c.lastSweep) < c.ttl {
+		return
+	}
+	for unit, cached := range c.units {
+		if now.Sub(cached.fetched) >= c.ttl {
+			delete(c.units, unit)
+		}
+	}
+	c.lastSweep = now
+}
+
+// unitSpecFromProperties builds a unit's metadata from its D-Bus properties,
+// as returned for the org.freedesktop.systemd1.Unit and .Service interfaces.
+func unitSpecFromProperties(unit string, unitProperties, serviceProperties map[string]interface{}) *info.SystemdUnitSpec {
+	spec := &info.SystemdUnitSpec{
+		Unit:           unit,
+		Slice:          stringProperty(serviceProperties, "Slice"),
+		Description:    stringProperty(unitProperties, "Description"),
+		ActiveState:    stringProperty(unitProperties, "ActiveState"),
+		SubState:       stringProperty(unitProperties, "SubState"),
+		Restarts:       uint32Property(serviceProperties, "NRestarts"),
+		MainPID:        uint32Property(serviceProperties, "MainPID"),
+		CPUWeight:      limitProperty(serviceProperties, "CPUWeight"),
+		CPUQuotaPerSec: limitProperty(serviceProperties, "CPUQuotaPerSecUSec"),
+		IOWeight:       limitProperty(serviceProperties, "IOWeight"),
+		MemoryHigh:     limitProperty(serviceProperties, "MemoryHigh"),
+		MemoryMax:      limitProperty(serviceProperties, "MemoryMax"),
+		TasksMax:       limitProperty(serviceProperties, "TasksMax"),
+	}
+	// Timestamps are in microseconds since the epoch, 0 if never.
+	if usec, ok := unitProperties["ActiveEnterTimestamp"].(uint64); ok && usec > 0 {
+		spec.ActiveSince = time.UnixMicro(int64(usec))
+	}
+	return spec
+}
+
+func stringProperty(properties map[string]interface{}, name string) string {
+	value, _ := properties[name].(string)
+	return value
+}
+
+func uint32Property(properties map[string]interface{}, name string) uint32 {
+	value, _ := properties[name].(uint32)
+	return value
+}
+
+// limitProperty returns a resource-control property, mapping systemd's
+// "infinity" and "[not set]" (both the maximum uint64) to 0.
+func limitProperty(properties map[string]interface{}, name string) uint64 {
+	value, _ := properties[name].(uint64)
+	if value == math.MaxUint64 {
+		return 0
+	}
+	return value
+}
+
+// Directories unit files are looked up in, relative to the root filesystem,
+// in order of precedence.
+var unitFileDirs = []string{
+	"/etc/systemd/system",
+	"/run/systemd/transient",
+	"/run/systemd/system",
+	"/usr/lib/systemd/system",
+	"/lib/systemd/system",
+}
+
+// runtimeDirClient derives what it can about a unit from the files systemd
+// keeps on disk: whether it is active from the invocation ID systemd records
+// in /run/systemd/units for running units, and its description from its unit
+// file. It needs no D-Bus access but knows nothing of restarts, PIDs or
+// resource control.
+type runtimeDirClient struct {
+	// Root filesystem of the host, "/" or "/rootfs".
+	rootFs string
+}
+
+func (c *runtimeDirClient) UnitProperties(unit string) (*info.SystemdUnitSpec, error) {
+	spec := &info.SystemdUnitSpec{
+		Unit:        unit,
+		ActiveState: "inactive",
+	}
+	if _, err := os.Lstat(filepath.Join(c.rootFs, "/run/systemd/units", "invocation:"+unit)); err == nil {
+		spec.ActiveState = "active"
+	}
+	for _, dir := range unitFileDirs {
+		description, err := readUnitDescription(filepath.Join(c.rootFs, dir, unit))
+		if err == nil {
+			spec.Description = description
+			break
+		}
+	}
+	return spec, nil
+}
+
+// readUnitDescription returns the Description= of a unit file's [Unit]
+// section.
+func readUnitDescription(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	inUnitSection := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			inUnitSection = line == "[Unit]"
+			continue
+		}
+		if !inUnitSection {
+			continue
+		}
+		if value, ok := strings.CutPrefix(line, "Description="); ok {
+			return value, nil
+		}
+	}
+	return "", scanner.Err()
+}
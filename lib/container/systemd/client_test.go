@@ -0,0 +1,175 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package systemd
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sddbus "github.com/coreos/go-systemd/v22/dbus"
+
+	info "github.com/google/cadvisor/lib/model"
+)
+
+func TestUnitSpecFromProperties(t *testing.T) {
+	activeSince := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	unitProperties := map[string]interface{}{
+		"Id":                   "sshd.service",
+		"Description":          "OpenSSH server daemon",
+		"ActiveState":          "active",
+		"SubState":             "running",
+		"ActiveEnterTimestamp": uint64(activeSince.UnixMicro()),
+	}
+	serviceProperties := map[string]interface{}{
+		"Slice":              "system.slice",
+		"NRestarts":          uint32(3),
+		"MainPID":            uint32(1234),
+		"CPUWeight":          uint64(200),
+		"CPUQuotaPerSecUSec": uint64(math.MaxUint64),
+		"MemoryMax":          uint64(512 << 20),
+		"MemoryHigh":         uint64(math.MaxUint64),
+		"TasksMax":           uint64(4915),
+	}
+	assert.Equal(t, &info.SystemdUnitSpec{
+		Unit:        "sshd.service",
+		Slice:       "system.slice",
+		Description: "OpenSSH server daemon",
+		ActiveState: "active",
+		SubState:    "running",
+		ActiveSince: time.UnixMicro(activeSince.UnixMicro()),
+		Restarts:    3,
+		MainPID:     1234,
+		CPUWeight:   200,
+		MemoryMax:   512 << 20,
+		TasksMax:    4915,
+	}, unitSpecFromProperties("sshd.service", unitProperties, serviceProperties))
+}
+
+func TestRuntimeDirClient(t *testing.T) {
+	rootFs := t.TempDir()
+	unitsDir := filepath.Join(rootFs, "/run/systemd/units")
+	require.NoError(t, os.MkdirAll(unitsDir, 0o755))
+	require.NoError(t, os.Symlink("5d0ad5b3a1f84a1a9f0c2f2a1d9f8e7c", filepath.Join(unitsDir, "invocation:sshd.service")))
+	for dir, content := range map[string]string{
+		"/etc/systemd/system":     "[Unit]\nDescription=Local override\n",
+		"/usr/lib/systemd/system": "[Unit]\nDescription=OpenSSH server daemon\n",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(rootFs, dir), 0o755))
+		unit := "sshd.service"
+		if dir == "/etc/systemd/system" {
+			unit = "other.service"
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(rootFs, dir, unit), []byte(content), 0o644))
+	}
+
+	client := &runtimeDirClient{rootFs: rootFs}
+	spec, err := client.UnitProperties("sshd.service")
+	require.NoError(t, err)
+	assert.Equal(t, &info.SystemdUnitSpec{
+		Unit:        "sshd.service",
+		Description: "OpenSSH server daemon",
+		ActiveState: "active",
+	}, spec)
+
+	spec, err = client.UnitProperties("other.service")
+	require.NoError(t, err)
+	assert.Equal(t, "inactive", spec.ActiveState)
+	assert.Equal(t, "Local override", spec.Description)
+}
+
+func TestReadUnitDescription(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "foo.service")
+	require.NoError(t, os.WriteFile(file, []byte(`# comment
+[Service]
+Description=not this one
+ExecStart=/usr/bin/foo
+
+[Unit]
+After=network.target
+Description=Foo daemon
+`), 0o644))
+	description, err := readUnitDescription(file)
+	require.NoError(t, err)
+	assert.Equal(t, "Foo daemon", description)
+}
+
+func TestCachingClient(t *testing.T) {
+	client := &fakeUnitClient{units: map[string]*info.SystemdUnitSpec{
+		"sshd.service": {Unit: "sshd.service", ActiveState: "active"},
+	}}
+	cache := newCachingClient(client, time.Hour)
+
+	spec, err := cache.UnitProperties("sshd.service")
+	require.NoError(t, err)
+	// Callers get copies they may modify.
+	spec.Slice = "system.slice"
+	spec, err = cache.UnitProperties("sshd.service")
+	require.NoError(t, err)
+	assert.Equal(t, &info.SystemdUnitSpec{Unit: "sshd.service", ActiveState: "active"}, spec)
+	assert.Equal(t, 1, client.lookups)
+
+	// Failed lookups are not cached.
+	_, err = cache.UnitProperties("missing.service")
+	assert.Error(t, err)
+	_, err = cache.UnitProperties("missing.service")
+	assert.Error(t, err)
+	assert.Equal(t, 3, client.lookups)
+
+	// Expired metadata is looked up again.
+	cache.ttl = 0
+	_, err = cache.UnitProperties("sshd.service")
+	require.NoError(t, err)
+	assert.Equal(t, 4, client.lookups)
+}
+
+func TestDbusClientBacksOffDialling(t *testing.T) {
+	dials := 0
+	fallback := &fakeUnitClient{units: map[string]*info.SystemdUnitSpec{
+		"sshd.service": {Unit: "sshd.service", ActiveState: "active"},
+	}}
+	client := &dbusClient{
+		fallback: fallback,
+		dial: func(ctx context.Context) (*sddbus.Conn, error) {
+			dials++
+			return nil, errors.New("no system bus")
+		},
+	}
+
+	for i := 0; i < 3; i++ {
+		spec, err := client.UnitProperties("sshd.service")
+		require.NoError(t, err)
+		assert.Equal(t, "active", spec.ActiveState)
+	}
+	assert.Equal(t, 1, dials)
+	assert.Equal(t, 3, fallback.lookups)
+	assert.Equal(t, minDialBackoff, client.backoff)
+
+	// Once the backoff elapsed D-Bus is dialled again, backing off longer.
+	client.retryAt = time.Now()
+	_, err := client.UnitProperties("sshd.service")
+	require.NoError(t, err)
+	assert.Equal(t, 2, dials)
+	assert.Equal(t, 2*minDialBackoff, client.backoff)
+}
@@ -12,13 +12,20 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build linux
+
 package systemd
 
 import (
+	"flag"
 	"fmt"
+	"path"
 	"strings"
+	"sync"
 
 	"github.com/google/cadvisor/lib/container"
+	"github.com/google/cadvisor/lib/container/libcontainer"
+	"github.com/google/cadvisor/lib/container/raw"
 	"github.com/google/cadvisor/lib/fs"
 	info "github.com/google/cadvisor/lib/model"
 	"github.com/google/cadvisor/lib/watcher"
@@ -26,14 +33,48 @@ import (
 	"k8s.io/klog/v2"
 )
 
-type systemdFactory struct{}
+var (
+	systemdUnits     = flag.Bool("systemd_units", false, "Monitor the services under system.slice as systemd containers, with the metadata of their units")
+	systemdUserUnits = flag.Bool("systemd_user_units", false, "Also monitor the services of users' slices under user.slice, such as user@<uid>.service, as systemd containers. Requires --systemd_units")
+)
+
+type systemdFactory struct {
+	machineInfoFactory info.MachineInfoFactory
+
+	// Information about the mounted cgroup subsystems. Nil if they could not
+	// be found, in which case services are left to the raw factory.
+	cgroupSubsystems map[string]string
+
+	includedMetrics container.MetricSet
+
+	// Client for unit metadata, created on first use.
+	clientOnce sync.Once
+	client     unitClient
+}
 
 func (f *systemdFactory) String() string {
-	return "systemd"
+	return SystemdNamespace
 }
 
 func (f *systemdFactory) NewContainerHandler(name string, metadataEnvAllowList []string, inHostNamespace bool) (container.ContainerHandler, error) {
-	return nil, fmt.Errorf("not yet supported")
+	if f.cgroupSubsystems == nil {
+		return nil, fmt.Errorf("no cgroup subsystems found for %q", name)
+	}
+	return newSystemdContainerHandler(f.unitClient(inHostNamespace), name, f.machineInfoFactory, f.cgroupSubsystems, inHostNamespace, f.includedMetrics)
+}
+
+func (f *systemdFactory) unitClient(inHostNamespace bool) unitClient {
+	f.clientOnce.Do(func() {
+		if f.client != nil {
+			return
+		}
+		rootFs := "/"
+		if !inHostNamespace {
+			rootFs = "/rootfs"
+		}
+		f.client = newCachingClient(&dbusClient{fallback: &runtimeDirClient{rootFs: rootFs}}, unitPropertiesTTL)
+	})
+	return f.client
 }
 
 func (f *systemdFactory) CanHandleAndAccept(name string) (bool, bool, error) {
@@ -42,18 +83,57 @@ func (f *systemdFactory) CanHandleAndAccept(name string) (bool, bool, error) {
 	if strings.HasSuffix(name, ".mount") {
 		return true, false, nil
 	}
+	// Only services are claimed: scopes are also how container runtimes
+	// place their containers, and slices only group other units. With
+	// --docker_only the raw factory decides which services to monitor,
+	// honouring --raw_cgroup_prefix_whitelist.
+	if isSystemdService(name) && f.cgroupSubsystems != nil && !*raw.DockerOnly {
+		return true, true, nil
+	}
 	klog.V(5).Infof("%s not handled by systemd handler", name)
 	return false, false, nil
 }
 
+// isSystemdService returns whether the cgroup with the given name is that of
+// a service cAdvisor is told to monitor: one directly under system.slice or,
+// with --systemd_user_units, directly under the slice of a user. Services
+// below them, such as those of user managers, are not known to the system
+// manager, and cgroups a service creates for itself are left to the raw
+// factory.
+func isSystemdService(name string) bool {
+	if !*systemdUnits || !strings.HasSuffix(name, ".service") {
+		return false
+	}
+	parent := path.Dir(name)
+	if parent == "/system.slice" {
+		return true
+	}
+	matched, _ := path.Match("/user.slice/user-*.slice", parent)
+	return matched && *systemdUserUnits
+}
+
 func (f *systemdFactory) DebugInfo() map[string][]string {
 	return map[string][]string{}
 }
 
 // Register registers the systemd container factory.
 func Register(machineInfoFactory info.MachineInfoFactory, fsInfo fs.FsInfo, includedMetrics container.MetricSet) error {
+	cgroupSubsystems, err := libcontainer.GetCgroupSubsystems(includedMetrics)
+	if err == nil && len(cgroupSubsystems) == 0 {
+		err = fmt.Errorf("no supported cgroup mounts")
+	}
+	if err != nil {
+		// .mount cgroups still need to be ignored.
+		klog.Warningf("Systemd services will be handled as raw cgroups: %v", err)
+		cgroupSubsystems = nil
+	}
+
 	klog.V(1).Infof("Registering systemd factory")
-	factory := &systemdFactory{}
+	factory := &systemdFactory{
+		machineInfoFactory: machineInfoFactory,
+		cgroupSubsystems:   cgroupSubsystems,
+		includedMetrics:    includedMetrics,
+	}
 	container.RegisterContainerHandlerFactory(factory, []watcher.ContainerWatchSource{watcher.Raw})
 	return nil
 }
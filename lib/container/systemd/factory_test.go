@@ -0,0 +1,80 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package systemd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/lib/container/raw"
+)
+
+func TestCanHandleAndAccept(t *testing.T) {
+	f := &systemdFactory{cgroupSubsystems: map[string]string{"memory": "/sys/fs/cgroup/memory"}}
+	check := func(name string, handle, accept bool) {
+		t.Helper()
+		canHandle, canAccept, err := f.CanHandleAndAccept(name)
+		assert.NoError(t, err)
+		assert.Equal(t, handle, canHandle, name)
+		assert.Equal(t, accept, canAccept, name)
+	}
+
+	// Services are left to the raw factory unless --systemd_units is set, but
+	// .mount cgroups are always ignored.
+	check("/system.slice/sshd.service", false, false)
+	check("/system.slice/var-lib-docker.mount", true, false)
+
+	*systemdUnits = true
+	defer func() { *systemdUnits = false }()
+	for _, tc := range []struct {
+		name      string
+		canHandle bool
+		canAccept bool
+	}{
+		{"/system.slice/sshd.service", true, true},
+		{"/system.slice/var-lib-docker.mount", true, false},
+		{"/system.slice/docker-0123456789ab.scope", false, false},
+		// Only top-level services are claimed.
+		{"/system.slice/foo.service/child.service", false, false},
+		{"/kubepods.slice/kubelet.service", false, false},
+		{"/user.slice/user-1000.slice/user@1000.service", false, false},
+		{"/user.slice/user-1000.slice/user@1000.service/app.slice/foo.service", false, false},
+		{"/kubepods.slice", false, false},
+		{"/", false, false},
+	} {
+		check(tc.name, tc.canHandle, tc.canAccept)
+	}
+
+	// User services need --systemd_user_units.
+	*systemdUserUnits = true
+	check("/user.slice/user-1000.slice/user@1000.service", true, true)
+	check("/user.slice/user-1000.slice/user@1000.service/app.slice/foo.service", false, false)
+	check("/user.slice/other.service", false, false)
+	*systemdUserUnits = false
+
+	// Services are left to the raw factory with --docker_only ...
+	*raw.DockerOnly = true
+	canHandle, _, _ := f.CanHandleAndAccept("/system.slice/sshd.service")
+	assert.False(t, canHandle)
+	*raw.DockerOnly = false
+
+	// ... and when no cgroup mounts were found.
+	f.cgroupSubsystems = nil
+	canHandle, _, _ = f.CanHandleAndAccept("/system.slice/sshd.service")
+	assert.False(t, canHandle)
+}
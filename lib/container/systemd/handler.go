@@ -0,0 +1,220 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+// Handler for systemd units.
+package systemd
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/opencontainers/cgroups"
+
+	"github.com/google/cadvisor/lib/container"
+	"github.com/google/cadvisor/lib/container/common"
+	"github.com/google/cadvisor/lib/container/libcontainer"
+	info "github.com/google/cadvisor/lib/model"
+
+	"k8s.io/klog/v2"
+)
+
+// The namespace under which unit names are unique.
+const SystemdNamespace = "systemd"
+
+// Labels set on the containers of systemd units.
+const (
+	UnitLabel        = "systemd.unit"
+	SliceLabel       = "systemd.slice"
+	DescriptionLabel = "systemd.description"
+)
+
+type systemdContainerHandler struct {
+	client unitClient
+	// Name of the container for this handler.
+	name string
+	// Name of the unit, the last element of name.
+	unit string
+
+	machineInfoFactory info.MachineInfoFactory
+
+	// Absolute path to the cgroup hierarchies of this container.
+	// (e.g.: "cpu" -> "/sys/fs/cgroup/cpu/system.slice/sshd.service")
+	cgroupPaths map[string]string
+
+	// Labels describing the unit; these do not change while it exists.
+	labels map[string]string
+
+	includedMetrics container.MetricSet
+
+	libcontainerHandler *libcontainer.Handler
+}
+
+var _ container.ContainerHandler = &systemdContainerHandler{}
+
+func newSystemdContainerHandler(
+	client unitClient,
+	name string,
+	machineInfoFactory info.MachineInfoFactory,
+	cgroupSubsystems map[string]string,
+	inHostNamespace bool,
+	includedMetrics container.MetricSet,
+) (container.ContainerHandler, error) {
+	cgroupPaths := common.MakeCgroupPaths(cgroupSubsystems, name)
+
+	cgroupManager, err := libcontainer.NewCgroupManager(name, cgroupPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	rootFs := "/"
+	if !inHostNamespace {
+		rootFs = "/rootfs"
+	}
+
+	unit := path.Base(name)
+	unitSpec, err := client.UnitProperties(unit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get properties of unit %q: %v", unit, err)
+	}
+
+	labels := map[string]string{
+		UnitLabel:  unit,
+		SliceLabel: sliceOf(name, unitSpec),
+	}
+	if unitSpec.Description != "" {
+		labels[DescriptionLabel] = unitSpec.Description
+	}
+
+	return &systemdContainerHandler{
+		client:              client,
+		name:                name,
+		unit:                unit,
+		machineInfoFactory:  machineInfoFactory,
+		cgroupPaths:         cgroupPaths,
+		labels:              labels,
+		includedMetrics:     includedMetrics,
+		libcontainerHandler: libcontainer.NewHandler(cgroupManager, rootFs, 0, includedMetrics),
+	}, nil
+}
+
+// sliceOf returns the slice of the unit whose container is named name: the one
+// systemd reports, or else the parent cgroup.
+func sliceOf(name string, unitSpec *info.SystemdUnitSpec) string {
+	if unitSpec.Slice != "" {
+		return unitSpec.Slice
+	}
+	if parent := path.Base(path.Dir(name)); strings.HasSuffix(parent, ".slice") {
+		return parent
+	}
+	return ""
+}
+
+func (h *systemdContainerHandler) ContainerReference() (info.ContainerReference, error) {
+	return info.ContainerReference{
+		Name:      h.name,
+		Aliases:   []string{h.unit},
+		Namespace: SystemdNamespace,
+	}, nil
+}
+
+// Nothing to start up.
+func (h *systemdContainerHandler) Start() {}
+
+// Nothing to clean up.
+func (h *systemdContainerHandler) Cleanup() {}
+
+func (h *systemdContainerHandler) GetSpec() (info.ContainerSpec, error) {
+	const hasNetwork = false
+	const hasFilesystem = false
+	spec, err := common.GetSpec(h.cgroupPaths, h.machineInfoFactory, hasNetwork, hasFilesystem)
+	if err != nil {
+		return spec, err
+	}
+	spec.Labels = h.labels
+
+	// The unit's state changes while it runs, so it is looked up again, the
+	// client caching it for unitPropertiesTTL. Failing to do so, e.g. while
+	// the unit is being stopped, leaves the rest of the spec usable.
+	unitSpec, err := h.client.UnitProperties(h.unit)
+	if err != nil {
+		klog.V(4).Infof("Failed to get properties of unit %q: %v", h.unit, err)
+		return spec, nil
+	}
+	unitSpec.Slice = sliceOf(h.name, unitSpec)
+	spec.Systemd = unitSpec
+	if !unitSpec.ActiveSince.IsZero() {
+		spec.StartTime = unitSpec.ActiveSince
+	}
+	return spec, nil
+}
+
+func (h *systemdContainerHandler) GetStats() (*info.ContainerStats, error) {
+	stats, err := h.libcontainerHandler.GetStats()
+	if err != nil {
+		return stats, err
+	}
+	if h.includedMetrics.Has(container.DiskIOMetrics) {
+		mi, err := h.machineInfoFactory.GetMachineInfo()
+		if err != nil {
+			return stats, err
+		}
+		common.AssignDeviceNamesToDiskStats((*common.MachineInfoNamer)(mi), stats.DiskIo)
+	}
+	return stats, nil
+}
+
+func (h *systemdContainerHandler) ListContainers(listType container.ListType) ([]info.ContainerReference, error) {
+	// Units may have cgroups of their own below them, e.g. user@.service.
+	return common.ListContainers(h.name, h.cgroupPaths, listType)
+}
+
+func (h *systemdContainerHandler) ListProcesses(listType container.ListType) ([]int, error) {
+	return h.libcontainerHandler.GetProcesses()
+}
+
+func (h *systemdContainerHandler) GetCgroupPath(resource string) (string, error) {
+	var res string
+	if !cgroups.IsCgroup2UnifiedMode() {
+		res = resource
+	}
+	path, ok := h.cgroupPaths[res]
+	if !ok {
+		return "", fmt.Errorf("could not find path for resource %q for container %q", resource, h.name)
+	}
+	return path, nil
+}
+
+func (h *systemdContainerHandler) GetContainerLabels() map[string]string {
+	return h.labels
+}
+
+func (h *systemdContainerHandler) GetContainerIPAddress() string {
+	// Units share the host's network.
+	return "127.0.0.1"
+}
+
+func (h *systemdContainerHandler) Exists() bool {
+	return common.CgroupExists(h.cgroupPaths)
+}
+
+func (h *systemdContainerHandler) Type() container.ContainerType {
+	return container.ContainerTypeSystemd
+}
+
+func (h *systemdContainerHandler) GetExitCode() (int, error) {
+	return -1, fmt.Errorf("exit codes not available for systemd units")
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package systemd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/lib/container"
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// fakeUnitClient returns fixed unit metadata, standing in for D-Bus.
+type fakeUnitClient struct {
+	units map[string]*info.SystemdUnitSpec
+	// Number of calls to UnitProperties.
+	lookups int
+}
+
+func (c *fakeUnitClient) UnitProperties(unit string) (*info.SystemdUnitSpec, error) {
+	c.lookups++
+	spec, ok := c.units[unit]
+	if !ok {
+		return nil, fmt.Errorf("unit %s not loaded", unit)
+	}
+	copied := *spec
+	return &copied, nil
+}
+
+func TestHandler(t *testing.T) {
+	client := &fakeUnitClient{units: map[string]*info.SystemdUnitSpec{
+		"sshd.service": {
+			Unit:        "sshd.service",
+			Description: "OpenSSH server daemon",
+			ActiveState: "active",
+			SubState:    "running",
+			Restarts:    2,
+			MainPID:     1234,
+		},
+	}}
+
+	_, err := newSystemdContainerHandler(client, "/system.slice/missing.service", nil, nil, true, container.MetricSet{})
+	assert.ErrorContains(t, err, "missing.service")
+
+	handler, err := newSystemdContainerHandler(client, "/system.slice/sshd.service", nil, nil, true, container.MetricSet{})
+	require.NoError(t, err)
+	assert.Equal(t, container.ContainerTypeSystemd, handler.Type())
+
+	ref, err := handler.ContainerReference()
+	require.NoError(t, err)
+	assert.Equal(t, info.ContainerReference{
+		Name:      "/system.slice/sshd.service",
+		Aliases:   []string{"sshd.service"},
+		Namespace: SystemdNamespace,
+	}, ref)
+
+	// The slice comes from the cgroup path when systemd does not report it.
+	assert.Equal(t, map[string]string{
+		UnitLabel:        "sshd.service",
+		SliceLabel:       "system.slice",
+		DescriptionLabel: "OpenSSH server daemon",
+	}, handler.GetContainerLabels())
+}
+
+type machineInfo struct{}
+
+func (m machineInfo) GetMachineInfo() (*info.MachineInfo, error) {
+	return &info.MachineInfo{}, nil
+}
+
+func (m machineInfo) GetVersionInfo() (*info.VersionInfo, error) {
+	return &info.VersionInfo{}, nil
+}
+
+func TestGetSpecRefreshesUnit(t *testing.T) {
+	unit := &info.SystemdUnitSpec{Unit: "foo.service", ActiveState: "active", SubState: "running"}
+	client := &fakeUnitClient{units: map[string]*info.SystemdUnitSpec{"foo.service": unit}}
+	handler := &systemdContainerHandler{
+		client:             client,
+		name:               "/system.slice/foo.service",
+		unit:               "foo.service",
+		machineInfoFactory: machineInfo{},
+		labels:             map[string]string{UnitLabel: "foo.service"},
+	}
+
+	spec, err := handler.GetSpec()
+	require.NoError(t, err)
+	require.NotNil(t, spec.Systemd)
+	assert.Equal(t, "running", spec.Systemd.SubState)
+	assert.Equal(t, "system.slice", spec.Systemd.Slice)
+	assert.Equal(t, handler.labels, spec.Labels)
+
+	unit.SubState = "auto-restart"
+	unit.Restarts = 1
+	spec, err = handler.GetSpec()
+	require.NoError(t, err)
+	assert.Equal(t, "auto-restart", spec.Systemd.SubState)
+	assert.Equal(t, uint32(1), spec.Systemd.Restarts)
+
+	// A unit that can no longer be looked up still has a spec.
+	delete(client.units, "foo.service")
+	spec, err = handler.GetSpec()
+	require.NoError(t, err)
+	assert.Nil(t, spec.Systemd)
+}
@@ -28,6 +28,8 @@ import (
 
 type btrfsPlugin struct{}
 
+var _ fs.FsUsagePlugin = &btrfsPlugin{}
+
 // NewPlugin creates a new Btrfs filesystem plugin.
 func NewPlugin() fs.FsPlugin {
 	return &btrfsPlugin{}
@@ -56,14 +58,35 @@ func (p *btrfsPlugin) GetStats(device string, partition fs.PartitionInfo) (*fs.F
 		return nil, err
 	}
 
-	return &fs.FsStats{
+	stats := &fs.FsStats{
 		Capacity:   capacity,
 		Free:       free,
 		Available:  avail,
 		Inodes:     &inodes,
 		InodesFree: &inodesFree,
 		Type:       fs.VFS,
-	}, nil
+	}
+	fsid, err := FilesystemID(partition.Mountpoint)
+	if err == nil {
+		stats.Allocations, err = ReadAllocations(fsid)
+	}
+	if err != nil {
+		klog.V(4).Infof("Failed to get the allocations of btrfs filesystem %s: %v", device, err)
+	}
+	return stats, nil
+}
+
+// GetDirUsage reads the usage of subvolumes, such as the writable layers of
+// the btrfs storage driver of Docker and Podman, from their qgroup if quotas
+// are enabled. Their usage is the bytes they do not share with other
+// subvolumes, e.g. those written by a container on top of its image; qgroups
+// do not count inodes.
+func (p *btrfsPlugin) GetDirUsage(dir, device string, partition fs.PartitionInfo) (fs.UsageInfo, bool, error) {
+	usage, ok, err := SubvolumeUsage(dir)
+	if !ok || err != nil {
+		return fs.UsageInfo{}, false, err
+	}
+	return fs.UsageInfo{Bytes: usage.Exclusive, Qgroup: &usage}, true, nil
 }
 
 // ProcessMount handles Btrfs mount processing.
@@ -0,0 +1,176 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package btrfs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/google/cadvisor/lib/fs"
+)
+
+// Where the kernel describes mounted btrfs filesystems, by UUID.
+var sysfsBtrfsDir = "/sys/fs/btrfs"
+
+const (
+	// BTRFS_IOC_INO_LOOKUP, _IOWR(0x94, 18, struct btrfs_ioctl_ino_lookup_args).
+	btrfsIocInoLookup = 0xd0009412
+	// BTRFS_IOC_FS_INFO, _IOR(0x94, 31, struct btrfs_ioctl_fs_info_args).
+	btrfsIocFsInfo = 0x8400941f
+	// BTRFS_FIRST_FREE_OBJECTID, the inode of the root of every subvolume.
+	firstFreeObjectID = 256
+)
+
+// inoLookupArgs is struct btrfs_ioctl_ino_lookup_args of linux/btrfs.h.
+type inoLookupArgs struct {
+	treeID   uint64
+	objectID uint64
+	name     [4080]byte
+}
+
+// fsInfoArgs is struct btrfs_ioctl_fs_info_args of linux/btrfs.h, up to the
+// fields read.
+type fsInfoArgs struct {
+	maxID      uint64
+	numDevices uint64
+	fsid       [16]byte
+	rest       [992]byte
+}
+
+// SubvolumeUsage returns the usage of the subvolume whose root is dir from
+// its qgroup. It returns false if dir is not the root of a subvolume or quotas
+// are not enabled on its filesystem.
+func SubvolumeUsage(dir string) (fs.QgroupUsage, bool, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		return fs.QgroupUsage{}, false, err
+	}
+	defer f.Close()
+
+	var stat syscall.Stat_t
+	if err := syscall.Fstat(int(f.Fd()), &stat); err != nil {
+		return fs.QgroupUsage{}, false, err
+	}
+	if stat.Ino != firstFreeObjectID {
+		// The qgroup of the enclosing subvolume would account more than dir.
+		return fs.QgroupUsage{}, false, nil
+	}
+	fsid, err := filesystemID(f)
+	if err != nil {
+		return fs.QgroupUsage{}, false, err
+	}
+	// Looking up the root directory with no tree returns the subvolume of f.
+	args := inoLookupArgs{objectID: firstFreeObjectID}
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), btrfsIocInoLookup, uintptr(unsafe.Pointer(&args))); errno != 0 {
+		return fs.QgroupUsage{}, false, fmt.Errorf("failed to look up the subvolume of %q: %v", dir, errno)
+	}
+	return readQgroup(sysfsBtrfsDir, fsid, args.treeID)
+}
+
+// FilesystemID returns the UUID of the btrfs filesystem mounted at
+// mountpoint.
+func FilesystemID(mountpoint string) (string, error) {
+	f, err := os.Open(mountpoint)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return filesystemID(f)
+}
+
+func filesystemID(f *os.File) (string, error) {
+	var args fsInfoArgs
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), btrfsIocFsInfo, uintptr(unsafe.Pointer(&args))); errno != 0 {
+		return "", fmt.Errorf("failed to get the btrfs filesystem of %q: %v", f.Name(), errno)
+	}
+	id := args.fsid
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:16]), nil
+}
+
+// readQgroup reads the usage of the level 0 qgroup of subvolume id from the
+// sysfs directory of filesystem fsid, which has no qgroups if quotas are
+// disabled.
+func readQgroup(sysfsDir, fsid string, id uint64) (fs.QgroupUsage, bool, error) {
+	dir := filepath.Join(sysfsDir, fsid, "qgroups", fmt.Sprintf("0_%d", id))
+	referenced, err := readUint(filepath.Join(dir, "referenced"))
+	if errors.Is(err, os.ErrNotExist) {
+		return fs.QgroupUsage{}, false, nil
+	}
+	if err != nil {
+		return fs.QgroupUsage{}, false, err
+	}
+	exclusive, err := readUint(filepath.Join(dir, "exclusive"))
+	if err != nil {
+		return fs.QgroupUsage{}, false, err
+	}
+	return fs.QgroupUsage{Referenced: referenced, Exclusive: exclusive}, true, nil
+}
+
+// Types of block groups, which btrfs allocates and replicates separately.
+var blockGroupTypes = []string{"data", "metadata", "system"}
+
+// ReadAllocations returns how filesystem fsid allocates its space to each
+// type of block group, for each RAID profile in use.
+func ReadAllocations(fsid string) ([]fs.Allocation, error) {
+	return readAllocations(sysfsBtrfsDir, fsid)
+}
+
+func readAllocations(sysfsDir, fsid string) ([]fs.Allocation, error) {
+	var allocations []fs.Allocation
+	for _, groupType := range blockGroupTypes {
+		dir := filepath.Join(sysfsDir, fsid, "allocation", groupType)
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		var profiles []string
+		for _, entry := range entries {
+			// Profiles, e.g. "single" or "raid1", are the subdirectories.
+			if entry.IsDir() {
+				profiles = append(profiles, entry.Name())
+			}
+		}
+		sort.Strings(profiles)
+		for _, profile := range profiles {
+			total, err := readUint(filepath.Join(dir, profile, "total_bytes"))
+			if err != nil {
+				return nil, err
+			}
+			used, err := readUint(filepath.Join(dir, profile, "used_bytes"))
+			if err != nil {
+				return nil, err
+			}
+			allocations = append(allocations, fs.Allocation{Type: groupType, Profile: profile, Total: total, Used: used})
+		}
+	}
+	return allocations, nil
+}
+
+func readUint(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package btrfs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/lib/fs"
+)
+
+const testFsid = "0f8b2e3c-5a6d-4e7f-8a9b-0c1d2e3f4a5b"
+
+func writeSysfs(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, testFsid, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+func TestIoctlStructSizes(t *testing.T) {
+	// The sizes the kernel expects, which the ioctl numbers encode.
+	assert.Equal(t, uintptr(4096), unsafe.Sizeof(inoLookupArgs{}))
+	assert.Equal(t, uintptr(1024), unsafe.Sizeof(fsInfoArgs{}))
+}
+
+func TestReadQgroup(t *testing.T) {
+	dir := t.TempDir()
+	writeSysfs(t, dir, map[string]string{
+		"qgroups/0_258/referenced": "1048576\n",
+		"qgroups/0_258/exclusive":  "16384\n",
+	})
+
+	usage, ok, err := readQgroup(dir, testFsid, 258)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, fs.QgroupUsage{Referenced: 1048576, Exclusive: 16384}, usage)
+
+	// Subvolumes created before quotas were enabled have no qgroup.
+	_, ok, err = readQgroup(dir, testFsid, 259)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestReadAllocations(t *testing.T) {
+	dir := t.TempDir()
+	writeSysfs(t, dir, map[string]string{
+		"allocation/data/single/total_bytes": "8589934592\n",
+		"allocation/data/single/used_bytes":  "4294967296\n",
+		"allocation/data/raid1/total_bytes":  "1073741824\n",
+		"allocation/data/raid1/used_bytes":   "536870912\n",
+		// Files aside from the profiles are ignored.
+		"allocation/data/total_bytes":         "9663676416\n",
+		"allocation/metadata/dup/total_bytes": "536870912\n",
+		"allocation/metadata/dup/used_bytes":  "104857600\n",
+		"allocation/system/dup/total_bytes":   "8388608\n",
+		"allocation/system/dup/used_bytes":    "16384\n",
+		"allocation/global_rsv_size":          "5242880\n",
+	})
+
+	allocations, err := readAllocations(dir, testFsid)
+	require.NoError(t, err)
+	assert.Equal(t, []fs.Allocation{
+		{Type: "data", Profile: "raid1", Total: 1073741824, Used: 536870912},
+		{Type: "data", Profile: "single", Total: 8589934592, Used: 4294967296},
+		{Type: "metadata", Profile: "dup", Total: 536870912, Used: 104857600},
+		{Type: "system", Profile: "dup", Total: 8388608, Used: 16384},
+	}, allocations)
+}
@@ -19,7 +19,6 @@ package fs
 
 import (
 	"bufio"
-	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -28,6 +27,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	mount "github.com/moby/sys/mountinfo"
 
@@ -44,27 +44,10 @@ const (
 const (
 	// The block size in bytes.
 	statBlockSize uint64 = 512
-	// The maximum number of `disk usage` tasks that can be running at once.
+	// The maximum number of `disk usage` walks that can be running at once.
 	maxConcurrentOps = 20
 )
 
-// A pool for restricting the number of consecutive `du` and `find` tasks running.
-var pool = make(chan struct{}, maxConcurrentOps)
-
-func init() {
-	for i := 0; i < maxConcurrentOps; i++ {
-		releaseToken()
-	}
-}
-
-func claimToken() {
-	<-pool
-}
-
-func releaseToken() {
-	pool <- struct{}{}
-}
-
 type partition struct {
 	mountpoint string
 	major      uint
@@ -344,6 +327,7 @@ func (i *RealFsInfo) GetFsInfoForPath(mountSet map[string]struct{}) ([]Fs, error
 	}
 	// statsCache stores cached filesystem stats by cache key for plugins that implement FsCachingPlugin
 	statsCache := make(map[string]Fs)
+	batches := make(statsBatches)
 	for device, partition := range i.partitions {
 		_, hasMount := mountSet[partition.mountpoint]
 		_, hasDevice := deviceSet[device]
@@ -398,19 +382,10 @@ func (i *RealFsInfo) GetFsInfoForPath(mountSet map[string]struct{}) ([]Fs, error
 				}
 			}
 
-			stats, statsErr := plugin.GetStats(device, partInfo)
+			stats, statsErr := getPluginStats(plugin, device, partInfo, batches)
 			if statsErr != nil {
-				// Handle fallback to VFS for plugins that request it
-				if errors.Is(statsErr, ErrFallbackToVFS) {
-					vfsPlugin := GetPluginForFsType("ext4") // VFS handles ext*
-					if vfsPlugin != nil {
-						stats, statsErr = vfsPlugin.GetStats(device, partInfo)
-					}
-				}
-				if statsErr != nil {
-					klog.V(4).Infof("Stat fs failed for %s. Error: %v", partition.fsType, statsErr)
-					continue
-				}
+				klog.V(4).Infof("Stat fs failed for %s. Error: %v", partition.fsType, statsErr)
+				continue
 			}
 
 			if stats == nil {
@@ -424,6 +399,8 @@ func (i *RealFsInfo) GetFsInfoForPath(mountSet map[string]struct{}) ([]Fs, error
 			fs.Inodes = stats.Inodes
 			fs.InodesFree = stats.InodesFree
 			fs.Type = stats.Type
+			fs.Allocations = stats.Allocations
+			fs.Nfs = stats.Nfs
 
 			// Store in cache if plugin supports caching
 			if cacheKey != "" {
@@ -527,6 +504,16 @@ func getDiskStatsMap(diskStatsFile string) (map[string]DiskStats, error) {
 			Major:           major64,
 			Minor:           minor64,
 		}
+		if len(stats) >= 15 {
+			diskStats.DiscardsCompleted = stats[11]
+			diskStats.DiscardsMerged = stats[12]
+			diskStats.SectorsDiscarded = stats[13]
+			diskStats.DiscardTime = stats[14]
+		}
+		if len(stats) >= 17 {
+			diskStats.FlushesCompleted = stats[15]
+			diskStats.FlushTime = stats[16]
+		}
 		diskStatsMap[deviceName] = diskStats
 	}
 	return diskStatsMap, nil
@@ -610,72 +597,162 @@ func (i *RealFsInfo) GetDirFsDevice(dir string) (*DeviceInfo, error) {
 }
 
 func GetDirUsage(dir string) (UsageInfo, error) {
-	var usage UsageInfo
+	w, err := newDirWalk(dir, func() {})
+	if err != nil {
+		return UsageInfo{}, err
+	}
+	if _, err := w.step(func() {}, time.Time{}); err != nil {
+		return w.usage, err
+	}
+	return w.usage, nil
+}
 
+// dirWalk is a walk measuring the usage of a directory. It can stop between
+// the directories it reads and resume later where it stopped.
+type dirWalk struct {
+	dir     string
+	rootDev uint64
+	// Directories left to read.
+	pending []string
+	// dedupedInodes stores inodes that could be duplicates (nlink > 1)
+	dedupedInodes map[uint64]struct{}
+	usage         UsageInfo
+}
+
+// newDirWalk starts a walk of dir, calling visit before visiting dir itself.
+func newDirWalk(dir string, visit func()) (*dirWalk, error) {
 	if dir == "" {
-		return usage, fmt.Errorf("invalid directory")
+		return nil, fmt.Errorf("invalid directory")
 	}
 
-	rootInfo, err := os.Stat(dir)
+	visit()
+	rootInfo, err := os.Lstat(dir)
 	if err != nil {
-		return usage, fmt.Errorf("could not stat %q to get inode usage: %v", dir, err)
+		return nil, fmt.Errorf("could not stat %q to get inode usage: %v", dir, err)
 	}
 
 	rootStat, ok := rootInfo.Sys().(*syscall.Stat_t)
 	if !ok {
-		return usage, fmt.Errorf("unsupported fileinfo for getting inode usage of %q", dir)
+		return nil, fmt.Errorf("unsupported fileinfo for getting inode usage of %q", dir)
 	}
 
-	rootDevID := rootStat.Dev
+	w := &dirWalk{
+		dir:           dir,
+		rootDev:       rootStat.Dev,
+		dedupedInodes: make(map[uint64]struct{}),
+	}
+	w.account(rootStat)
+	if rootInfo.IsDir() {
+		w.pending = []string{dir}
+	}
+	return w, nil
+}
 
-	// dedupedInode stores inodes that could be duplicates (nlink > 1)
-	dedupedInodes := make(map[uint64]struct{})
+// step goes on with the walk, calling visit before visiting each file, until
+// it completes or, once a directory is read, deadline passes. A zero deadline
+// does not stop it. It returns whether the walk completed.
+func (w *dirWalk) step(visit func(), deadline time.Time) (bool, error) {
+	for len(w.pending) > 0 {
+		dir := w.pending[len(w.pending)-1]
+		w.pending = w.pending[:len(w.pending)-1]
 
-	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		entries, err := os.ReadDir(dir)
 		if os.IsNotExist(err) {
 			// expected if files appear/vanish
-			return nil
+			continue
 		}
 		if err != nil {
-			return fmt.Errorf("unable to count inodes for part of dir %s: %s", dir, err)
+			return false, fmt.Errorf("unable to count inodes for part of dir %s: %s", w.dir, err)
 		}
+		for _, entry := range entries {
+			visit()
+			info, err := entry.Info()
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return false, fmt.Errorf("unable to count inodes for part of dir %s: %s", w.dir, err)
+			}
 
-		// according to the docs, Sys can be nil
-		if info.Sys() == nil {
-			return fmt.Errorf("fileinfo Sys is nil")
+			s, ok := info.Sys().(*syscall.Stat_t)
+			if !ok {
+				return false, fmt.Errorf("unsupported fileinfo; could not convert to stat_t")
+			}
+			if s.Dev != w.rootDev {
+				// don't descend into directories on other devices
+				continue
+			}
+			w.account(s)
+			if info.IsDir() {
+				w.pending = append(w.pending, filepath.Join(dir, entry.Name()))
+			}
 		}
 
-		s, ok := info.Sys().(*syscall.Stat_t)
-		if !ok {
-			return fmt.Errorf("unsupported fileinfo; could not convert to stat_t")
+		if !deadline.IsZero() && len(w.pending) > 0 && time.Now().After(deadline) {
+			return false, nil
 		}
+	}
+	return true, nil
+}
 
-		if s.Dev != rootDevID {
-			// don't descend into directories on other devices
-			return filepath.SkipDir
-		}
-		if s.Nlink > 1 {
-			if _, ok := dedupedInodes[s.Ino]; !ok {
-				// Dedupe things that could be hardlinks
-				dedupedInodes[s.Ino] = struct{}{}
+func (w *dirWalk) measured() UsageInfo {
+	return w.usage
+}
 
-				usage.Bytes += uint64(s.Blocks) * statBlockSize
-				usage.Inodes++
-			}
-		} else {
-			usage.Bytes += uint64(s.Blocks) * statBlockSize
-			usage.Inodes++
+func (w *dirWalk) account(s *syscall.Stat_t) {
+	if s.Nlink > 1 {
+		if _, ok := w.dedupedInodes[s.Ino]; ok {
+			return
 		}
-		return nil
-	})
-
-	return usage, err
+		// Dedupe things that could be hardlinks
+		w.dedupedInodes[s.Ino] = struct{}{}
+	}
+	w.usage.Bytes += uint64(s.Blocks) * statBlockSize
+	w.usage.Inodes++
 }
 
+var _ FsInfoWithLimit = &RealFsInfo{}
+
 func (i *RealFsInfo) GetDirUsage(dir string) (UsageInfo, error) {
-	claimToken()
-	defer releaseToken()
-	return GetDirUsage(dir)
+	return i.GetDirUsageWithLimit(dir, 0)
+}
+
+// GetDirUsageWithLimit returns the usage of dir. Walks of directories whose
+// previous usage is close to limit go first; zero is no limit.
+func (i *RealFsInfo) GetDirUsageWithLimit(dir string, limit uint64) (UsageInfo, error) {
+	if usage, ok := i.getPluginDirUsage(dir); ok {
+		return usage, nil
+	}
+	return getScheduler().getDirUsage(dir, limit)
+}
+
+// getPluginDirUsage returns the usage of dir as accounted by the plugin of
+// the filesystem it resides on, if that plugin accounts it.
+func (i *RealFsInfo) getPluginDirUsage(dir string) (UsageInfo, bool) {
+	device, err := i.GetDirFsDevice(dir)
+	if err != nil {
+		return UsageInfo{}, false
+	}
+	partition, found := i.partitions[device.Device]
+	if !found {
+		return UsageInfo{}, false
+	}
+	plugin, ok := GetPluginForFsType(partition.fsType).(FsUsagePlugin)
+	if !ok {
+		return UsageInfo{}, false
+	}
+	usage, ok, err := plugin.GetDirUsage(dir, device.Device, PartitionInfo{
+		Mountpoint: partition.mountpoint,
+		Major:      partition.major,
+		Minor:      partition.minor,
+		FsType:     partition.fsType,
+		BlockSize:  partition.blockSize,
+	})
+	if err != nil {
+		klog.V(4).Infof("%s: failed to get the usage of %q, walking it instead: %v", plugin.Name(), dir, err)
+		return UsageInfo{}, false
+	}
+	return usage, ok
 }
 
 // Get major and minor Ids for a mount point using btrfs as filesystem.
@@ -21,6 +21,7 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"syscall"
 	"testing"
 
 	mount "github.com/moby/sys/mountinfo"
@@ -192,6 +193,24 @@ func TestGetDiskStatsMapMajorMinorNum(t *testing.T) {
 	}
 }
 
+func TestGetDiskStatsMapDiscardsAndFlushes(t *testing.T) {
+	diskStatsMap, err := getDiskStatsMap("test_resources/diskstats_discard")
+	require.NoError(t, err)
+	sda := diskStatsMap["/dev/sda"]
+	assert.Equal(t, uint64(1400), sda.WeightedIoTime)
+	assert.Equal(t, uint64(30), sda.DiscardsCompleted)
+	assert.Equal(t, uint64(3), sda.DiscardsMerged)
+	assert.Equal(t, uint64(4096), sda.SectorsDiscarded)
+	assert.Equal(t, uint64(60), sda.DiscardTime)
+	assert.Equal(t, uint64(40), sda.FlushesCompleted)
+	assert.Equal(t, uint64(80), sda.FlushTime)
+	// Kernels before 5.5 report discards but not flushes.
+	sdb := diskStatsMap["/dev/sdb"]
+	assert.Equal(t, uint64(5), sdb.DiscardsCompleted)
+	assert.Equal(t, uint64(7), sdb.DiscardTime)
+	assert.Equal(t, uint64(0), sdb.FlushesCompleted)
+}
+
 func TestFileNotExist(t *testing.T) {
 	_, err := getDiskStatsMap("/file_does_not_exist")
 	if err != nil {
@@ -557,3 +576,102 @@ func TestProcessMounts(t *testing.T) {
 		}
 	}
 }
+
+// testUsagePlugin accounts the usage of the directories it knows on its own.
+type testUsagePlugin struct {
+	testPlugin
+	usage map[string]UsageInfo
+}
+
+func (p *testUsagePlugin) GetDirUsage(dir, device string, partition PartitionInfo) (UsageInfo, bool, error) {
+	usage, ok := p.usage[dir]
+	return usage, ok, nil
+}
+
+func TestPluginDirUsage(t *testing.T) {
+	projectDir, otherDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(otherDir+"/file", make([]byte, 4096), 0600))
+	require.NoError(t, RegisterPlugin("test-quota", &testUsagePlugin{
+		testPlugin: testPlugin{
+			name:      "test-quota",
+			canHandle: func(fsType string) bool { return fsType == "quotafs" },
+		},
+		usage: map[string]UsageInfo{projectDir: {Bytes: 1 << 30, Inodes: 42}},
+	}))
+
+	var stat syscall.Stat_t
+	require.NoError(t, syscall.Stat(projectDir, &stat))
+	fsInfo := &RealFsInfo{partitions: map[string]partition{
+		"/dev/quota": {fsType: "quotafs", major: major(stat.Dev), minor: minor(stat.Dev)},
+	}}
+
+	usage, err := fsInfo.GetDirUsage(projectDir)
+	require.NoError(t, err)
+	assert.Equal(t, UsageInfo{Bytes: 1 << 30, Inodes: 42}, usage)
+
+	// Directories the plugin does not account are walked.
+	usage, err = fsInfo.GetDirUsage(otherDir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), usage.Inodes)
+}
+
+type testStatsPlugin struct {
+	testPlugin
+	stated []string
+}
+
+func (p *testStatsPlugin) GetStats(device string, partition PartitionInfo) (*FsStats, error) {
+	p.stated = append(p.stated, partition.Mountpoint)
+	return &FsStats{Capacity: 100, Free: 40, Available: 30, Type: VFS}, nil
+}
+
+func TestGetMountStats(t *testing.T) {
+	plugin := &testStatsPlugin{testPlugin: testPlugin{
+		name:      "test-stats",
+		canHandle: func(fsType string) bool { return fsType == "statsfs" },
+	}}
+	require.NoError(t, RegisterPlugin("test-stats", plugin))
+
+	stats := GetMountStats("/proc/42/root", []*mount.Info{
+		{Mountpoint: "/data", Major: 8, Minor: 1, FSType: "statsfs", Source: "/dev/sda1"},
+		// A second volume of the same filesystem is not stat'ed again.
+		{Mountpoint: "/cache", Major: 8, Minor: 1, FSType: "statsfs", Source: "/dev/sda1"},
+		{Mountpoint: "/logs", Major: 8, Minor: 2, FSType: "statsfs", Source: "/dev/sda2"},
+		{Mountpoint: "/run/secrets", Major: 0, Minor: 40, FSType: "unknownfs", Source: "none"},
+	})
+	assert.Equal(t, []string{"/proc/42/root/data", "/proc/42/root/logs"}, plugin.stated)
+	assert.Len(t, stats, 3)
+	assert.Equal(t, uint64(100), stats["/cache"].Capacity)
+	assert.NotContains(t, stats, "/run/secrets")
+}
+
+type testBatchPlugin struct {
+	testStatsPlugin
+	batches int
+}
+
+func (p *testBatchPlugin) NewBatch() func(device string, partition PartitionInfo) (*FsStats, error) {
+	p.batches++
+	return p.GetStats
+}
+
+func TestGetMountStatsBatch(t *testing.T) {
+	plugin := &testBatchPlugin{testStatsPlugin: testStatsPlugin{testPlugin: testPlugin{
+		name:      "test-batch",
+		canHandle: func(fsType string) bool { return fsType == "batchfs" },
+	}}}
+	require.NoError(t, RegisterPlugin("test-batch", plugin))
+
+	// The partitions of a collection share a batch.
+	stats := GetMountStats("/proc/42/root", []*mount.Info{
+		{Mountpoint: "/a", Major: 0, Minor: 50, FSType: "batchfs", Source: "server:/a"},
+		{Mountpoint: "/b", Major: 0, Minor: 51, FSType: "batchfs", Source: "server:/b"},
+	})
+	assert.Len(t, stats, 2)
+	assert.Equal(t, 1, plugin.batches)
+
+	GetMountStats("/proc/43/root", []*mount.Info{
+		{Mountpoint: "/a", Major: 0, Minor: 52, FSType: "batchfs", Source: "server:/a"},
+	})
+	assert.Equal(t, 2, plugin.batches)
+}
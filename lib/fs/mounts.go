@@ -0,0 +1,72 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs
+
+import (
+	"fmt"
+	"path/filepath"
+
+	mount "github.com/moby/sys/mountinfo"
+	"k8s.io/klog/v2"
+)
+
+// GetMountStats returns the stats of the filesystems of mounts, by
+// mountpoint. The mountpoints are relative to root, e.g. /proc/<pid>/root for
+// the mounts of the mount namespace of another process. Mounts no plugin
+// handles are skipped, and the stats of mounts of the same filesystem, as
+// told by the cache key of their plugin or else their device numbers, are
+// fetched once.
+func GetMountStats(root string, mounts []*mount.Info) map[string]*FsStats {
+	result := make(map[string]*FsStats, len(mounts))
+	statsCache := make(map[string]*FsStats)
+	batches := make(statsBatches)
+	for _, mnt := range mounts {
+		plugin := GetPluginForFsType(mnt.FSType)
+		if plugin == nil {
+			continue
+		}
+		partInfo := PartitionInfo{
+			Mountpoint: filepath.Join(root, mnt.Mountpoint),
+			Major:      uint(mnt.Major),
+			Minor:      uint(mnt.Minor),
+			FsType:     mnt.FSType,
+		}
+
+		cacheKey := fmt.Sprintf("%d:%d", mnt.Major, mnt.Minor)
+		if cachingPlugin, ok := plugin.(FsCachingPlugin); ok {
+			cacheKey = cachingPlugin.CacheKey(partInfo)
+		}
+		if cacheKey != "" {
+			if stats, found := statsCache[cacheKey]; found {
+				result[mnt.Mountpoint] = stats
+				continue
+			}
+		}
+
+		stats, err := getPluginStats(plugin, mnt.Source, partInfo, batches)
+		if err != nil {
+			klog.V(4).Infof("Stat fs failed for %s mounted at %s. Error: %v", mnt.FSType, partInfo.Mountpoint, err)
+			continue
+		}
+		if stats == nil {
+			continue
+		}
+		if cacheKey != "" {
+			statsCache[cacheKey] = stats
+		}
+		result[mnt.Mountpoint] = stats
+	}
+	return result
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nfs
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/google/cadvisor/lib/fs"
+)
+
+// Overridable for tests.
+var mountStatsPath = "/proc/self/mountstats"
+
+// MountStats are the client statistics of an NFS mount.
+type MountStats struct {
+	Device     string
+	Mountpoint string
+	FsType     string
+	Stats      fs.NfsStats
+}
+
+// ReadMountStats reads the statistics of the NFS mounts of a mountstats file,
+// e.g. /proc/<pid>/mountstats for the mount namespace of a process.
+func ReadMountStats(path string) ([]MountStats, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseMountStats(f)
+}
+
+// ByMountpoint indexes mounts by their mountpoint.
+func ByMountpoint(mounts []MountStats) map[string]*MountStats {
+	byMountpoint := make(map[string]*MountStats, len(mounts))
+	for i := range mounts {
+		byMountpoint[mounts[i].Mountpoint] = &mounts[i]
+	}
+	return byMountpoint
+}
+
+// parseMountStats parses the NFS mounts of mountstats, skipping the mounts of
+// other filesystems, which have no statistics.
+func parseMountStats(r io.Reader) ([]MountStats, error) {
+	var (
+		mounts  []MountStats
+		current *MountStats
+		inOps   bool
+	)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "device" {
+			// device <device> mounted on <mountpoint> with fstype <type> [statvers=<version>]
+			if current != nil {
+				mounts = append(mounts, *current)
+			}
+			current, inOps = nil, false
+			if len(fields) < 8 || fields[2] != "mounted" || fields[6] != "fstype" {
+				return nil, fmt.Errorf("unexpected mountstats line %q", line)
+			}
+			if strings.HasPrefix(fields[7], "nfs") && len(fields) > 8 {
+				current = &MountStats{Device: fields[1], Mountpoint: unescape(fields[4]), FsType: fields[7]}
+				current.Stats.Server, current.Stats.Export = splitDevice(fields[1])
+			}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		switch {
+		case fields[0] == "bytes:":
+			// normal read, normal write, direct read, direct write, server
+			// read and server write bytes, then read and write pages.
+			values, err := parseUints(fields[1:], 6)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %q: %v", line, err)
+			}
+			current.Stats.ReadBytes, current.Stats.WriteBytes = values[4], values[5]
+		case strings.TrimSpace(line) == "per-op statistics":
+			inOps = true
+		case inOps && strings.HasSuffix(fields[0], ":"):
+			// operations, transmissions, major timeouts, bytes sent,
+			// bytes received, queue, RTT and execute milliseconds, and
+			// errors since 5.3.
+			values, err := parseUints(fields[1:], 8)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %q: %v", line, err)
+			}
+			if values[0] == 0 {
+				continue
+			}
+			current.Stats.Ops = append(current.Stats.Ops, fs.NfsOpStats{
+				Op:            strings.TrimSuffix(fields[0], ":"),
+				Requests:      values[0],
+				Transmissions: values[1],
+				Rtt:           values[6],
+				Execute:       values[7],
+			})
+		}
+	}
+	if current != nil {
+		mounts = append(mounts, *current)
+	}
+	return mounts, scanner.Err()
+}
+
+func parseUints(fields []string, want int) ([]uint64, error) {
+	if len(fields) < want {
+		return nil, fmt.Errorf("want %d values, got %d", want, len(fields))
+	}
+	values := make([]uint64, want)
+	for i := range values {
+		v, err := strconv.ParseUint(fields[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// splitDevice splits an NFS device, e.g. "server:/export" or
+// "[fd00::1]:/export", into its server and export.
+func splitDevice(device string) (string, string) {
+	i := strings.Index(device, ":/")
+	if i < 0 {
+		return device, ""
+	}
+	return strings.Trim(device[:i], "[]"), device[i+1:]
+}
+
+// unescape decodes the octal escapes of the spaces, tabs, newlines and
+// backslashes in a mountpoint.
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+4 <= len(s) {
+			if v, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
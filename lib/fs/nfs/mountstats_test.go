@@ -0,0 +1,91 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nfs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/lib/fs"
+)
+
+func TestReadMountStats(t *testing.T) {
+	mounts, err := ReadMountStats("testdata/mountstats")
+	require.NoError(t, err)
+	assert.Equal(t, []MountStats{
+		{
+			Device:     "192.168.1.10:/srv/data",
+			Mountpoint: "/mnt/my data",
+			FsType:     "nfs4",
+			Stats: fs.NfsStats{
+				Server:     "192.168.1.10",
+				Export:     "/srv/data",
+				ReadBytes:  1052672,
+				WriteBytes: 4096,
+				Ops: []fs.NfsOpStats{
+					{Op: "NULL", Requests: 1, Transmissions: 1},
+					{Op: "READ", Requests: 40, Transmissions: 42, Rtt: 120, Execute: 125},
+					{Op: "WRITE", Requests: 2, Transmissions: 2, Rtt: 8, Execute: 9},
+					{Op: "GETATTR", Requests: 105, Transmissions: 105, Rtt: 42, Execute: 45},
+				},
+			},
+		},
+		{
+			Device:     "[fd00::1]:/export",
+			Mountpoint: "/mnt/v6",
+			FsType:     "nfs",
+			Stats: fs.NfsStats{
+				Server:     "fd00::1",
+				Export:     "/export",
+				ReadBytes:  10,
+				WriteBytes: 20,
+				Ops:        []fs.NfsOpStats{{Op: "READ", Requests: 3, Transmissions: 3, Rtt: 1, Execute: 2}},
+			},
+		},
+	}, mounts)
+}
+
+func TestParseMountStatsMalformed(t *testing.T) {
+	_, err := parseMountStats(strings.NewReader("device a:/b mounted on /c with fstype nfs statvers=1.1\n\tbytes:\t1 2\n"))
+	assert.Error(t, err)
+}
+
+func TestNfsStatsLookup(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mountstats")
+	data, err := os.ReadFile("testdata/mountstats")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	nfsStatsOf := newNfsStatsLookup(path)
+	stats, err := nfsStatsOf("/mnt/v6")
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+	assert.Equal(t, "fd00::1", stats.Server)
+
+	// mountstats is only parsed by the first lookup.
+	require.NoError(t, os.Remove(path))
+	stats, err = nfsStatsOf("/boot")
+	require.NoError(t, err)
+	assert.Nil(t, stats)
+
+	_, err = newNfsStatsLookup(path)("/mnt/v6")
+	assert.Error(t, err)
+}
@@ -54,21 +54,62 @@ func (p *nfsPlugin) Priority() int {
 // GetStats returns filesystem statistics for NFS.
 // NFS uses VFS stats.
 func (p *nfsPlugin) GetStats(device string, partition fs.PartitionInfo) (*fs.FsStats, error) {
-	capacity, free, avail, inodes, inodesFree, err := vfs.GetVfsStats(partition.Mountpoint)
-	if err != nil {
-		klog.V(4).Infof("the file system type is %s, partition mountpoint does not exist: %v, error: %v",
-			partition.FsType, partition.Mountpoint, err)
-		return nil, err
+	return p.NewBatch()(device, partition)
+}
+
+// NewBatch implements fs.FsBatchPlugin: the client statistics of all the NFS
+// partitions of a collection are parsed from mountstats once.
+func (p *nfsPlugin) NewBatch() func(device string, partition fs.PartitionInfo) (*fs.FsStats, error) {
+	nfsStatsOf := newNfsStatsLookup(mountStatsPath)
+	return func(device string, partition fs.PartitionInfo) (*fs.FsStats, error) {
+		capacity, free, avail, inodes, inodesFree, err := vfs.GetVfsStats(partition.Mountpoint)
+		if err != nil {
+			klog.V(4).Infof("the file system type is %s, partition mountpoint does not exist: %v, error: %v",
+				partition.FsType, partition.Mountpoint, err)
+			return nil, err
+		}
+
+		nfsStats, err := nfsStatsOf(partition.Mountpoint)
+		if err != nil {
+			klog.V(4).Infof("unable to get nfs stats of %s: %v", partition.Mountpoint, err)
+		}
+
+		return &fs.FsStats{
+			Capacity:   capacity,
+			Free:       free,
+			Available:  avail,
+			Inodes:     &inodes,
+			InodesFree: &inodesFree,
+			Type:       fs.VFS,
+			Nfs:        nfsStats,
+		}, nil
 	}
+}
 
-	return &fs.FsStats{
-		Capacity:   capacity,
-		Free:       free,
-		Available:  avail,
-		Inodes:     &inodes,
-		InodesFree: &inodesFree,
-		Type:       fs.VFS,
-	}, nil
+// newNfsStatsLookup returns a function returning the client statistics of the
+// NFS mount at a mountpoint, or nil if the kernel reports none. The mountstats
+// file at path is parsed on the first lookup only.
+func newNfsStatsLookup(path string) func(mountpoint string) (*fs.NfsStats, error) {
+	var (
+		parsed bool
+		mounts map[string]*MountStats
+		err    error
+	)
+	return func(mountpoint string) (*fs.NfsStats, error) {
+		if !parsed {
+			parsed = true
+			var all []MountStats
+			all, err = ReadMountStats(path)
+			mounts = ByMountpoint(all)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if mount, ok := mounts[mountpoint]; ok {
+			return &mount.Stats, nil
+		}
+		return nil, nil
+	}
 }
 
 // ProcessMount handles NFS mount processing.
@@ -0,0 +1,140 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package overlay
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+
+	mount "github.com/moby/sys/mountinfo"
+)
+
+// ArgCopyUpRateThreshold is the growth rate of the upper layer of a container
+// above which it is flagged as copying up heavily.
+var ArgCopyUpRateThreshold = flag.Uint64("overlay_copy_up_rate_threshold", 1<<20, "Growth of the upper layer of the overlay root filesystem of a container, in bytes per second, above which the container is flagged as copying up heavily from its lower layers. Zero disables the flag")
+
+// Layers are the directories an overlay mount is made of.
+type Layers struct {
+	// Lower layers, from the uppermost down, including data-only layers.
+	Lower []string
+	// Upper layer, which receives the writes; empty if the mount is
+	// read-only.
+	Upper string
+	// Work directory of the upper layer.
+	Work string
+}
+
+// ParseLayers returns the layers given by the lowerdir, upperdir and workdir
+// options of an overlay mount, such as the super options of its mountinfo
+// entry.
+func ParseLayers(options string) Layers {
+	var layers Layers
+	for _, option := range splitEscaped(options, ',') {
+		key, value, _ := strings.Cut(option, "=")
+		switch key {
+		case "lowerdir":
+			// Layers after "::" are data-only, with no metadata of their own.
+			for _, dir := range splitEscaped(value, ':') {
+				if dir != "" {
+					layers.Lower = append(layers.Lower, unescapeOption(dir))
+				}
+			}
+		case "lowerdir+", "datadir+":
+			layers.Lower = append(layers.Lower, unescapeOption(value))
+		case "upperdir":
+			layers.Upper = unescapeOption(value)
+		case "workdir":
+			layers.Work = unescapeOption(value)
+		}
+	}
+	return layers
+}
+
+// Resolve returns the layers with their absolute directories under rootFs,
+// where the host filesystem is mounted, and their relative ones under base,
+// the directory of the storage driver that mounted the overlay, as runtimes
+// do when the absolute options would be too long.
+func (l Layers) Resolve(rootFs, base string) Layers {
+	resolve := func(dir string) string {
+		if dir == "" {
+			return ""
+		}
+		if filepath.IsAbs(dir) {
+			return filepath.Join(rootFs, dir)
+		}
+		return filepath.Join(base, dir)
+	}
+	resolved := Layers{Upper: resolve(l.Upper), Work: resolve(l.Work)}
+	for _, dir := range l.Lower {
+		resolved.Lower = append(resolved.Lower, resolve(dir))
+	}
+	return resolved
+}
+
+// ReadRootLayers returns the layers of the overlay mounted at the root of the
+// mount namespace of the process whose proc directory is procDir, as container
+// runtimes mount the root filesystem of containers. It returns false if that
+// root is not an overlay.
+func ReadRootLayers(procDir string) (Layers, bool, error) {
+	f, err := os.Open(filepath.Join(procDir, "mountinfo"))
+	if err != nil {
+		return Layers{}, false, err
+	}
+	defer f.Close()
+	mounts, err := mount.GetMountsFromReader(f, mount.SingleEntryFilter("/"))
+	if err != nil {
+		return Layers{}, false, err
+	}
+	if len(mounts) == 0 || mounts[0].FSType != "overlay" {
+		return Layers{}, false, nil
+	}
+	return ParseLayers(mounts[0].VFSOptions), true, nil
+}
+
+// splitEscaped splits s around the separators not escaped by a backslash,
+// which the kernel allows in the directories of overlay options.
+func splitEscaped(s string, sep byte) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// unescapeOption removes the backslashes escaping characters of a directory.
+func unescapeOption(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package overlay
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLayers(t *testing.T) {
+	for _, test := range []struct {
+		options  string
+		expected Layers
+	}{
+		{
+			options: "rw,lowerdir=/l/a:/l/b,upperdir=/c/diff,workdir=/c/work,index=off",
+			expected: Layers{
+				Lower: []string{"/l/a", "/l/b"},
+				Upper: "/c/diff",
+				Work:  "/c/work",
+			},
+		},
+		// Relative directories, as runtimes mount with when the absolute
+		// ones are too long.
+		{
+			options:  "rw,lowerdir=l/a:l/b,upperdir=c/diff,workdir=c/work",
+			expected: Layers{Lower: []string{"l/a", "l/b"}, Upper: "c/diff", Work: "c/work"},
+		},
+		// Read-only, with data-only layers.
+		{
+			options:  "ro,lowerdir=/l/a::/d/b::/d/c",
+			expected: Layers{Lower: []string{"/l/a", "/d/b", "/d/c"}},
+		},
+		// Layers appended one option at a time.
+		{
+			options:  "rw,lowerdir+=/l/a,lowerdir+=/l/b,datadir+=/d/c,upperdir=/c/diff,workdir=/c/work",
+			expected: Layers{Lower: []string{"/l/a", "/l/b", "/d/c"}, Upper: "/c/diff", Work: "/c/work"},
+		},
+		// Escaped separators.
+		{
+			options:  `rw,lowerdir=/l/a\:1:/l/b\,2,upperdir=/c/diff`,
+			expected: Layers{Lower: []string{"/l/a:1", "/l/b,2"}, Upper: "/c/diff"},
+		},
+	} {
+		assert.Equal(t, test.expected, ParseLayers(test.options), test.options)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	layers := Layers{Lower: []string{"/l/a", "l/b"}, Upper: "c/diff"}
+	assert.Equal(t, Layers{
+		Lower: []string{"/rootfs/l/a", "/rootfs/var/lib/docker/overlay2/l/b"},
+		Upper: "/rootfs/var/lib/docker/overlay2/c/diff",
+	}, layers.Resolve("/rootfs", "/rootfs/var/lib/docker/overlay2"))
+}
+
+func TestReadRootLayers(t *testing.T) {
+	layers, ok, err := ReadRootLayers("testdata")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, Layers{
+		Lower: []string{"/var/lib/docker/overlay2/l/AAA", "/var/lib/docker/overlay2/l/BBB"},
+		Upper: "/var/lib/docker/overlay2/abc/diff",
+		Work:  "/var/lib/docker/overlay2/abc/work",
+	}, layers)
+
+	procDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(procDir, "mountinfo"), []byte("1 0 8:1 / / rw - ext4 /dev/sda1 rw\n"), 0o644))
+	_, ok, err = ReadRootLayers(procDir)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
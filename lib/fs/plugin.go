@@ -56,6 +56,29 @@ type FsCachingPlugin interface {
 	CacheKey(partition PartitionInfo) string
 }
 
+// FsUsagePlugin is an optional interface for plugins that can tell the usage
+// of a directory from filesystem metadata, e.g. the quota of the project the
+// directory belongs to, rather than by walking it.
+type FsUsagePlugin interface {
+	FsPlugin
+
+	// GetDirUsage returns the usage of dir, which resides on the partition
+	// of the given device. It returns false if the filesystem does not
+	// account dir on its own, in which case dir is walked instead.
+	GetDirUsage(dir, device string, partition PartitionInfo) (UsageInfo, bool, error)
+}
+
+// FsBatchPlugin is an optional interface for plugins that read the stats of
+// all their partitions from one source, e.g. /proc/self/mountstats for NFS,
+// which is then read once per collection rather than once per partition.
+type FsBatchPlugin interface {
+	FsPlugin
+
+	// NewBatch returns the GetStats of a single collection, which reads the
+	// shared source on first use and reuses it for the other partitions.
+	NewBatch() func(device string, partition PartitionInfo) (*FsStats, error)
+}
+
 // PartitionInfo contains information needed for stats collection.
 type PartitionInfo struct {
 	Mountpoint string
@@ -73,12 +96,47 @@ type FsStats struct {
 	Inodes     *uint64
 	InodesFree *uint64
 	Type       FsType
+	// How the filesystem allocates its space, if it reports it.
+	Allocations []Allocation
+	// Client statistics, for NFS mounts.
+	Nfs *NfsStats
 }
 
 // ErrFallbackToVFS signals that a specialized plugin cannot handle
 // this filesystem and VFS should be used instead.
 var ErrFallbackToVFS = errors.New("fallback to VFS")
 
+// statsBatches are the batches of the FsBatchPlugins of a collection.
+type statsBatches map[FsPlugin]func(device string, partition PartitionInfo) (*FsStats, error)
+
+// getStats returns the stats of a partition from plugin, within the batch of
+// the collection if the plugin is an FsBatchPlugin.
+func (b statsBatches) getStats(plugin FsPlugin, device string, partition PartitionInfo) (*FsStats, error) {
+	batchPlugin, ok := plugin.(FsBatchPlugin)
+	if !ok {
+		return plugin.GetStats(device, partition)
+	}
+	getStats, ok := b[plugin]
+	if !ok {
+		getStats = batchPlugin.NewBatch()
+		b[plugin] = getStats
+	}
+	return getStats(device, partition)
+}
+
+// getPluginStats returns the stats of a partition from plugin, or from VFS
+// if the plugin cannot handle it.
+func getPluginStats(plugin FsPlugin, device string, partition PartitionInfo, batches statsBatches) (*FsStats, error) {
+	stats, err := batches.getStats(plugin, device, partition)
+	if errors.Is(err, ErrFallbackToVFS) {
+		vfsPlugin := GetPluginForFsType("ext4") // VFS handles ext*
+		if vfsPlugin != nil {
+			return vfsPlugin.GetStats(device, partition)
+		}
+	}
+	return stats, err
+}
+
 // Plugin registry (init-time registration only).
 var (
 	pluginsLock sync.RWMutex
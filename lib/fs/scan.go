@@ -0,0 +1,393 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package fs
+
+import (
+	"container/heap"
+	"flag"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+var (
+	// ArgDuFilesPerSecond is the I/O budget of the walks measuring the disk
+	// usage of directories.
+	ArgDuFilesPerSecond = flag.Uint64("du_files_per_second", 0, "Maximum number of files per second all the walks measuring the disk usage of directories, such as the writable layers of containers, may visit together. Zero does not limit them")
+	// ArgDuScanWait is how long a walk runs before stopping to resume later.
+	ArgDuScanWait = flag.Duration("du_scan_wait", 10*time.Second, "How long the walk measuring the disk usage of a directory runs at a time. A walk taking longer stops, the usage measured by its previous walk is reported, and it resumes where it stopped the next time the usage of the directory is requested")
+)
+
+const (
+	// Directories whose previous usage is above this ratio of their limit are
+	// walked first.
+	nearLimitRatio = 0.9
+	// How long the usage of a directory no longer requested is remembered.
+	scanResultExpiry = time.Hour
+	// Most walks stopped before completing kept to resume. Walks stopping
+	// beyond it start over, until parked walks complete.
+	maxParkedWalks = 64
+)
+
+// Upper bounds, in seconds, of the buckets of the latencies of walks.
+var scanLatencyBuckets = []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300}
+
+// The scheduler of the walks of all the FsInfos, which share the I/O budget.
+var (
+	scheduler     *scanScheduler
+	schedulerOnce sync.Once
+)
+
+func getScheduler() *scanScheduler {
+	schedulerOnce.Do(func() {
+		// Created on first use, once flags are parsed.
+		scheduler = newScanScheduler(maxConcurrentOps, *ArgDuFilesPerSecond, *ArgDuScanWait)
+	})
+	return scheduler
+}
+
+// GetScanStats returns the stats of the walks measuring the disk usage of
+// directories.
+func GetScanStats() ScanStats {
+	return getScheduler().stats()
+}
+
+// scanScheduler runs the walks measuring the disk usage of directories on a
+// fixed number of workers, sharing a budget of files visited per second.
+// Directories close to the limit of their container are walked first, then
+// those whose usage was measured least recently. A walk requested while one of
+// the same directory is queued or running joins it. Walks run for the wait at
+// a time, so that a slow walk does not hold a worker: one measuring a
+// directory for the first time is queued again, and one of a directory with a
+// previous usage is saved to resume at the next request.
+type scanScheduler struct {
+	sync.Mutex
+	// Signals the workers that walks are queued.
+	cond    *sync.Cond
+	queue   scanQueue
+	workers int
+	running int
+	// Walks queued or running, by directory.
+	scans map[string]*dirScan
+	// Usage measured by the last walk of each directory.
+	results map[string]*scanResult
+	// Number of results with a walk to resume.
+	parked    int
+	lastSweep time.Time
+	wait      time.Duration
+	budget    *fileBudget
+	started   bool
+
+	files          atomic.Uint64
+	latencyCount   uint64
+	latencySum     float64
+	latencyBuckets []uint64
+
+	// Starts a walk of dir; newDirWalk but for tests.
+	newWalk func(dir string, visit func()) (resumableWalk, error)
+}
+
+// resumableWalk is a walk measuring the usage of a directory that can stop
+// and resume later, as dirWalk.
+type resumableWalk interface {
+	// step goes on with the walk until it completes or deadline passes,
+	// returning whether it completed.
+	step(visit func(), deadline time.Time) (bool, error)
+	// measured returns the usage measured so far.
+	measured() UsageInfo
+}
+
+// dirScan is a walk of a directory, queued or running.
+type dirScan struct {
+	dir       string
+	nearLimit bool
+	// When the previous walk of dir completed, zero if there is none.
+	lastDone time.Time
+	queued   time.Time
+	// When the walk started, before stopping to resume if it did.
+	started time.Time
+	// Walk to resume, nil to start one.
+	walk resumableWalk
+	// Closed once the walk completed, with its usage or error.
+	done  chan struct{}
+	usage UsageInfo
+	err   error
+}
+
+type scanResult struct {
+	usage     UsageInfo
+	done      time.Time
+	requested time.Time
+	// Walk of the directory stopped before completing, resumed by the next
+	// request, and when it started.
+	walk        resumableWalk
+	walkStarted time.Time
+}
+
+func newScanScheduler(workers int, filesPerSecond uint64, wait time.Duration) *scanScheduler {
+	s := &scanScheduler{
+		workers:        workers,
+		scans:          make(map[string]*dirScan),
+		results:        make(map[string]*scanResult),
+		wait:           wait,
+		latencyBuckets: make([]uint64, len(scanLatencyBuckets)),
+		newWalk: func(dir string, visit func()) (resumableWalk, error) {
+			return newDirWalk(dir, visit)
+		},
+	}
+	if filesPerSecond > 0 {
+		s.budget = newFileBudget(filesPerSecond)
+	}
+	s.cond = sync.NewCond(&s.Mutex)
+	return s
+}
+
+// getDirUsage returns the usage of dir, walked first if its previous usage
+// is close to limit. It waits for the walk of dir to complete if its usage was
+// never measured, and otherwise returns the usage measured by the previous
+// walk if the walk stops before completing or is not run within the wait.
+func (s *scanScheduler) getDirUsage(dir string, limit uint64) (UsageInfo, error) {
+	now := time.Now()
+
+	s.Lock()
+	if !s.started {
+		for i := 0; i < s.workers; i++ {
+			go s.work()
+		}
+		s.started = true
+	}
+	s.sweep(now)
+	result := s.results[dir]
+	if result != nil {
+		result.requested = now
+	}
+	scan, ok := s.scans[dir]
+	if !ok {
+		scan = &dirScan{dir: dir, queued: now, started: now, done: make(chan struct{})}
+		if result != nil {
+			scan.lastDone = result.done
+			scan.nearLimit = limit > 0 && float64(result.usage.Bytes) > nearLimitRatio*float64(limit)
+			if result.walk != nil {
+				scan.walk, scan.started = result.walk, result.walkStarted
+				result.walk = nil
+				s.parked--
+			}
+		}
+		heap.Push(&s.queue, scan)
+		s.scans[dir] = scan
+		s.cond.Signal()
+	}
+	s.Unlock()
+
+	if result == nil {
+		<-scan.done
+		return scan.usage, scan.err
+	}
+	timer := time.NewTimer(s.wait)
+	defer timer.Stop()
+	select {
+	case <-scan.done:
+		return scan.usage, scan.err
+	case <-timer.C:
+		klog.V(4).Infof("fs: walk of %q did not complete within %v, reporting its usage as of %v", dir, s.wait, result.done)
+		return result.usage, nil
+	}
+}
+
+// work runs the queued walks, most urgent first, each for the wait at most.
+func (s *scanScheduler) work() {
+	s.Lock()
+	defer s.Unlock()
+	for {
+		for s.queue.Len() == 0 {
+			s.cond.Wait()
+		}
+		scan := heap.Pop(&s.queue).(*dirScan)
+		s.running++
+		s.Unlock()
+
+		var (
+			done bool
+			err  error
+		)
+		walk := scan.walk
+		if walk == nil {
+			walk, err = s.newWalk(scan.dir, s.visit)
+		}
+		if err == nil {
+			done, err = walk.step(s.visit, time.Now().Add(s.wait))
+		}
+
+		s.Lock()
+		now := time.Now()
+		s.running--
+		result := s.results[scan.dir]
+		if err == nil && !done {
+			if result == nil {
+				// Measured for the first time, resumed after the walks
+				// queued meanwhile had their turn.
+				scan.walk = walk
+				scan.queued = now
+				heap.Push(&s.queue, scan)
+				s.cond.Signal()
+				continue
+			}
+			if s.parked < maxParkedWalks {
+				klog.V(4).Infof("fs: walk of %q stopped after %v, reporting its usage as of %v until it completes", scan.dir, s.wait, result.done)
+				result.walk, result.walkStarted = walk, scan.started
+				s.parked++
+			} else {
+				klog.V(4).Infof("fs: walk of %q stopped after %v and %d walks are already kept to resume, starting it over next time", scan.dir, s.wait, s.parked)
+			}
+			delete(s.scans, scan.dir)
+			scan.usage = result.usage
+			close(scan.done)
+			continue
+		}
+		delete(s.scans, scan.dir)
+		scan.err = err
+		if err == nil {
+			scan.usage = walk.measured()
+			s.results[scan.dir] = &scanResult{usage: scan.usage, done: now, requested: now}
+		}
+		s.observe(now.Sub(scan.started))
+		close(scan.done)
+	}
+}
+
+// visit accounts a file visited by a walk, waiting for the budget to allow
+// it.
+func (s *scanScheduler) visit() {
+	s.files.Add(1)
+	if s.budget != nil {
+		time.Sleep(s.budget.reserve(time.Now()))
+	}
+}
+
+// observe accounts the latency of a walk. Must be called with the lock held.
+func (s *scanScheduler) observe(latency time.Duration) {
+	seconds := latency.Seconds()
+	s.latencyCount++
+	s.latencySum += seconds
+	for i, bound := range scanLatencyBuckets {
+		if seconds <= bound {
+			s.latencyBuckets[i]++
+		}
+	}
+}
+
+// sweep forgets the usage of the directories no longer requested, such as
+// those of removed containers. Must be called with the lock held.
+func (s *scanScheduler) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < scanResultExpiry {
+		return
+	}
+	for dir, result := range s.results {
+		if now.Sub(result.requested) > scanResultExpiry {
+			if result.walk != nil {
+				s.parked--
+			}
+			delete(s.results, dir)
+		}
+	}
+	s.lastSweep = now
+}
+
+func (s *scanScheduler) stats() ScanStats {
+	s.Lock()
+	defer s.Unlock()
+	stats := ScanStats{
+		Queued:         s.queue.Len(),
+		Running:        s.running,
+		Files:          s.files.Load(),
+		LatencyCount:   s.latencyCount,
+		LatencySum:     s.latencySum,
+		LatencyBuckets: make(map[float64]uint64, len(scanLatencyBuckets)),
+	}
+	now := time.Now()
+	for _, scan := range s.queue {
+		if waiting := now.Sub(scan.queued); waiting > stats.OldestQueued {
+			stats.OldestQueued = waiting
+		}
+	}
+	for i, bound := range scanLatencyBuckets {
+		stats.LatencyBuckets[bound] = s.latencyBuckets[i]
+	}
+	return stats
+}
+
+// scanQueue is a heap of the queued walks, the most urgent first.
+type scanQueue []*dirScan
+
+func (q scanQueue) Len() int { return len(q) }
+
+func (q scanQueue) Less(i, j int) bool {
+	if q[i].nearLimit != q[j].nearLimit {
+		return q[i].nearLimit
+	}
+	if !q[i].lastDone.Equal(q[j].lastDone) {
+		return q[i].lastDone.Before(q[j].lastDone)
+	}
+	return q[i].queued.Before(q[j].queued)
+}
+
+func (q scanQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *scanQueue) Push(x any) { *q = append(*q, x.(*dirScan)) }
+
+func (q *scanQueue) Pop() any {
+	old := *q
+	n := len(old)
+	scan := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return scan
+}
+
+// fileBudget is a token bucket of files that walks may visit, refilled at a
+// fixed rate up to one second's worth.
+type fileBudget struct {
+	sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newFileBudget(filesPerSecond uint64) *fileBudget {
+	return &fileBudget{rate: float64(filesPerSecond), tokens: float64(filesPerSecond)}
+}
+
+// reserve takes a file from the budget at now and returns how long to wait
+// before visiting it.
+func (b *fileBudget) reserve(now time.Time) time.Duration {
+	b.Lock()
+	defer b.Unlock()
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.rate {
+			b.tokens = b.rate
+		}
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
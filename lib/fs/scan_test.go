@@ -0,0 +1,254 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileBudget(t *testing.T) {
+	b := newFileBudget(2)
+	start := time.Now()
+	assert.Equal(t, time.Duration(0), b.reserve(start))
+	assert.Equal(t, time.Duration(0), b.reserve(start))
+	assert.Equal(t, 500*time.Millisecond, b.reserve(start))
+	assert.Equal(t, time.Second, b.reserve(start))
+	// Refilled at 2 files per second, less the files already reserved.
+	assert.Equal(t, time.Duration(0), b.reserve(start.Add(1500*time.Millisecond)))
+	assert.Equal(t, 500*time.Millisecond, b.reserve(start.Add(1500*time.Millisecond)))
+}
+
+// blockingWalk walks directories in the order requested, each walk waiting
+// to be released.
+type blockingWalk struct {
+	sync.Mutex
+	walked  []string
+	release chan struct{}
+}
+
+func (w *blockingWalk) newWalk(dir string, visit func()) (resumableWalk, error) {
+	w.Lock()
+	w.walked = append(w.walked, dir)
+	w.Unlock()
+	visit()
+	return &fakeWalk{steps: 1, bytesPerStep: 1, release: w.release}, nil
+}
+
+func (w *blockingWalk) dirs() []string {
+	w.Lock()
+	defer w.Unlock()
+	return append([]string(nil), w.walked...)
+}
+
+// fakeWalk completes after a number of steps, each measuring some bytes.
+type fakeWalk struct {
+	steps        int
+	taken        int
+	bytesPerStep uint64
+	release      chan struct{}
+}
+
+func (w *fakeWalk) step(visit func(), deadline time.Time) (bool, error) {
+	if w.release != nil {
+		<-w.release
+	}
+	w.taken++
+	return w.taken == w.steps, nil
+}
+
+func (w *fakeWalk) measured() UsageInfo {
+	return UsageInfo{Bytes: uint64(w.taken) * w.bytesPerStep}
+}
+
+func TestScanSchedulerPriority(t *testing.T) {
+	w := &blockingWalk{release: make(chan struct{})}
+	s := newScanScheduler(1, 0, time.Hour)
+	s.newWalk = w.newWalk
+	// Both directories were measured before, with a limit of 100 bytes.
+	s.results["/full"] = &scanResult{usage: UsageInfo{Bytes: 95}, done: time.Now(), requested: time.Now()}
+	s.results["/normal"] = &scanResult{usage: UsageInfo{Bytes: 10}, done: time.Now().Add(-time.Minute), requested: time.Now()}
+
+	var wg sync.WaitGroup
+	request := func(dir string, limit uint64) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.getDirUsage(dir, limit)
+			assert.NoError(t, err)
+		}()
+	}
+	// The only worker is busy with the first walk while the others queue.
+	request("/busy", 0)
+	require.Eventually(t, func() bool { return s.stats().Running == 1 }, time.Second, time.Millisecond)
+	request("/normal", 100)
+	request("/full", 100)
+	request("/full", 100)
+	require.Eventually(t, func() bool { return s.stats().Queued == 2 }, time.Second, time.Millisecond)
+	close(w.release)
+	wg.Wait()
+
+	// The walk of the directory close to its limit goes first, though the
+	// other was measured less recently, and requests of the same directory
+	// share it.
+	assert.Equal(t, []string{"/busy", "/full", "/normal"}, w.dirs())
+	stats := s.stats()
+	assert.Equal(t, uint64(3), stats.LatencyCount)
+	assert.Equal(t, uint64(3), stats.Files)
+	assert.Equal(t, 0, stats.Queued)
+}
+
+func TestScanSchedulerResumesWalk(t *testing.T) {
+	var walks []*fakeWalk
+	bytesPerStep := uint64(1)
+	s := newScanScheduler(1, 0, time.Hour)
+	s.newWalk = func(dir string, visit func()) (resumableWalk, error) {
+		walk := &fakeWalk{steps: 3, bytesPerStep: bytesPerStep}
+		walks = append(walks, walk)
+		return walk, nil
+	}
+
+	// With no previous usage, the walk is resumed until it completes.
+	usage, err := s.getDirUsage("/dir", 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), usage.Bytes)
+	assert.Len(t, walks, 1)
+
+	// With a previous usage, a walk that stops reports it and resumes at the
+	// next request, until it completes.
+	bytesPerStep = 2
+	for _, want := range []uint64{3, 3, 6} {
+		usage, err := s.getDirUsage("/dir", 0)
+		require.NoError(t, err)
+		assert.Equal(t, want, usage.Bytes)
+	}
+	assert.Len(t, walks, 2)
+	assert.Equal(t, uint64(2), s.stats().LatencyCount)
+}
+
+func TestScanSchedulerInterleavesFirstWalks(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		steps []string
+	)
+	release := make(chan struct{})
+	s := newScanScheduler(1, 0, time.Hour)
+	s.newWalk = func(dir string, visit func()) (resumableWalk, error) {
+		walk := &fakeWalk{steps: 1, bytesPerStep: 1}
+		if dir == "/slow" {
+			walk.steps = 3
+			walk.release = release
+		}
+		return &recordingWalk{fakeWalk: walk, record: func() {
+			mu.Lock()
+			defer mu.Unlock()
+			steps = append(steps, dir)
+		}}, nil
+	}
+
+	var wg sync.WaitGroup
+	for _, dir := range []string{"/slow", "/fast"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.getDirUsage(dir, 0)
+			assert.NoError(t, err)
+		}()
+		// The slow walk runs its first step before the fast one is queued.
+		require.Eventually(t, func() bool { return s.stats().Running == 1 }, time.Second, time.Millisecond)
+	}
+	require.Eventually(t, func() bool { return s.stats().Queued == 1 }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// The stopped walk of the slow directory lets the fast one run before it
+	// resumes.
+	assert.Equal(t, []string{"/slow", "/fast", "/slow", "/slow"}, steps)
+}
+
+// recordingWalk is a fakeWalk that records its steps.
+type recordingWalk struct {
+	*fakeWalk
+	record func()
+}
+
+func (w *recordingWalk) step(visit func(), deadline time.Time) (bool, error) {
+	w.record()
+	return w.fakeWalk.step(visit, deadline)
+}
+
+func TestScanSchedulerParkedWalksLimit(t *testing.T) {
+	s := newScanScheduler(1, 0, time.Hour)
+	s.newWalk = func(dir string, visit func()) (resumableWalk, error) {
+		return &fakeWalk{steps: 2, bytesPerStep: 1}, nil
+	}
+	dirs := make([]string, maxParkedWalks+1)
+	for i := range dirs {
+		dirs[i] = fmt.Sprintf("/dir%d", i)
+		s.results[dirs[i]] = &scanResult{done: time.Now(), requested: time.Now()}
+	}
+
+	// Walks stop after their first step, and all but the last are kept.
+	for _, dir := range dirs {
+		_, err := s.getDirUsage(dir, 0)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, maxParkedWalks, s.parked)
+	assert.Nil(t, s.results[dirs[maxParkedWalks]].walk)
+
+	// A kept walk resumes and completes, the other starts over.
+	usage, err := s.getDirUsage(dirs[0], 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), usage.Bytes)
+	usage, err = s.getDirUsage(dirs[maxParkedWalks], 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), usage.Bytes)
+	assert.Equal(t, maxParkedWalks, s.parked)
+}
+
+func TestDirWalkResumes(t *testing.T) {
+	dir := t.TempDir()
+	for _, sub := range []string{"a/b", "a/c", "d"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, sub), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, sub, "file"), []byte("data"), 0o644))
+	}
+	want, err := GetDirUsage(dir)
+	require.NoError(t, err)
+
+	w, err := newDirWalk(dir, func() {})
+	require.NoError(t, err)
+	// A past deadline stops the walk after each directory it reads.
+	steps := 0
+	for {
+		steps++
+		done, err := w.step(func() {}, time.Now().Add(-time.Second))
+		require.NoError(t, err)
+		if done {
+			break
+		}
+	}
+	assert.Equal(t, 5, steps)
+	assert.Equal(t, want, w.measured())
+	assert.Equal(t, uint64(8), want.Inodes)
+}
@@ -16,6 +16,7 @@ package fs
 
 import (
 	"errors"
+	"time"
 )
 
 type Context struct {
@@ -71,6 +72,45 @@ type Fs struct {
 	Inodes     *uint64
 	InodesFree *uint64
 	DiskStats  DiskStats
+	// How the filesystem allocates its space, if it reports it.
+	Allocations []Allocation
+	// Client statistics, for NFS mounts.
+	Nfs *NfsStats
+}
+
+// Allocation is the space a filesystem allocated to a type of block groups
+// replicated with a given profile, e.g. the "data" block groups of btrfs
+// mirrored with "raid1".
+type Allocation struct {
+	Type    string
+	Profile string
+	// Bytes allocated to the block groups, and bytes used within them.
+	Total uint64
+	Used  uint64
+}
+
+// NfsStats are the client statistics of an NFS mount, from mountstats.
+type NfsStats struct {
+	Server string
+	Export string
+	// Bytes read from and written to the server.
+	ReadBytes  uint64
+	WriteBytes uint64
+	// Statistics of each type of RPC the client sent at least once.
+	Ops []NfsOpStats
+}
+
+// NfsOpStats are the statistics of one type of NFS RPC, e.g. "READ".
+type NfsOpStats struct {
+	Op string
+	// Number of requests, and of times they were transmitted, including
+	// retransmissions.
+	Requests      uint64
+	Transmissions uint64
+	// Cumulative milliseconds between sending the requests and receiving
+	// the replies, and between queueing them and their completion.
+	Rtt     uint64
+	Execute uint64
 }
 
 type DiskStats struct {
@@ -89,11 +129,48 @@ type DiskStats struct {
 	WeightedIoTime  uint64
 	Major           uint64
 	Minor           uint64
+	// Discards, from Linux 4.18, and flushes, from Linux 5.5; zero on
+	// earlier kernels.
+	DiscardsCompleted uint64
+	DiscardsMerged    uint64
+	SectorsDiscarded  uint64
+	DiscardTime       uint64
+	FlushesCompleted  uint64
+	FlushTime         uint64
 }
 
 type UsageInfo struct {
 	Bytes  uint64
 	Inodes uint64
+	// Usage of the directory as accounted by its btrfs qgroup, if it is the
+	// root of a subvolume with one.
+	Qgroup *QgroupUsage
+}
+
+// QgroupUsage is the usage of a btrfs subvolume, as accounted by its qgroup.
+type QgroupUsage struct {
+	// Bytes of the extents the subvolume refers to.
+	Referenced uint64
+	// Bytes of the extents no other subvolume refers to.
+	Exclusive uint64
+}
+
+// ScanStats describes the walks measuring the disk usage of directories.
+type ScanStats struct {
+	// Number of walks waiting for a worker.
+	Queued int
+	// Number of walks in progress.
+	Running int
+	// How long the walk queued first has been waiting.
+	OldestQueued time.Duration
+	// Number of files visited by walks.
+	Files uint64
+	// Number of walks completed, and their total seconds from being
+	// requested to completing.
+	LatencyCount uint64
+	LatencySum   float64
+	// Cumulative number of walks completed, by upper bound of their seconds.
+	LatencyBuckets map[float64]uint64
 }
 
 var (
@@ -131,3 +208,14 @@ type FsInfo interface {
 	// Returns the mountpoint associated with a particular device.
 	GetMountpointForDevice(device string) (string, error)
 }
+
+// FsInfoWithLimit is an FsInfo that measures the directories close to their
+// limit first.
+type FsInfoWithLimit interface {
+	FsInfo
+
+	// GetDirUsageWithLimit returns a usage information for 'dir', which may
+	// use up to limit bytes, such as the size limit of the writable layer of
+	// its container, or zero if it has none.
+	GetDirUsageWithLimit(dir string, limit uint64) (UsageInfo, error)
+}
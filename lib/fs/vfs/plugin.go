@@ -28,6 +28,8 @@ import (
 
 type vfsPlugin struct{}
 
+var _ fs.FsUsagePlugin = &vfsPlugin{}
+
 // NewPlugin creates a new VFS filesystem plugin.
 func NewPlugin() fs.FsPlugin {
 	return &vfsPlugin{}
@@ -95,3 +97,12 @@ func (p *vfsPlugin) GetStats(device string, partition fs.PartitionInfo) (*fs.FsS
 func (p *vfsPlugin) ProcessMount(mnt *mount.Info) (bool, *mount.Info, error) {
 	return true, mnt, nil
 }
+
+// GetDirUsage reads the usage of directories with a project ID on XFS and
+// ext4 from the quota of their project.
+func (p *vfsPlugin) GetDirUsage(dir, device string, partition fs.PartitionInfo) (fs.UsageInfo, bool, error) {
+	if !*ArgProjectQuotaUsage || (partition.FsType != "xfs" && partition.FsType != "ext4") {
+		return fs.UsageInfo{}, false, nil
+	}
+	return GetProjectUsage(dir, device)
+}
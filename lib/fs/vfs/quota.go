@@ -0,0 +1,141 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package vfs
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+
+	"github.com/google/cadvisor/lib/fs"
+)
+
+// ArgProjectQuotaUsage enables reading directory usage from project quotas.
+var ArgProjectQuotaUsage = flag.Bool("project_quota_usage", true, "Read the disk usage of directories with an XFS or ext4 project ID their parent does not share, as the kubelet assigns to emptyDir volumes, from the quota of their project instead of walking them. Requires the filesystem to be mounted with project quotas (prjquota)")
+
+const (
+	// FS_IOC_FSGETXATTR, _IOR('X', 31, struct fsxattr).
+	fsIocFsgetxattr = 0x801c581f
+	// Q_GETQUOTA, for PRJQUOTA quotas.
+	qGetQuota = 0x800007
+	prjQuota  = 2
+	// quotactl_fd(2), Linux 5.14 and later, numbered alike on every
+	// architecture.
+	sysQuotactlFd = 443
+)
+
+// fsxattr is struct fsxattr of linux/fs.h.
+type fsxattr struct {
+	xflags     uint32
+	extsize    uint32
+	nextents   uint32
+	projid     uint32
+	cowextsize uint32
+	pad        [8]byte
+}
+
+// dqblk is struct if_dqblk of linux/quota.h.
+type dqblk struct {
+	bhardlimit uint64
+	bsoftlimit uint64
+	curspace   uint64
+	ihardlimit uint64
+	isoftlimit uint64
+	curinodes  uint64
+	btime      uint64
+	itime      uint64
+	valid      uint32
+}
+
+// qcmd is QCMD of linux/quota.h.
+func qcmd(cmd, quotaType uintptr) uintptr {
+	return cmd<<8 | quotaType&0xff
+}
+
+// GetProjectUsage returns the bytes and inodes used by the project dir
+// belongs to, on the filesystem of the given block device, from its project
+// quota. It returns false if dir has no project ID, if the filesystem does not
+// support them, or if dir is not the top of its project: project IDs are
+// inherited, so the quota of a project a subdirectory shares with its parent,
+// such as the upper dir of an overlay whose layer directory has the project,
+// accounts more than the subdirectory.
+func GetProjectUsage(dir, device string) (fs.UsageInfo, bool, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		return fs.UsageInfo{}, false, err
+	}
+	defer f.Close()
+
+	id, err := getProjectID(f)
+	if err != nil || id == 0 {
+		return fs.UsageInfo{}, false, err
+	}
+	parent, err := os.Open(filepath.Dir(dir))
+	if err != nil {
+		return fs.UsageInfo{}, false, err
+	}
+	parentID, err := getProjectID(parent)
+	parent.Close()
+	if err != nil || parentID == id {
+		return fs.UsageInfo{}, false, err
+	}
+
+	var quota dqblk
+	if err := getProjectQuota(f, device, id, &quota); err != nil {
+		return fs.UsageInfo{}, false, fmt.Errorf("failed to get the quota of project %d of %q: %v", id, dir, err)
+	}
+	return fs.UsageInfo{Bytes: quota.curspace, Inodes: quota.curinodes}, true, nil
+}
+
+// getProjectID returns the project ID of the open file f, zero if it has none
+// or its filesystem does not support them; a variable for tests.
+var getProjectID = func(f *os.File) (uint32, error) {
+	var attr fsxattr
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), fsIocFsgetxattr, uintptr(unsafe.Pointer(&attr))); errno != 0 {
+		if errors.Is(errno, syscall.ENOTTY) || errors.Is(errno, syscall.EOPNOTSUPP) || errors.Is(errno, syscall.EINVAL) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get the project ID of %q: %v", f.Name(), errno)
+	}
+	return attr.projid, nil
+}
+
+// getProjectQuota reads the quota of project id on the filesystem of f,
+// through a descriptor of f if the kernel allows it, which unlike the block
+// device needs not be visible from within cAdvisor's mount namespace.
+func getProjectQuota(f *os.File, device string, id uint32, quota *dqblk) error {
+	cmd := qcmd(qGetQuota, prjQuota)
+	_, _, errno := syscall.Syscall6(sysQuotactlFd, f.Fd(), cmd, uintptr(id), uintptr(unsafe.Pointer(quota)), 0, 0)
+	if errno != syscall.ENOSYS {
+		if errno != 0 {
+			return errno
+		}
+		return nil
+	}
+	special, err := syscall.BytePtrFromString(device)
+	if err != nil {
+		return err
+	}
+	if _, _, errno := syscall.Syscall6(syscall.SYS_QUOTACTL, cmd, uintptr(unsafe.Pointer(special)), uintptr(id), uintptr(unsafe.Pointer(quota)), 0, 0); errno != 0 {
+		return errno
+	}
+	return nil
+}
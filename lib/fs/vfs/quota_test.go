@@ -0,0 +1,74 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package vfs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/cadvisor/lib/fs"
+)
+
+func TestQuotaStructSizes(t *testing.T) {
+	// The sizes the kernel expects, which FS_IOC_FSGETXATTR encodes.
+	assert.Equal(t, uintptr(28), unsafe.Sizeof(fsxattr{}))
+	assert.Equal(t, uintptr(72), unsafe.Sizeof(dqblk{}))
+	assert.Equal(t, uintptr(0x80000702), qcmd(qGetQuota, prjQuota))
+}
+
+func TestGetDirUsageWithoutProject(t *testing.T) {
+	p := &vfsPlugin{}
+	dir := t.TempDir()
+
+	// Only XFS and ext4 have project quotas.
+	_, ok, err := p.GetDirUsage(dir, "/dev/sda1", fs.PartitionInfo{FsType: "vfat"})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// A fresh temporary directory has no project ID.
+	_, ok, err = GetProjectUsage(dir, "/dev/sda1")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestGetProjectUsageOfSubdirectory(t *testing.T) {
+	layer := t.TempDir()
+	upper := filepath.Join(layer, "diff")
+	require.NoError(t, os.Mkdir(upper, 0o755))
+
+	// The project of the layer directory is inherited by its upper dir.
+	defer func(f func(*os.File) (uint32, error)) { getProjectID = f }(getProjectID)
+	var asked []string
+	getProjectID = func(f *os.File) (uint32, error) {
+		asked = append(asked, f.Name())
+		if f.Name() == filepath.Dir(layer) {
+			return 0, nil
+		}
+		return 42, nil
+	}
+
+	// The upper dir shares the project with its parent, and is walked.
+	_, ok, err := GetProjectUsage(upper, "/dev/sda1")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, []string{upper, layer}, asked)
+}
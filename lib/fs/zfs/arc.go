@@ -0,0 +1,85 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zfs
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Overridable for tests.
+var arcStatsPath = "/proc/spl/kstat/zfs/arcstats"
+
+// ArcStats are the statistics of the ZFS Adaptive Replacement Cache.
+type ArcStats struct {
+	Hits     uint64
+	Misses   uint64
+	L2Hits   uint64
+	L2Misses uint64
+	// Current size of the ARC, in bytes.
+	Size uint64
+	// Size the ARC is adapting to, in bytes.
+	TargetSize uint64
+	// Maximum size of the ARC, in bytes.
+	MaxSize uint64
+}
+
+// ReadArcStats reads the ARC statistics from the kstat of the ZFS module.
+func ReadArcStats() (*ArcStats, error) {
+	data, err := os.ReadFile(arcStatsPath)
+	if err != nil {
+		return nil, err
+	}
+	return parseArcStats(data)
+}
+
+func parseArcStats(data []byte) (*ArcStats, error) {
+	var stats ArcStats
+	fields := map[string]*uint64{
+		"hits":      &stats.Hits,
+		"misses":    &stats.Misses,
+		"l2_hits":   &stats.L2Hits,
+		"l2_misses": &stats.L2Misses,
+		"size":      &stats.Size,
+		"c":         &stats.TargetSize,
+		"c_max":     &stats.MaxSize,
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for n := 0; scanner.Scan(); n++ {
+		// Skip the kstat header and the column names.
+		if n < 2 {
+			continue
+		}
+		// Each line is "<name> <type> <data>".
+		line := strings.Fields(scanner.Text())
+		if len(line) != 3 {
+			continue
+		}
+		field, ok := fields[line[0]]
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseUint(line[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse arcstats %s %q: %v", line[0], line[2], err)
+		}
+		*field = value
+	}
+	return &stats, scanner.Err()
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zfs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadArcStats(t *testing.T) {
+	oldPath := arcStatsPath
+	arcStatsPath = "testdata/arcstats"
+	defer func() { arcStatsPath = oldPath }()
+
+	stats, err := ReadArcStats()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := &ArcStats{
+		Hits:       93640218,
+		Misses:     2210473,
+		L2Hits:     3315,
+		L2Misses:   1022,
+		Size:       4294967296,
+		TargetSize: 5368709120,
+		MaxSize:    8589934592,
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("ReadArcStats() = %+v, want %+v", stats, want)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zfs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DatasetStats is the usage of a ZFS dataset.
+type DatasetStats struct {
+	Name string
+	// Bytes used by the dataset and its descendants, snapshots included.
+	Used uint64
+	// Bytes of data the dataset refers to, which it may share with its origin
+	// and other clones.
+	Referenced uint64
+	// Bytes used by the snapshots of the dataset.
+	UsedBySnapshots uint64
+	// Ratio of the logical to the physical size of the referenced data.
+	CompressRatio float64
+}
+
+// ListDatasets returns the stats of the filesystems under parent, excluding
+// parent itself.
+func ListDatasets(run Runner, parent string) ([]DatasetStats, error) {
+	out, err := run("zfs", "list", "-Hp", "-r", "-t", "filesystem", "-o", "name,used,referenced,usedbysnapshots,compressratio", parent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the datasets of %s: %v", parent, err)
+	}
+	return parseDatasets(out, parent)
+}
+
+func parseDatasets(out []byte, parent string) ([]DatasetStats, error) {
+	var datasets []DatasetStats
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("unexpected `zfs list` output, want 5 fields, got %q", line)
+		}
+		if fields[0] == parent {
+			continue
+		}
+		ds := DatasetStats{Name: fields[0]}
+		var err error
+		if ds.Used, err = parseZfsUint(fields[1]); err != nil {
+			return nil, err
+		}
+		if ds.Referenced, err = parseZfsUint(fields[2]); err != nil {
+			return nil, err
+		}
+		if ds.UsedBySnapshots, err = parseZfsUint(fields[3]); err != nil {
+			return nil, err
+		}
+		// Older releases suffix the ratio with "x" even in parsable output.
+		if ds.CompressRatio, err = strconv.ParseFloat(strings.TrimSuffix(fields[4], "x"), 64); err != nil {
+			return nil, err
+		}
+		datasets = append(datasets, ds)
+	}
+	return datasets, nil
+}
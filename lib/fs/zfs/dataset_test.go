@@ -0,0 +1,55 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zfs
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fakeRunner returns output for the command line it expects, and fails
+// otherwise.
+func fakeRunner(t *testing.T, want string, output string) Runner {
+	return func(name string, arg ...string) ([]byte, error) {
+		if got := strings.Join(append([]string{name}, arg...), " "); got != want {
+			t.Errorf("ran %q, want %q", got, want)
+		}
+		return []byte(output), nil
+	}
+}
+
+func TestListDatasets(t *testing.T) {
+	run := fakeRunner(t, "zfs list -Hp -r -t filesystem -o name,used,referenced,usedbysnapshots,compressratio tank/docker",
+		"tank/docker\t3000\t100\t0\t1.00\n"+
+			"tank/docker/abc\t2048\t1024\t512\t1.50\n"+
+			"tank/docker/def\t-\t4096\t0\t2.25x\n")
+
+	datasets, err := ListDatasets(run, "tank/docker")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []DatasetStats{
+		{Name: "tank/docker/abc", Used: 2048, Referenced: 1024, UsedBySnapshots: 512, CompressRatio: 1.5},
+		{Name: "tank/docker/def", Used: 0, Referenced: 4096, UsedBySnapshots: 0, CompressRatio: 2.25},
+	}
+	if !reflect.DeepEqual(datasets, want) {
+		t.Errorf("ListDatasets() = %+v, want %+v", datasets, want)
+	}
+
+	if _, err := ListDatasets(fakeRunner(t, "zfs list -Hp -r -t filesystem -o name,used,referenced,usedbysnapshots,compressratio tank", "tank\t1\t2\n"), "tank"); err == nil {
+		t.Error("expected error for 3-field output")
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zfs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PoolStats is the state and usage of a ZFS pool.
+type PoolStats struct {
+	Name string
+	// Health of the pool, e.g. "ONLINE" or "DEGRADED".
+	Health    string
+	Size      uint64
+	Allocated uint64
+	Free      uint64
+	// Percentage of the free space that is fragmented.
+	Fragmentation uint64
+	// Percentage of the pool that is allocated.
+	Capacity uint64
+}
+
+// ListPools returns the stats of the imported pools.
+func ListPools(run Runner) ([]PoolStats, error) {
+	out, err := run("zpool", "list", "-Hp", "-o", "name,health,size,allocated,free,fragmentation,capacity")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list zfs pools: %v", err)
+	}
+	return parsePools(out)
+}
+
+func parsePools(out []byte) ([]PoolStats, error) {
+	var pools []PoolStats
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 7 {
+			return nil, fmt.Errorf("unexpected `zpool list` output, want 7 fields, got %q", line)
+		}
+		pool := PoolStats{Name: fields[0], Health: fields[1]}
+		values := []*uint64{&pool.Size, &pool.Allocated, &pool.Free, &pool.Fragmentation, &pool.Capacity}
+		for i, value := range values {
+			// Percentages are suffixed with "%" by older releases.
+			v, err := parseZfsUint(strings.TrimSuffix(fields[i+2], "%"))
+			if err != nil {
+				return nil, err
+			}
+			*value = v
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zfs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListPools(t *testing.T) {
+	run := fakeRunner(t, "zpool list -Hp -o name,health,size,allocated,free,fragmentation,capacity",
+		"rpool\tONLINE\t1000000\t250000\t750000\t12\t25\n"+
+			// Pools without space map histograms have no fragmentation.
+			"tank\tDEGRADED\t4000000\t3600000\t400000\t-\t90%\n")
+
+	pools, err := ListPools(run)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []PoolStats{
+		{Name: "rpool", Health: "ONLINE", Size: 1000000, Allocated: 250000, Free: 750000, Fragmentation: 12, Capacity: 25},
+		{Name: "tank", Health: "DEGRADED", Size: 4000000, Allocated: 3600000, Free: 400000, Capacity: 90},
+	}
+	if !reflect.DeepEqual(pools, want) {
+		t.Errorf("ListPools() = %+v, want %+v", pools, want)
+	}
+
+	// No pools are imported.
+	pools, err = ListPools(fakeRunner(t, "zpool list -Hp -o name,health,size,allocated,free,fragmentation,capacity", ""))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pools) != 0 {
+		t.Errorf("ListPools() = %+v, want none", pools)
+	}
+}
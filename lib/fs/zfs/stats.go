@@ -16,18 +16,35 @@ package zfs
 
 import (
 	"fmt"
+	"os"
 	"os/exec"
 	"strconv"
 	"strings"
 )
 
+// Runner runs a command and returns its standard output. Tests substitute a
+// fake for the zfs and zpool commands.
+type Runner func(name string, arg ...string) ([]byte, error)
+
+// ExecRunner runs commands on the host.
+func ExecRunner(name string, arg ...string) ([]byte, error) {
+	return exec.Command(name, arg...).Output()
+}
+
+// Available returns whether the ZFS kernel module is loaded, without which
+// the zfs and zpool commands fail.
+func Available() bool {
+	_, err := os.Stat(zfsDevicePath)
+	return err == nil
+}
+
 // GetZfsStats returns (capacity, free, available) byte counts for the given ZFS
 // dataset/pool. It shells out to `zfs list` -- the same mechanism the
 // mistifyio/go-zfs library used internally -- reduced to the three properties
 // cAdvisor consumes, so that heavyweight dependency is no longer required.
 func GetZfsStats(poolName string) (uint64, uint64, uint64, error) {
 	// `-Hp`: scripted output (no header), parsable exact byte values.
-	out, err := exec.Command("zfs", "list", "-Hp", "-o", "used,available,usedbydataset", poolName).Output()
+	out, err := ExecRunner("zfs", "list", "-Hp", "-o", "used,available,usedbydataset", poolName)
 	if err != nil {
 		return 0, 0, 0, err
 	}
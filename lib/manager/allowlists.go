@@ -0,0 +1,40 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+// SetAllowLists replaces the raw container cgroup path prefix whitelist and the
+// container env metadata whitelist while the manager runs, so the full binary
+// can reload them without dropping in-memory history. Cgroups newly allowed by
+// the prefix whitelist are picked up at the next global housekeeping; the env
+// whitelist applies to containers created from now on. Containers already
+// being tracked are kept.
+func (m *manager) SetAllowLists(rawContainerCgroupPathPrefixWhiteList, containerEnvMetadataWhiteList []string) {
+	m.allowListsMu.Lock()
+	defer m.allowListsMu.Unlock()
+	m.rawContainerCgroupPathPrefixWhiteList = rawContainerCgroupPathPrefixWhiteList
+	m.containerEnvMetadataWhiteList = containerEnvMetadataWhiteList
+}
+
+func (m *manager) getRawContainerCgroupPathPrefixWhiteList() []string {
+	m.allowListsMu.RLock()
+	defer m.allowListsMu.RUnlock()
+	return m.rawContainerCgroupPathPrefixWhiteList
+}
+
+func (m *manager) getContainerEnvMetadataWhiteList() []string {
+	m.allowListsMu.RLock()
+	defer m.allowListsMu.RUnlock()
+	return m.containerEnvMetadataWhiteList
+}
@@ -81,6 +81,14 @@ type containerData struct {
 	infoLastUpdatedTime      atomicTime // Unix nano
 	statsLastUpdatedTime     atomicTime // Unix nano
 	lastErrorTime            time.Time
+	// Number of times the container was recreated under the same name.
+	restartCount int
+	// Forecasts the usage of the filesystems of the container over the
+	// samples kept in the cache, nil until it has filesystems.
+	fsForecaster *fsForecaster
+	// Filesystems of the previous stats of the root container, whose I/O
+	// rates are derived from them.
+	previousFs *info.ContainerStats
 	//  used to track time
 	clock clock.Clock
 
@@ -386,10 +394,30 @@ func (cd *containerData) updateSpec() error {
 			spec.CustomMetrics = customSpec
 		}
 	}
+	// Runtimes that count restarts themselves may know of more than
+	// cAdvisor has seen.
+	if cd.restartCount > spec.RestartCount {
+		spec.RestartCount = cd.restartCount
+	}
 	cd.info.Spec = spec
 	return nil
 }
 
+// machineFsAvailable returns the bytes available on each filesystem of the
+// machine, by device, from stats if they are those of the root container and
+// else from its latest stats.
+func (cd *containerData) machineFsAvailable(stats *info.ContainerStats) map[string]uint64 {
+	if cd.info.Name == "/" {
+		return fsAvailable(stats)
+	}
+	var empty time.Time
+	rootStats, err := cd.memoryCache.RecentStats("/", empty, empty, 1)
+	if err != nil || len(rootStats) == 0 {
+		return nil
+	}
+	return fsAvailable(rootStats[0])
+}
+
 func (cd *containerData) DerivedStats() (info.DerivedStats, error) {
 	if cd.summaryReader == nil {
 		return info.DerivedStats{}, fmt.Errorf("derived stats not enabled for container %q", cd.info.Name)
@@ -502,6 +530,18 @@ func (cd *containerData) updateStats() error {
 		ContainerReference: ref,
 	}
 
+	if len(stats.Filesystem) > 0 {
+		if cd.fsForecaster == nil {
+			cd.fsForecaster = newFsForecaster(cd.memoryCache.MaxAge())
+		}
+		cd.fsForecaster.forecast(stats, cd.machineFsAvailable(stats))
+		if cd.info.Name == "/" {
+			// The root container has the filesystems of the machine.
+			deriveDiskIoRates(cd.previousFs, stats)
+			cd.previousFs = &info.ContainerStats{Timestamp: stats.Timestamp, Filesystem: stats.Filesystem}
+		}
+	}
+
 	err = cd.memoryCache.AddStats(&cInfo, stats)
 	if err != nil {
 		return err
@@ -0,0 +1,73 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// deriveDiskIoRates sets the I/O rates of each filesystem of stats from the
+// change of its diskstats counters since previous, the previous sample of the
+// filesystems, if any.
+func deriveDiskIoRates(previous, stats *info.ContainerStats) {
+	if previous == nil {
+		return
+	}
+	// Counters are in milliseconds.
+	interval := float64(stats.Timestamp.Sub(previous.Timestamp).Milliseconds())
+	if interval <= 0 {
+		return
+	}
+	for i := range stats.Filesystem {
+		fs := &stats.Filesystem[i]
+		for j := range previous.Filesystem {
+			if previous.Filesystem[j].Device == fs.Device {
+				fs.IoRates = diskIoRates(&previous.Filesystem[j], fs, interval)
+				break
+			}
+		}
+	}
+}
+
+// diskIoRates returns the rates of the I/O between the counters of prev and
+// cur, interval milliseconds apart, or nil if the counters were reset.
+func diskIoRates(prev, cur *info.FsStats, interval float64) *info.DiskIoRates {
+	if cur.IoTime < prev.IoTime || cur.WeightedIoTime < prev.WeightedIoTime ||
+		cur.ReadsCompleted < prev.ReadsCompleted || cur.WritesCompleted < prev.WritesCompleted ||
+		cur.DiscardsCompleted < prev.DiscardsCompleted || cur.FlushesCompleted < prev.FlushesCompleted {
+		return nil
+	}
+	utilization := float64(cur.IoTime-prev.IoTime) / interval * 100
+	if utilization > 100 {
+		utilization = 100
+	}
+	return &info.DiskIoRates{
+		Utilization:  utilization,
+		ReadAwait:    await(prev.ReadsCompleted, cur.ReadsCompleted, prev.ReadTime, cur.ReadTime),
+		WriteAwait:   await(prev.WritesCompleted, cur.WritesCompleted, prev.WriteTime, cur.WriteTime),
+		DiscardAwait: await(prev.DiscardsCompleted, cur.DiscardsCompleted, prev.DiscardTime, cur.DiscardTime),
+		FlushAwait:   await(prev.FlushesCompleted, cur.FlushesCompleted, prev.FlushTime, cur.FlushTime),
+		QueueSize:    float64(cur.WeightedIoTime-prev.WeightedIoTime) / interval,
+	}
+}
+
+// await returns the average seconds taken by the requests completed between
+// two samples of their count and of the milliseconds they took.
+func await(prevCount, curCount, prevTime, curTime uint64) float64 {
+	if curCount <= prevCount || curTime < prevTime {
+		return 0
+	}
+	return float64(curTime-prevTime) / float64(curCount-prevCount) / 1000
+}
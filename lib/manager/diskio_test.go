@@ -0,0 +1,64 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/lib/model"
+)
+
+func TestDeriveDiskIoRates(t *testing.T) {
+	now := time.Unix(1000, 0)
+	previous := fsSample(now.Add(-10*time.Second),
+		info.FsStats{Device: "sda1", ReadsCompleted: 100, ReadTime: 500, WritesCompleted: 50, WriteTime: 1000, IoTime: 2000, WeightedIoTime: 3000, FlushesCompleted: 10, FlushTime: 40},
+		info.FsStats{Device: "sdb1", IoTime: 5000},
+	)
+	stats := fsSample(now,
+		info.FsStats{Device: "sda1", ReadsCompleted: 300, ReadTime: 1500, WritesCompleted: 50, WriteTime: 1000, IoTime: 7000, WeightedIoTime: 18000, FlushesCompleted: 30, FlushTime: 100},
+		// Counters reset, e.g. by a device replaced since.
+		info.FsStats{Device: "sdb1", IoTime: 100},
+		info.FsStats{Device: "sdc1", IoTime: 100},
+	)
+
+	deriveDiskIoRates(previous, stats)
+
+	rates := stats.Filesystem[0].IoRates
+	require.NotNil(t, rates)
+	assert.InDelta(t, 50, rates.Utilization, 1e-9)
+	assert.InDelta(t, 0.005, rates.ReadAwait, 1e-9)
+	// No write completed during the interval.
+	assert.Equal(t, 0.0, rates.WriteAwait)
+	assert.InDelta(t, 0.003, rates.FlushAwait, 1e-9)
+	assert.InDelta(t, 1.5, rates.QueueSize, 1e-9)
+
+	assert.Nil(t, stats.Filesystem[1].IoRates)
+	assert.Nil(t, stats.Filesystem[2].IoRates)
+
+	// A previous sample from after stats is ignored.
+	stats = fsSample(now.Add(-20*time.Second), info.FsStats{Device: "sda1"})
+	deriveDiskIoRates(previous, stats)
+	assert.Nil(t, stats.Filesystem[0].IoRates)
+}
+
+func TestDeriveDiskIoRatesWithoutPrevious(t *testing.T) {
+	stats := fsSample(time.Unix(1000, 0), info.FsStats{Device: "sda1", IoTime: 100})
+	deriveDiskIoRates(nil, stats)
+	assert.Nil(t, stats.Filesystem[0].IoRates)
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"time"
+
+	info "github.com/google/cadvisor/lib/model"
+)
+
+// Fewest samples of a filesystem a forecast of its usage is fitted to.
+const minForecastSamples = 3
+
+// fsForecaster forecasts the usage of the filesystems of a container from a
+// window of their recent usage, kept by device with the running sums of the
+// least-squares line through it, so that each sample is only accounted once.
+type fsForecaster struct {
+	// Samples older than the window before the latest are dropped.
+	window  time.Duration
+	devices map[string]*usageWindow
+}
+
+// usageWindow is the usage of a filesystem in the window, oldest first, with
+// the means and co-moments of the points (seconds since base, bytes).
+type usageWindow struct {
+	samples []usageSample
+	base    time.Time
+
+	n, meanX, meanY, cxx, cxy float64
+}
+
+type usageSample struct {
+	timestamp time.Time
+	x, y      float64
+}
+
+func newFsForecaster(window time.Duration) *fsForecaster {
+	return &fsForecaster{window: window, devices: make(map[string]*usageWindow)}
+}
+
+// forecast adds the usage of each filesystem of stats to its window and sets
+// its forecast from the least-squares line through the window. The time to
+// full of filesystems whose stats do not tell the bytes available, such as the
+// writable layers of containers, is forecast from machineAvailable, the bytes
+// available on the filesystems of the machine by device, and left out if their
+// device is not in it. Stats older than the latest of a filesystem are not
+// added, and not forecast.
+func (f *fsForecaster) forecast(stats *info.ContainerStats, machineAvailable map[string]uint64) {
+	evict := stats.Timestamp.Add(-f.window)
+	for device, w := range f.devices {
+		w.evict(evict)
+		if len(w.samples) == 0 {
+			delete(f.devices, device)
+		}
+	}
+	for i := range stats.Filesystem {
+		fs := &stats.Filesystem[i]
+		w, ok := f.devices[fs.Device]
+		if !ok {
+			w = &usageWindow{base: stats.Timestamp}
+			f.devices[fs.Device] = w
+		}
+		if !w.add(stats.Timestamp, float64(fs.Usage)) || len(w.samples) < minForecastSamples {
+			continue
+		}
+		rate, ok := w.slope()
+		if !ok {
+			continue
+		}
+		fs.Forecast = &info.FsForecast{FillRate: rate}
+		available, ok := fs.Available, fs.Available > 0
+		if !ok {
+			available, ok = machineAvailable[fs.Device]
+		}
+		if rate > 0 && ok {
+			timeToFull := float64(available) / rate
+			fs.Forecast.TimeToFull = &timeToFull
+		}
+	}
+}
+
+// add adds the usage y at timestamp, unless it is not after the latest
+// sample, and returns whether it did.
+func (w *usageWindow) add(timestamp time.Time, y float64) bool {
+	if n := len(w.samples); n > 0 && !timestamp.After(w.samples[n-1].timestamp) {
+		return false
+	}
+	x := timestamp.Sub(w.base).Seconds()
+	w.samples = append(w.samples, usageSample{timestamp: timestamp, x: x, y: y})
+	w.n++
+	dx := x - w.meanX
+	w.meanX += dx / w.n
+	w.meanY += (y - w.meanY) / w.n
+	w.cxx += dx * (x - w.meanX)
+	w.cxy += dx * (y - w.meanY)
+	return true
+}
+
+// evict drops the samples not after evictTime.
+func (w *usageWindow) evict(evictTime time.Time) {
+	for len(w.samples) > 0 && !w.samples[0].timestamp.After(evictTime) {
+		sample := w.samples[0]
+		w.samples = w.samples[1:]
+		w.n--
+		if w.n == 0 {
+			w.meanX, w.meanY, w.cxx, w.cxy = 0, 0, 0, 0
+			continue
+		}
+		meanX, meanY := w.meanX, w.meanY
+		w.meanX -= (sample.x - meanX) / w.n
+		w.meanY -= (sample.y - meanY) / w.n
+		w.cxx -= (sample.x - w.meanX) * (sample.x - meanX)
+		w.cxy -= (sample.x - w.meanX) * (sample.y - meanY)
+	}
+}
+
+// slope returns the slope of the least-squares line through the samples. It
+// returns false if they are all at the same time.
+func (w *usageWindow) slope() (float64, bool) {
+	if w.cxx <= 0 {
+		return 0, false
+	}
+	return w.cxy / w.cxx, true
+}
+
+// fsAvailable returns the bytes available on each filesystem of stats, by
+// device.
+func fsAvailable(stats *info.ContainerStats) map[string]uint64 {
+	available := make(map[string]uint64, len(stats.Filesystem))
+	for _, fs := range stats.Filesystem {
+		available[fs.Device] = fs.Available
+	}
+	return available
+}
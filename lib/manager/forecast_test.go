@@ -0,0 +1,136 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/lib/model"
+)
+
+func fsSample(t time.Time, fsStats ...info.FsStats) *info.ContainerStats {
+	return &info.ContainerStats{Timestamp: t, Filesystem: fsStats}
+}
+
+// forecastAll feeds history to a forecaster with a window of a minute before
+// forecasting stats.
+func forecastAll(history []*info.ContainerStats, stats *info.ContainerStats, machineAvailable map[string]uint64) *fsForecaster {
+	f := newFsForecaster(time.Minute)
+	for _, sample := range history {
+		f.forecast(sample, machineAvailable)
+	}
+	f.forecast(stats, machineAvailable)
+	return f
+}
+
+func TestForecastFsUsage(t *testing.T) {
+	now := time.Unix(1000, 0)
+	history := []*info.ContainerStats{
+		fsSample(now.Add(-30*time.Second), info.FsStats{Device: "sda1", Usage: 1000}, info.FsStats{Device: "sdb1", Usage: 500}),
+		fsSample(now.Add(-20*time.Second), info.FsStats{Device: "sda1", Usage: 1100}, info.FsStats{Device: "sdb1", Usage: 400}),
+		// sdb1 was not mounted.
+		fsSample(now.Add(-10*time.Second), info.FsStats{Device: "sda1", Usage: 1200}),
+	}
+	stats := fsSample(now,
+		info.FsStats{Device: "sda1", Usage: 1300, Available: 500},
+		info.FsStats{Device: "sdb1", Usage: 300, Available: 500},
+		info.FsStats{Device: "sdc1", Usage: 100, Available: 500},
+	)
+
+	forecastAll(history, stats, nil)
+
+	require.NotNil(t, stats.Filesystem[0].Forecast)
+	assert.InDelta(t, 10, stats.Filesystem[0].Forecast.FillRate, 1e-9)
+	require.NotNil(t, stats.Filesystem[0].Forecast.TimeToFull)
+	assert.InDelta(t, 50, *stats.Filesystem[0].Forecast.TimeToFull, 1e-9)
+
+	// A shrinking filesystem never fills up.
+	require.NotNil(t, stats.Filesystem[1].Forecast)
+	assert.InDelta(t, -45.0/7, stats.Filesystem[1].Forecast.FillRate, 1e-9)
+	assert.Nil(t, stats.Filesystem[1].Forecast.TimeToFull)
+
+	// Without enough history there is no forecast.
+	assert.Nil(t, stats.Filesystem[2].Forecast)
+}
+
+func TestForecastFsUsageWindow(t *testing.T) {
+	now := time.Unix(1000, 0)
+	f := newFsForecaster(time.Minute)
+	// Usage grows by 100 bytes per second, then by 10 bytes per second once
+	// the earlier samples left the window.
+	for i := 0; i < 12; i++ {
+		usage := uint64(i) * 1000
+		if i > 6 {
+			usage = 6000 + uint64(i-6)*100
+		}
+		stats := fsSample(now.Add(time.Duration(i)*10*time.Second), info.FsStats{Device: "sda1", Usage: usage})
+		f.forecast(stats, nil)
+		if i == 5 {
+			require.NotNil(t, stats.Filesystem[0].Forecast)
+			assert.InDelta(t, 100, stats.Filesystem[0].Forecast.FillRate, 1e-9)
+		}
+		if i == 11 {
+			require.NotNil(t, stats.Filesystem[0].Forecast)
+			assert.InDelta(t, 10, stats.Filesystem[0].Forecast.FillRate, 1e-9)
+		}
+	}
+	assert.Len(t, f.devices["sda1"].samples, 6)
+
+	// Filesystems gone for longer than the window are forgotten.
+	f.forecast(fsSample(now.Add(3*time.Minute), info.FsStats{Device: "sdb1"}), nil)
+	assert.NotContains(t, f.devices, "sda1")
+}
+
+func TestForecastFsUsageIgnoresEarlierStats(t *testing.T) {
+	now := time.Unix(1000, 0)
+	history := []*info.ContainerStats{
+		fsSample(now.Add(-10*time.Second), info.FsStats{Device: "sda1", Usage: 1000}),
+		fsSample(now, info.FsStats{Device: "sda1", Usage: 5000}),
+		fsSample(now.Add(10*time.Second), info.FsStats{Device: "sda1", Usage: 9000}),
+	}
+	stats := fsSample(now, info.FsStats{Device: "sda1", Usage: 1100})
+
+	f := forecastAll(history, stats, nil)
+	assert.Nil(t, stats.Filesystem[0].Forecast)
+	assert.Len(t, f.devices["sda1"].samples, 3)
+}
+
+func TestForecastFsUsageOfContainerLayer(t *testing.T) {
+	now := time.Unix(1000, 0)
+	// Container handlers do not set the bytes available on the filesystem of
+	// the writable layer.
+	history := []*info.ContainerStats{
+		fsSample(now.Add(-20*time.Second), info.FsStats{Device: "sda1", Usage: 100}),
+		fsSample(now.Add(-10*time.Second), info.FsStats{Device: "sda1", Usage: 200}),
+	}
+	stats := fsSample(now, info.FsStats{Device: "sda1", Usage: 300})
+
+	forecastAll(history, stats, map[string]uint64{"sda1": 1000})
+	require.NotNil(t, stats.Filesystem[0].Forecast)
+	assert.InDelta(t, 10, stats.Filesystem[0].Forecast.FillRate, 1e-9)
+	require.NotNil(t, stats.Filesystem[0].Forecast.TimeToFull)
+	assert.InDelta(t, 100, *stats.Filesystem[0].Forecast.TimeToFull, 1e-9)
+
+	// Without the filesystem of the machine, the time to full is unknown
+	// rather than zero.
+	stats = fsSample(now, info.FsStats{Device: "sda1", Usage: 300})
+	forecastAll(history, stats, map[string]uint64{"sdb1": 1000})
+	require.NotNil(t, stats.Filesystem[0].Forecast)
+	assert.Nil(t, stats.Filesystem[0].Forecast.TimeToFull)
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/google/cadvisor/lib/container"
+	info "github.com/google/cadvisor/lib/model"
+
+	"k8s.io/klog/v2"
+)
+
+// ImageStores returns the images of the runtimes that store them, each with
+// the tracked containers running it.
+func (m *manager) ImageStores() ([]info.ImageStore, error) {
+	stores, errs := container.ImageStores()
+	if len(stores) == 0 && len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	for _, err := range errs {
+		klog.Warning(err)
+	}
+
+	var specs []*containerInfo
+	for name, cont := range m.getSubcontainers("/") {
+		cinfo, err := cont.GetInfo(false)
+		if err != nil {
+			// The container may have been removed since.
+			klog.V(4).Infof("Failed to get the spec of container %q: %v", name, err)
+			continue
+		}
+		specs = append(specs, cinfo)
+	}
+	for i := range stores {
+		addImageContainers(&stores[i], specs)
+	}
+	return stores, nil
+}
+
+// addImageContainers sets the containers of the runtime of the store that run
+// each of its images, found by image ID or else by the image name they were
+// created from.
+func addImageContainers(store *info.ImageStore, containers []*containerInfo) {
+	for _, cont := range containers {
+		if cont.Namespace != store.Runtime {
+			continue
+		}
+		for i := range store.Images {
+			image := &store.Images[i]
+			if (cont.Spec.ImageID != "" && cont.Spec.ImageID == image.ID) || (cont.Spec.ImageID == "" && image.HasName(cont.Spec.Image)) {
+				image.Containers = append(image.Containers, cont.Name)
+				break
+			}
+		}
+	}
+	for i := range store.Images {
+		sort.Strings(store.Images[i].Containers)
+	}
+}
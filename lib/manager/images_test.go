@@ -0,0 +1,66 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	info "github.com/google/cadvisor/lib/model"
+)
+
+func TestAddImageContainers(t *testing.T) {
+	store := info.ImageStore{
+		Runtime: "docker",
+		Images: []info.ImageInfo{
+			{ID: "sha256:aaa", RepoTags: []string{"nginx:1.27"}},
+			{ID: "sha256:bbb", RepoTags: []string{"redis:7"}, RepoDigests: []string{"redis@sha256:ccc"}},
+		},
+	}
+	containers := []*containerInfo{
+		{
+			ContainerReference: info.ContainerReference{Name: "/docker/b", Namespace: "docker"},
+			Spec:               info.ContainerSpec{Image: "nginx:1.27", ImageID: "sha256:aaa"},
+		},
+		{
+			ContainerReference: info.ContainerReference{Name: "/docker/a", Namespace: "docker"},
+			Spec:               info.ContainerSpec{Image: "nginx:1.27", ImageID: "sha256:aaa"},
+		},
+		// The image ID wins over the name.
+		{
+			ContainerReference: info.ContainerReference{Name: "/docker/c", Namespace: "docker"},
+			Spec:               info.ContainerSpec{Image: "nginx:1.27", ImageID: "sha256:bbb"},
+		},
+		// Without an image ID, the name is matched.
+		{
+			ContainerReference: info.ContainerReference{Name: "/docker/d", Namespace: "docker"},
+			Spec:               info.ContainerSpec{Image: "redis@sha256:ccc"},
+		},
+		// Containers of other runtimes are not.
+		{
+			ContainerReference: info.ContainerReference{Name: "/podman/e", Namespace: "podman"},
+			Spec:               info.ContainerSpec{Image: "nginx:1.27", ImageID: "sha256:aaa"},
+		},
+		{
+			ContainerReference: info.ContainerReference{Name: "/docker/f", Namespace: "docker"},
+			Spec:               info.ContainerSpec{Image: "busybox"},
+		},
+	}
+
+	addImageContainers(&store, containers)
+	assert.Equal(t, []string{"/docker/a", "/docker/b"}, store.Images[0].Containers)
+	assert.Equal(t, []string{"/docker/c", "/docker/d"}, store.Images[1].Containers)
+}
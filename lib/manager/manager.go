@@ -133,6 +133,19 @@ type Manager interface {
 	// Get information about the podman container with the specified name.
 	PodmanContainer(containerName string, query *info.ContainerInfoRequest) (info.ContainerInfo, error)
 
+	// Get the Kubernetes pods of the tracked containers, keyed by pod UID.
+	AllPods(query *info.ContainerInfoRequest) (map[string]info.PodInfo, error)
+
+	// Get the Kubernetes pod with the specified UID.
+	Pod(uid string, query *info.ContainerInfoRequest) (info.PodInfo, error)
+
+	// Get the images of the runtimes that store them, each with the tracked
+	// containers running it.
+	ImageStores() ([]info.ImageStore, error)
+
+	// Get the stats of the walks measuring the disk usage of directories.
+	DirUsageScanStats() fs.ScanStats
+
 	// Get the specs for a container, possibly with subcontainers.
 	GetContainerSpec(containerName string, options info.RequestOptions) (map[string]info.ContainerSpec, error)
 
@@ -152,6 +165,14 @@ type Manager interface {
 	// full binary injects its events manager; the kubelet does not call this (no
 	// events are emitted). See events.go.
 	SetEventSink(sink EventSink)
+
+	// SetAllowLists replaces the raw container cgroup path prefix whitelist and
+	// the container env metadata whitelist given to New. See allowlists.go.
+	SetAllowLists(rawContainerCgroupPathPrefixWhiteList, containerEnvMetadataWhiteList []string)
+
+	// SetRestartLoopPolicy configures when a container restart loop event is
+	// emitted. See restarts.go.
+	SetRestartLoopPolicy(threshold int, window time.Duration)
 }
 
 // Housekeeping configuration for the manager
@@ -219,6 +240,7 @@ func New(memoryCache *memory.InMemoryCache, sysfs sysfs.SysFs, HousekeepingConfi
 		eventsChannel:                         eventsChannel,
 		rawContainerCgroupPathPrefixWhiteList: rawContainerCgroupPathPrefixWhiteList,
 		containerEnvMetadataWhiteList:         containerEnvMetadataWhiteList,
+		restarts:                              newRestartTracker(defaultRestartLoopThreshold, defaultRestartLoopWindow),
 	}
 
 	machineInfo, err := machine.Info(sysfs, fsInfo, inHostNamespace)
@@ -313,6 +335,9 @@ type manager struct {
 	includedMetrics           container.MetricSet
 	containerWatchers         []watcher.ContainerWatcher
 	eventsChannel             chan watcher.ContainerEvent
+	// allowListsMu protects the two allow lists below, which SetAllowLists
+	// may replace while the manager runs.
+	allowListsMu sync.RWMutex
 	// List of raw container cgroup path prefix whitelist.
 	rawContainerCgroupPathPrefixWhiteList []string
 	// List of container env prefix whitelist, the matched container envs would be collected into metrics as extra labels.
@@ -327,6 +352,10 @@ type manager struct {
 	// lifecycle and OOM events. nil for the kubelet (no event machinery). See
 	// events.go.
 	eventSink EventSink
+
+	// restarts counts containers recreated under the same name and detects
+	// restart loops. See restarts.go.
+	restarts *restartTracker
 }
 
 // Start the container manager.
@@ -335,7 +364,7 @@ func (m *manager) Start() error {
 		m.containerWatchers = container.InitializePlugins(m, m.fsInfo, m.includedMetrics)
 	}
 
-	err := raw.Register(m, m.fsInfo, m.includedMetrics, m.rawContainerCgroupPathPrefixWhiteList)
+	err := raw.RegisterWithPrefixWhiteListFunc(m, m.fsInfo, m.includedMetrics, m.getRawContainerCgroupPathPrefixWhiteList)
 	if err != nil {
 		klog.Errorf("Registration of the raw container factory failed: %v", err)
 	}
@@ -726,6 +755,10 @@ func (m *manager) GetDirFsInfo(dir string) (info.FsInfo, error) {
 	return m.getFsInfoByDeviceName(device.Device)
 }
 
+func (m *manager) DirUsageScanStats() fs.ScanStats {
+	return fs.GetScanStats()
+}
+
 func (m *manager) GetFsInfo(label string) ([]info.FsInfo, error) {
 	var empty time.Time
 	// Get latest data from filesystems hanging off root container.
@@ -756,13 +789,15 @@ func (m *manager) GetFsInfo(label string) ([]info.FsInfo, error) {
 		}
 
 		fi := info.FsInfo{
-			Timestamp:  stats[0].Timestamp,
-			Device:     fs.Device,
-			Mountpoint: mountpoint,
-			Capacity:   fs.Limit,
-			Usage:      fs.Usage,
-			Available:  fs.Available,
-			Labels:     labels,
+			Timestamp:   stats[0].Timestamp,
+			Device:      fs.Device,
+			Mountpoint:  mountpoint,
+			Capacity:    fs.Limit,
+			Usage:       fs.Usage,
+			Available:   fs.Available,
+			Labels:      labels,
+			Allocations: fs.Allocations,
+			Forecast:    fs.Forecast,
 		}
 		if fs.HasInodes {
 			fi.Inodes = &fs.Inodes
@@ -830,7 +865,7 @@ func (m *manager) createContainer(containerName string, watchSource watcher.Cont
 		return nil
 	}
 
-	handler, accept, err := container.NewContainerHandler(containerName, watchSource, m.containerEnvMetadataWhiteList, m.inHostNamespace)
+	handler, accept, err := container.NewContainerHandler(containerName, watchSource, m.getContainerEnvMetadataWhiteList(), m.inHostNamespace)
 	if err != nil {
 		return err
 	}
@@ -844,6 +879,14 @@ func (m *manager) createContainer(containerName string, watchSource watcher.Cont
 		return err
 	}
 
+	restartCount, restartLoop := m.restarts.recordCreation(restartKey(containerName, cont.info.Spec.Labels), time.Now())
+	if restartCount > 0 {
+		cont.lock.Lock()
+		cont.restartCount = restartCount
+		cont.info.Spec.RestartCount = max(cont.info.Spec.RestartCount, restartCount)
+		cont.lock.Unlock()
+	}
+
 	if m.includedMetrics.Has(container.PerfMetrics) {
 		perfCgroupPath, err := handler.GetCgroupPath("perf_event")
 		if err != nil {
@@ -916,6 +959,17 @@ func (m *manager) createContainer(containerName string, watchSource watcher.Cont
 			EventType:     info.EventContainerCreation,
 		})
 	}
+	if restartLoop != nil {
+		klog.V(2).Infof("Container %q restarted %d times within %v", containerName, restartLoop.Restarts, restartLoop.Window)
+		m.addEvent(&info.Event{
+			ContainerName: cont.info.Name,
+			Timestamp:     time.Now(),
+			EventType:     info.EventRestartLoop,
+			EventData: info.EventData{
+				RestartLoop: restartLoop,
+			},
+		})
+	}
 
 	// Start the container's housekeeping.
 	return cont.Start()
@@ -931,13 +985,10 @@ func (m *manager) destroyContainer(containerName string) error {
 		return nil
 	}
 
-	exitCode, err := cont.handler.GetExitCode()
-	if err != nil {
-		klog.V(4).Infof("Could not retrieve exit code for container %q: %v (using -1)", containerName, err)
-		exitCode = -1
-	}
+	now := time.Now()
+	exitStatus := getExitStatus(cont, now)
 
-	err = cont.Stop()
+	err := cont.Stop()
 	if err != nil {
 		return err
 	}
@@ -950,24 +1001,70 @@ func (m *manager) destroyContainer(containerName string) error {
 			Name:      alias,
 		})
 	}
-	klog.V(3).Infof("Destroyed container: %q (aliases: %v, namespace: %q, exit_code: %d)", containerName, cont.info.Aliases, cont.info.Namespace, exitCode)
+	m.restarts.recordDeletion(restartKey(containerName, cont.info.Spec.Labels), now, exitStatus.ExitCode)
+	klog.V(3).Infof("Destroyed container: %q (aliases: %v, namespace: %q, exit_code: %d, signal: %d)", containerName, cont.info.Aliases, cont.info.Namespace, exitStatus.ExitCode, exitStatus.Signal)
 
 	// Surface the container-deletion event to the sink (no-op if unset),
-	// carrying the exit code (the /events API and its tests rely on it).
+	// carrying the exit status (the /events API and its tests rely on it).
+	deletion := &info.ContainerDeletionEventData{
+		ExitCode: exitStatus.ExitCode,
+		Signal:   exitStatus.Signal,
+	}
+	if !exitStatus.StartedAt.IsZero() && exitStatus.FinishedAt.After(exitStatus.StartedAt) {
+		deletion.Runtime = exitStatus.FinishedAt.Sub(exitStatus.StartedAt)
+	}
 	m.addEvent(&info.Event{
 		ContainerName: cont.info.Name,
-		Timestamp:     time.Now(),
+		Timestamp:     now,
 		EventType:     info.EventContainerDeletion,
 		EventData: info.EventData{
-			ContainerDeletion: &info.ContainerDeletionEventData{
-				ExitCode: exitCode,
-			},
+			ContainerDeletion: deletion,
 		},
 	})
 
 	return nil
 }
 
+// Refresh the metadata of a container its runtime reported as changed, creating
+// the container if it is not known yet.
+func (m *manager) updateContainer(containerName string, watchSource watcher.ContainerWatchSource) error {
+	cont, ok := m.containers.Load(namespacedContainerName{Name: containerName})
+	if !ok {
+		return m.createContainer(containerName, watchSource)
+	}
+
+	if refresher, ok := cont.handler.(container.MetadataRefresher); ok {
+		if err := refresher.RefreshMetadata(); err != nil {
+			return err
+		}
+	}
+	ref, err := cont.handler.ContainerReference()
+	if err != nil {
+		return err
+	}
+
+	// A renamed container changes aliases, which must be re-registered.
+	cont.lock.Lock()
+	oldAliases := cont.info.Aliases
+	cont.info.ContainerReference = ref
+	cont.lock.Unlock()
+	for _, alias := range oldAliases {
+		m.containers.Delete(namespacedContainerName{
+			Namespace: ref.Namespace,
+			Name:      alias,
+		})
+	}
+	for _, alias := range ref.Aliases {
+		m.containers.Store(namespacedContainerName{
+			Namespace: ref.Namespace,
+			Name:      alias,
+		}, cont)
+	}
+
+	klog.V(3).Infof("Updated container: %q (aliases: %v, namespace: %q)", containerName, ref.Aliases, ref.Namespace)
+	return cont.updateSpec()
+}
+
 // Detect all containers that have been added or deleted from the specified container.
 func (m *manager) getContainersDiff(containerName string) (added []info.ContainerReference, removed []info.ContainerReference, err error) {
 	// Get all subcontainers recursively.
@@ -1074,6 +1171,8 @@ func (m *manager) watchForNewContainers(quit chan error) error {
 					}
 				case event.EventType == watcher.ContainerDelete:
 					err = m.destroyContainer(event.Name)
+				case event.EventType == watcher.ContainerUpdate:
+					err = m.updateContainer(event.Name, event.WatchSource)
 				}
 				if err != nil {
 					klog.Warningf("Failed to process watch event %+v: %v", event, err)
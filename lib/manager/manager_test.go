@@ -730,6 +730,48 @@ func TestDestroyContainerExitCodeUnavailable(t *testing.T) {
 	mockHandler.AssertExpectations(t)
 }
 
+// renamingHandler is a handler whose runtime reports it was renamed.
+type renamingHandler struct {
+	*containertest.MockContainerHandler
+	newAliases []string
+}
+
+func (h *renamingHandler) RefreshMetadata() error {
+	h.Aliases = h.newAliases
+	return nil
+}
+
+func TestUpdateContainerRefreshesAliases(t *testing.T) {
+	mockHandler := containertest.NewMockContainerHandler("/test")
+	mockHandler.Aliases = []string{"old"}
+	mockHandler.On("GetSpec").Return(info.ContainerSpec{Labels: map[string]string{"a": "b"}}, nil)
+	handler := &renamingHandler{MockContainerHandler: mockHandler, newAliases: []string{"new"}}
+
+	m := &manager{}
+	cont := &containerData{
+		handler: handler,
+		info: containerInfo{
+			ContainerReference: info.ContainerReference{
+				Name:    "/test",
+				Aliases: []string{"old"},
+			},
+		},
+	}
+	m.containers.Store(namespacedContainerName{Name: "/test"}, cont)
+	m.containers.Store(namespacedContainerName{Name: "old"}, cont)
+
+	assert.NoError(t, m.updateContainer("/test", watcher.Runtime))
+
+	_, ok := m.containers.Load(namespacedContainerName{Name: "old"})
+	assert.False(t, ok)
+	renamed, ok := m.containers.Load(namespacedContainerName{Name: "new"})
+	assert.True(t, ok)
+	assert.Equal(t, cont, renamed)
+	assert.Equal(t, []string{"new"}, cont.info.Aliases)
+	assert.Equal(t, map[string]string{"a": "b"}, cont.info.Spec.Labels)
+	mockHandler.AssertExpectations(t)
+}
+
 type mockEventHandler struct {
 	events []*info.Event
 }
@@ -0,0 +1,99 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/cadvisor/lib/cache/memory"
+	info "github.com/google/cadvisor/lib/model"
+
+	"k8s.io/klog/v2"
+)
+
+// AllPods returns the Kubernetes pods of the tracked containers, keyed by
+// UID. Containers are grouped by the pod UID in their labels, or else by the
+// pod cgroup the kubelet created them in.
+func (m *manager) AllPods(query *info.ContainerInfoRequest) (map[string]info.PodInfo, error) {
+	return m.pods(query, "")
+}
+
+// Pod returns the Kubernetes pod with the given UID.
+func (m *manager) Pod(uid string, query *info.ContainerInfoRequest) (info.PodInfo, error) {
+	pods, err := m.pods(query, uid)
+	if err != nil {
+		return info.PodInfo{}, err
+	}
+	pod, ok := pods[uid]
+	if !ok {
+		return info.PodInfo{}, fmt.Errorf("unknown pod %q", uid)
+	}
+	return pod, nil
+}
+
+// pods aggregates the containers of every pod, or only of the pod with the
+// given UID if it is not empty.
+func (m *manager) pods(query *info.ContainerInfoRequest, uid string) (map[string]info.PodInfo, error) {
+	// Every sample is read, so that the samples of containers can be aligned,
+	// and only the most recent samples of pods are returned.
+	containerQuery := *query
+	containerQuery.NumStats = -1
+	containers := make(map[string][]*info.ContainerInfo)
+	refs := make(map[string]info.PodReference)
+	for name, cont := range m.getSubcontainers("/") {
+		cinfo, err := m.containerDataToContainerInfo(cont, &containerQuery)
+		if err != nil {
+			// Ignore the error because of race condition and return best-effort result.
+			if err == memory.ErrDataNotFound {
+				klog.V(4).Infof("Error getting data for container %s because of race condition", name)
+				continue
+			}
+			return nil, err
+		}
+		ref, ok := info.PodOf(name, cinfo.Spec.Labels)
+		if !ok || (uid != "" && ref.UID != uid) {
+			continue
+		}
+		containers[ref.UID] = append(containers[ref.UID], cinfo)
+		// Not every container of a pod need be labelled with its name.
+		if ref.Name != "" || refs[ref.UID].UID == "" {
+			refs[ref.UID] = ref
+		}
+	}
+
+	pods := make(map[string]info.PodInfo, len(containers))
+	for uid, conts := range containers {
+		sort.Slice(conts, func(i, j int) bool { return conts[i].Name < conts[j].Name })
+		pod := info.PodInfo{
+			PodReference: refs[uid],
+			Containers:   make([]info.ContainerReference, 0, len(conts)),
+		}
+		specs := make([]info.ContainerSpec, 0, len(conts))
+		stats := make([][]*info.ContainerStats, 0, len(conts))
+		for _, cont := range conts {
+			pod.Containers = append(pod.Containers, cont.ContainerReference)
+			specs = append(specs, cont.Spec)
+			stats = append(stats, cont.Stats)
+		}
+		pod.Spec = info.PodSpec(specs)
+		pod.Stats = info.PodStats(stats)
+		if query.NumStats >= 0 && len(pod.Stats) > query.NumStats {
+			pod.Stats = pod.Stats[len(pod.Stats)-query.NumStats:]
+		}
+		pods[uid] = pod
+	}
+	return pods, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	info "github.com/google/cadvisor/lib/model"
+
+	"k8s.io/utils/clock"
+)
+
+const testPodUID = "068e8fa0-9213-11e7-a01f-507b9d4141fa"
+
+// addPodContainer registers a tracked container with the given spec and one
+// stats sample per memory usage given, a second apart.
+func (m *manager) addPodContainer(t *testing.T, name string, spec info.ContainerSpec, memoryUsage ...uint64) {
+	t.Helper()
+	m.addPodContainerAt(t, name, spec, time.Unix(1700000000, 0), memoryUsage...)
+}
+
+// addPodContainerAt is addPodContainer with the first sample taken at start.
+func (m *manager) addPodContainerAt(t *testing.T, name string, spec info.ContainerSpec, start time.Time, memoryUsage ...uint64) {
+	t.Helper()
+	h := &mockHandler{ref: info.ContainerReference{Name: name}, spec: spec}
+	cont, err := newContainerData(name, m.memoryCache, h, time.Minute, false, clock.RealClock{})
+	require.NoError(t, err)
+	for i, usage := range memoryUsage {
+		stats := &info.ContainerStats{
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			Memory:    &info.MemoryStats{Usage: usage, WorkingSet: usage},
+		}
+		require.NoError(t, m.memoryCache.AddStats(&info.ContainerInfo{ContainerReference: h.ref}, stats))
+	}
+	m.containers.Store(namespacedContainerName{Name: name}, cont)
+}
+
+func TestAllPods(t *testing.T) {
+	m := newTestManager()
+	podLabels := map[string]string{
+		info.PodUIDLabel:       testPodUID,
+		info.PodNameLabel:      "web-0",
+		info.PodNamespaceLabel: "shop",
+	}
+	// Sandbox, labelled as the kubelet labels them.
+	m.addPodContainer(t, "/kubepods/burstable/pod"+testPodUID+"/sandbox", info.ContainerSpec{
+		HasCpu: true,
+		Cpu:    info.CpuSpec{Limit: 2},
+		Labels: map[string]string{info.ContainerNameLabel: "POD", info.PodUIDLabel: testPodUID},
+	}, 1, 1)
+	m.addPodContainer(t, "/kubepods/burstable/pod"+testPodUID+"/app", info.ContainerSpec{
+		HasCpu:    true,
+		Cpu:       info.CpuSpec{Limit: 512, Quota: 50000, Period: 100000},
+		HasMemory: true,
+		Memory:    info.MemorySpec{Limit: 256 << 20, Reservation: math.MaxUint64},
+		Labels:    podLabels,
+	}, 100, 200, 300)
+	// Not labelled, grouped by its cgroup.
+	m.addPodContainer(t, "/kubepods/burstable/pod"+testPodUID+"/sidecar", info.ContainerSpec{
+		HasCpu:    true,
+		Cpu:       info.CpuSpec{Limit: 102, Quota: 5000, Period: 10000},
+		HasMemory: true,
+		Memory:    info.MemorySpec{Limit: 64 << 20},
+	}, 10, 20)
+	// The pod cgroup itself and containers outside of pods are not grouped.
+	m.addPodContainer(t, "/kubepods/burstable/pod"+testPodUID, info.ContainerSpec{}, 1)
+	m.addPodContainer(t, "/system.slice/sshd.service", info.ContainerSpec{}, 1)
+
+	pods, err := m.AllPods(&info.ContainerInfoRequest{NumStats: -1})
+	require.NoError(t, err)
+	require.Len(t, pods, 1)
+	pod := pods[testPodUID]
+	assert.Equal(t, info.PodReference{UID: testPodUID, Name: "web-0", Namespace: "shop"}, pod.PodReference)
+	require.Len(t, pod.Containers, 3)
+	assert.Equal(t, "/kubepods/burstable/pod"+testPodUID+"/app", pod.Containers[0].Name)
+
+	// The sandbox does not count towards requests and limits.
+	assert.Equal(t, uint64(614), pod.Spec.Cpu.Limit)
+	assert.Equal(t, uint64(100000), pod.Spec.Cpu.Quota)
+	assert.Equal(t, uint64(100000), pod.Spec.Cpu.Period)
+	assert.Equal(t, uint64(320<<20), pod.Spec.Memory.Limit)
+	assert.Equal(t, uint64(0), pod.Spec.Memory.Reservation)
+
+	// Only up to the latest sample of every container, the samples of each
+	// second summed together.
+	require.Len(t, pod.Stats, 2)
+	assert.Equal(t, uint64(1+100+10), pod.Stats[0].Memory.Usage)
+	assert.Equal(t, uint64(1+200+20), pod.Stats[1].Memory.WorkingSet)
+	assert.Equal(t, time.Unix(1700000001, 0), pod.Stats[1].Timestamp)
+
+	pod, err = m.Pod(testPodUID, &info.ContainerInfoRequest{NumStats: 1})
+	assert.NoError(t, err)
+	require.Len(t, pod.Stats, 1)
+	assert.Equal(t, uint64(1+200+20), pod.Stats[0].Memory.Usage)
+	_, err = m.Pod("unknown", &info.ContainerInfoRequest{NumStats: 1})
+	assert.Error(t, err)
+}
+
+func TestPodUnlimited(t *testing.T) {
+	m := newTestManager()
+	m.addPodContainer(t, "/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod068e8fa0_9213_11e7_a01f_507b9d4141fa.slice/cri-containerd-a.scope", info.ContainerSpec{
+		HasCpu:    true,
+		Cpu:       info.CpuSpec{Limit: 2, Period: 100000},
+		HasMemory: true,
+		Memory:    info.MemorySpec{Limit: math.MaxUint64},
+	}, 1)
+
+	pod, err := m.Pod(testPodUID, &info.ContainerInfoRequest{NumStats: 1})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), pod.Spec.Cpu.Quota)
+	assert.Equal(t, uint64(math.MaxUint64), pod.Spec.Memory.Limit)
+}
+
+func TestPodStatsAligned(t *testing.T) {
+	m := newTestManager()
+	start := time.Unix(1700000000, 0)
+	labels := map[string]string{info.PodUIDLabel: testPodUID}
+	// "b" is housekept half a second after "a", and started later.
+	m.addPodContainerAt(t, "/kubepods/pod"+testPodUID+"/a", info.ContainerSpec{Labels: labels}, start, 10, 20, 30)
+	m.addPodContainerAt(t, "/kubepods/pod"+testPodUID+"/b", info.ContainerSpec{Labels: labels}, start.Add(1500*time.Millisecond), 200, 300, 400)
+
+	pod, err := m.Pod(testPodUID, &info.ContainerInfoRequest{NumStats: -1})
+	require.NoError(t, err)
+	// Pod samples are taken at the times of the samples of "a", whose latest
+	// is older, once "b" started, summing the latest sample of "b" before.
+	require.Len(t, pod.Stats, 1)
+	assert.Equal(t, start.Add(2*time.Second), pod.Stats[0].Timestamp)
+	assert.Equal(t, uint64(30+200), pod.Stats[0].Memory.Usage)
+}
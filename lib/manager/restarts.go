@@ -0,0 +1,198 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/cadvisor/lib/container"
+	info "github.com/google/cadvisor/lib/model"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	defaultRestartLoopThreshold = 5
+	defaultRestartLoopWindow    = 10 * time.Minute
+)
+
+// restartTracker counts how often containers are recreated under the same
+// key, see restartKey: their pod UID and container name for the containers
+// of a pod. A container created within the window after one with the same key
+// was destroyed counts as a restart; one recreated later counts as new.
+// Only containers that have been destroyed at least once are tracked, and
+// destroyed containers are forgotten once the window has passed. A nil tracker
+// tracks nothing.
+type restartTracker struct {
+	mu        sync.Mutex
+	threshold int
+	window    time.Duration
+	entries   map[string]*restartEntry
+	lastSweep time.Time
+}
+
+type restartEntry struct {
+	// Time the container was last destroyed; zero while it is running.
+	deletedAt time.Time
+	// Exit code of the last run.
+	exitCode int
+	// Total restarts seen.
+	restarts int
+	// Restart times within the window that have not yet been reported as a loop.
+	recent []time.Time
+}
+
+func newRestartTracker(threshold int, window time.Duration) *restartTracker {
+	return &restartTracker{
+		threshold: threshold,
+		window:    window,
+		entries:   make(map[string]*restartEntry),
+	}
+}
+
+func (t *restartTracker) setPolicy(threshold int, window time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.threshold = threshold
+	t.window = window
+}
+
+// recordCreation notes that the container tracked under key was created at
+// now and returns its restart count. If the restart completes a loop
+// (threshold restarts within the window) the loop's details are returned as
+// well; the recent restarts are then reset so the next loop is reported only
+// after another threshold restarts.
+func (t *restartTracker) recordCreation(key string, now time.Time) (int, *info.RestartLoopEventData) {
+	if t == nil {
+		return 0, nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e, ok := t.entries[key]
+	if !ok || e.deletedAt.IsZero() {
+		return 0, nil
+	}
+	if now.Sub(e.deletedAt) > t.window {
+		delete(t.entries, key)
+		return 0, nil
+	}
+
+	e.deletedAt = time.Time{}
+	e.restarts++
+	recent := e.recent[:0]
+	for _, ts := range e.recent {
+		if now.Sub(ts) <= t.window {
+			recent = append(recent, ts)
+		}
+	}
+	e.recent = append(recent, now)
+
+	if t.threshold <= 0 || len(e.recent) < t.threshold {
+		return e.restarts, nil
+	}
+	loop := &info.RestartLoopEventData{
+		Restarts: len(e.recent),
+		Window:   t.window,
+		ExitCode: e.exitCode,
+	}
+	e.recent = nil
+	return e.restarts, loop
+}
+
+// recordDeletion notes that the container tracked under key was destroyed at
+// now.
+func (t *restartTracker) recordDeletion(key string, now time.Time, exitCode int) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sweep(now)
+	e, ok := t.entries[key]
+	if !ok {
+		e = &restartEntry{}
+		t.entries[key] = e
+	}
+	e.deletedAt = now
+	e.exitCode = exitCode
+}
+
+// sweep forgets destroyed containers that were not recreated within the
+// window. It runs at most once per window. Must be called with mu held.
+func (t *restartTracker) sweep(now time.Time) {
+	if now.Sub(t.lastSweep) < t.window {
+		return
+	}
+	t.lastSweep = now
+	for name, e := range t.entries {
+		if !e.deletedAt.IsZero() && now.Sub(e.deletedAt) > t.window {
+			delete(t.entries, name)
+		}
+	}
+}
+
+// restartKey returns the name a container is tracked under across restarts.
+// The kubelet recreates the containers of a pod under a new ID and cgroup, so
+// they are tracked by pod UID and container name, from their labels; other
+// containers by their cgroup name, which runtimes reuse.
+func restartKey(name string, labels map[string]string) string {
+	podUID, containerName := labels[info.PodUIDLabel], labels[info.ContainerNameLabel]
+	if podUID == "" || containerName == "" {
+		return name
+	}
+	return "pod:" + podUID + "/" + containerName
+}
+
+// SetRestartLoopPolicy configures restart-loop detection: a restartLoop event
+// is emitted when a container is recreated threshold times within window.
+// A threshold of zero or less disables the event; restarts are still counted.
+func (m *manager) SetRestartLoopPolicy(threshold int, window time.Duration) {
+	m.restarts.setPolicy(threshold, window)
+}
+
+// getExitStatus returns how the container exited. Handlers implementing
+// container.ExitStatusHandler are asked directly; otherwise only the exit code
+// is known. Missing start and finish times are filled from the container's
+// spec and the current time, and a missing signal is derived from the code.
+func getExitStatus(cont *containerData, now time.Time) container.ExitStatus {
+	var status container.ExitStatus
+	var err error
+	if h, ok := cont.handler.(container.ExitStatusHandler); ok {
+		status, err = h.GetExitStatus()
+	} else {
+		status.ExitCode, err = cont.handler.GetExitCode()
+	}
+	if err != nil {
+		klog.V(4).Infof("Could not retrieve exit status for container %q: %v (using -1)", cont.info.Name, err)
+		status.ExitCode = -1
+	}
+
+	if status.StartedAt.IsZero() {
+		cont.lock.Lock()
+		status.StartedAt = cont.info.Spec.StartTime
+		if status.StartedAt.IsZero() {
+			status.StartedAt = cont.info.Spec.CreationTime
+		}
+		cont.lock.Unlock()
+	}
+	if status.FinishedAt.IsZero() {
+		status.FinishedAt = now
+	}
+	if status.Signal == 0 {
+		status.Signal = container.SignalFromExitCode(status.ExitCode)
+	}
+	return status
+}
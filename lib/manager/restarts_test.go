@@ -0,0 +1,152 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/cadvisor/lib/cache/memory"
+	containertest "github.com/google/cadvisor/lib/container/testing"
+	info "github.com/google/cadvisor/lib/model"
+	"github.com/google/cadvisor/lib/stats"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartTrackerCountsRecreation(t *testing.T) {
+	tracker := newRestartTracker(3, time.Minute)
+	now := time.Unix(1000, 0)
+
+	count, loop := tracker.recordCreation("/a", now)
+	assert.Equal(t, 0, count)
+	assert.Nil(t, loop)
+
+	for i := 1; i <= 2; i++ {
+		now = now.Add(time.Second)
+		tracker.recordDeletion("/a", now, 1)
+		now = now.Add(time.Second)
+		count, loop = tracker.recordCreation("/a", now)
+		assert.Equal(t, i, count)
+		assert.Nil(t, loop)
+	}
+
+	now = now.Add(time.Second)
+	tracker.recordDeletion("/a", now, 137)
+	now = now.Add(time.Second)
+	count, loop = tracker.recordCreation("/a", now)
+	assert.Equal(t, 3, count)
+	if assert.NotNil(t, loop) {
+		assert.Equal(t, 3, loop.Restarts)
+		assert.Equal(t, time.Minute, loop.Window)
+		assert.Equal(t, 137, loop.ExitCode)
+	}
+
+	// The loop was reported; the next restart starts a new count towards it.
+	now = now.Add(time.Second)
+	tracker.recordDeletion("/a", now, 137)
+	count, loop = tracker.recordCreation("/a", now.Add(time.Second))
+	assert.Equal(t, 4, count)
+	assert.Nil(t, loop)
+}
+
+func TestRestartTrackerOutsideWindow(t *testing.T) {
+	tracker := newRestartTracker(2, time.Minute)
+	now := time.Unix(1000, 0)
+
+	tracker.recordDeletion("/a", now, 0)
+	count, loop := tracker.recordCreation("/a", now.Add(2*time.Minute))
+	assert.Equal(t, 0, count)
+	assert.Nil(t, loop)
+	assert.Empty(t, tracker.entries)
+
+	// Destroyed containers that are never recreated are swept.
+	tracker.recordDeletion("/b", now, 0)
+	tracker.recordDeletion("/c", now.Add(2*time.Minute), 0)
+	assert.NotContains(t, tracker.entries, "/b")
+	assert.Contains(t, tracker.entries, "/c")
+}
+
+func TestRestartTrackerDisabledLoop(t *testing.T) {
+	tracker := newRestartTracker(0, time.Minute)
+	now := time.Unix(1000, 0)
+	for i := 0; i < 5; i++ {
+		tracker.recordDeletion("/a", now, 1)
+		_, loop := tracker.recordCreation("/a", now)
+		assert.Nil(t, loop)
+	}
+	count, _ := tracker.recordCreation("/a", now)
+	assert.Equal(t, 0, count, "a running container is not a restart")
+}
+
+func TestDestroyContainerExitStatus(t *testing.T) {
+	mockHandler := containertest.NewMockContainerHandler("/test")
+	mockHandler.On("GetExitCode").Return(137, nil)
+
+	memoryCache := memory.New(60*time.Second, nil)
+	m := &manager{
+		quitChannels: make([]chan error, 0, 2),
+		memoryCache:  memoryCache,
+		restarts:     newRestartTracker(defaultRestartLoopThreshold, defaultRestartLoopWindow),
+	}
+	startTime := time.Now().Add(-time.Hour)
+	cont := &containerData{
+		handler:          mockHandler,
+		memoryCache:      memoryCache,
+		perfCollector:    &stats.NoopCollector{},
+		resctrlCollector: &stats.NoopCollector{},
+		info: containerInfo{
+			ContainerReference: info.ContainerReference{
+				Name: "/test",
+			},
+			Spec: info.ContainerSpec{
+				CreationTime: startTime,
+			},
+		},
+		stop: make(chan struct{}),
+	}
+	m.containers.Store(namespacedContainerName{Name: "/test"}, cont)
+	sink := &mockEventHandler{}
+	m.eventSink = sink
+
+	assert.NoError(t, m.destroyContainer("/test"))
+
+	if assert.Len(t, sink.events, 1) {
+		deletion := sink.events[0].EventData.ContainerDeletion
+		assert.Equal(t, 137, deletion.ExitCode)
+		assert.Equal(t, 9, deletion.Signal)
+		assert.InDelta(t, time.Hour, deletion.Runtime, float64(time.Minute))
+	}
+	assert.Contains(t, m.restarts.entries, "/test")
+}
+
+func TestRestartKey(t *testing.T) {
+	assert.Equal(t, "/docker/abc", restartKey("/docker/abc", map[string]string{"app": "web"}))
+	// The kubelet recreates containers with a new ID, within the same pod.
+	labels := map[string]string{
+		info.PodUIDLabel:        "0e2d5e4c-7b1a-4f5e-9d3c-1a2b3c4d5e6f",
+		info.ContainerNameLabel: "web",
+	}
+	first := restartKey("/kubepods/pod0e2d5e4c-7b1a-4f5e-9d3c-1a2b3c4d5e6f/aaa", labels)
+	second := restartKey("/kubepods/pod0e2d5e4c-7b1a-4f5e-9d3c-1a2b3c4d5e6f/bbb", labels)
+	assert.Equal(t, first, second)
+
+	tracker := newRestartTracker(2, time.Minute)
+	now := time.Unix(1000, 0)
+	tracker.recordCreation(first, now)
+	tracker.recordDeletion(first, now.Add(time.Second), 1)
+	count, _ := tracker.recordCreation(second, now.Add(2*time.Second))
+	assert.Equal(t, 1, count)
+}
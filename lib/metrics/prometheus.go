@@ -55,6 +55,180 @@ func fsValues(fsStats []info.FsStats, valueFn func(*info.FsStats) float64, times
 	return values
 }
 
+// fsForecastValues is a helper method for assembling the forecasts of the
+// usage of filesystems, for those that have one.
+func fsForecastValues(fsStats []info.FsStats, valueFn func(*info.FsForecast) (float64, bool), timestamp time.Time) metricValues {
+	var values metricValues
+	for _, stat := range fsStats {
+		if stat.Forecast == nil {
+			continue
+		}
+		value, ok := valueFn(stat.Forecast)
+		if !ok {
+			continue
+		}
+		values = append(values, metricValue{
+			value:     value,
+			labels:    []string{stat.Device},
+			timestamp: timestamp,
+		})
+	}
+	return values
+}
+
+// fsIoRateValues is a helper method for assembling the I/O rates of the
+// devices of filesystems, for those that have them.
+func fsIoRateValues(fsStats []info.FsStats, valueFn func(*info.DiskIoRates) float64, timestamp time.Time) metricValues {
+	var values metricValues
+	for _, stat := range fsStats {
+		if stat.IoRates == nil {
+			continue
+		}
+		values = append(values, metricValue{
+			value:     valueFn(stat.IoRates),
+			labels:    []string{stat.Device},
+			timestamp: timestamp,
+		})
+	}
+	return values
+}
+
+// fsOverlayValues is a helper method for assembling the usage of the overlay
+// layers of filesystems, for those that are the root overlay of a container.
+func fsOverlayValues(fsStats []info.FsStats, valueFn func(*info.OverlayStats) float64, timestamp time.Time) metricValues {
+	var values metricValues
+	for _, stat := range fsStats {
+		if stat.Overlay == nil {
+			continue
+		}
+		values = append(values, metricValue{
+			value:     valueFn(stat.Overlay),
+			labels:    []string{stat.Device},
+			timestamp: timestamp,
+		})
+	}
+	return values
+}
+
+// fsAllocationValues is a helper method for assembling per-filesystem
+// allocation stats.
+func fsAllocationValues(fsStats []info.FsStats, valueFn func(*info.FsAllocation) float64, timestamp time.Time) metricValues {
+	var values metricValues
+	for _, stat := range fsStats {
+		for _, allocation := range stat.Allocations {
+			values = append(values, metricValue{
+				value:     valueFn(&allocation),
+				labels:    []string{stat.Device, allocation.Type, allocation.Profile},
+				timestamp: timestamp,
+			})
+		}
+	}
+	return values
+}
+
+// btrfsQgroupValues is a helper method for assembling the qgroup stats of the
+// btrfs subvolumes of a container.
+func btrfsQgroupValues(fsStats []info.FsStats, valueFn func(*info.BtrfsQgroupStats) float64, timestamp time.Time) metricValues {
+	var values metricValues
+	for _, stat := range fsStats {
+		if stat.Btrfs == nil {
+			continue
+		}
+		values = append(values, metricValue{
+			value:     valueFn(stat.Btrfs),
+			labels:    []string{stat.Device},
+			timestamp: timestamp,
+		})
+	}
+	return values
+}
+
+// zfsDatasetValues is a helper method for assembling the stats of the ZFS
+// datasets of a container.
+func zfsDatasetValues(fsStats []info.FsStats, valueFn func(*info.ZfsDatasetStats) float64, timestamp time.Time) metricValues {
+	var values metricValues
+	for _, stat := range fsStats {
+		if stat.Zfs == nil {
+			continue
+		}
+		values = append(values, metricValue{
+			value:     valueFn(stat.Zfs),
+			labels:    []string{stat.Device, stat.Zfs.Dataset},
+			timestamp: timestamp,
+		})
+	}
+	return values
+}
+
+// volumeValues is a helper method for assembling per-volume stats.
+func volumeValues(volumes []info.VolumeStats, valueFn func(*info.VolumeStats) float64, timestamp time.Time) metricValues {
+	values := make(metricValues, 0, len(volumes))
+	for _, volume := range volumes {
+		values = append(values, metricValue{
+			value:     valueFn(&volume),
+			labels:    []string{volume.Device, volume.MountPoint},
+			timestamp: timestamp,
+		})
+	}
+	return values
+}
+
+// nfsMount is an NFS mount of a container, on its filesystems or volumes.
+type nfsMount struct {
+	device string
+	stats  *info.NfsStats
+}
+
+// nfsMounts returns the NFS mounts of the container once per device, as the
+// client statistics of a device are shared by all its mounts.
+func nfsMounts(s *info.ContainerStats) []nfsMount {
+	var mounts []nfsMount
+	seen := make(map[string]bool)
+	add := func(device string, stats *info.NfsStats) {
+		if stats == nil || seen[device] {
+			return
+		}
+		seen[device] = true
+		mounts = append(mounts, nfsMount{device: device, stats: stats})
+	}
+	for _, stat := range s.Filesystem {
+		add(stat.Device, stat.Nfs)
+	}
+	for _, volume := range s.Volumes {
+		add(volume.Device, volume.Nfs)
+	}
+	return mounts
+}
+
+// nfsValues is a helper method for assembling per-mount NFS client stats.
+func nfsValues(s *info.ContainerStats, valueFn func(*info.NfsStats) float64) metricValues {
+	var values metricValues
+	for _, mount := range nfsMounts(s) {
+		values = append(values, metricValue{
+			value:     valueFn(mount.stats),
+			labels:    []string{mount.device, mount.stats.Server, mount.stats.Export},
+			timestamp: s.Timestamp,
+		})
+	}
+	return values
+}
+
+// nfsOpValues is a helper method for assembling per-mount, per-operation NFS
+// client stats.
+func nfsOpValues(s *info.ContainerStats, valueFn func(*info.NfsOpStats) float64) metricValues {
+	var values metricValues
+	for _, mount := range nfsMounts(s) {
+		for _, op := range mount.stats.Ops {
+			values = append(values, metricValue{
+				value:     valueFn(&op),
+				labels:    []string{mount.device, mount.stats.Server, mount.stats.Export, op.Op},
+				timestamp: s.Timestamp,
+			})
+		}
+	}
+	return values
+}
+
 // ioValues is a helper method for assembling per-disk and per-filesystem stats.
 func ioValues(ioStats []info.PerDiskStats, ioType string, ioValueFn func(uint64) float64,
 	fsStats []info.FsStats, valueFn func(*info.FsStats) float64, timestamp time.Time) metricValues {
@@ -757,6 +931,209 @@ func NewPrometheusCollector(i infoProvider, f ContainerLabelsFunc, includedMetri
 						return float64(fs.Usage)
 					}, s.Timestamp)
 				},
+			}, {
+				name:        "container_fs_fill_rate_bytes_per_second",
+				help:        "Number of bytes per second the usage of the filesystem grows by, from a linear fit to its recent usage.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsForecastValues(s.Filesystem, func(f *info.FsForecast) (float64, bool) {
+						return f.FillRate, true
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_time_to_full_seconds",
+				help:        "Number of seconds until the filesystem is full at the rate its usage grows by, if it grows.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsForecastValues(s.Filesystem, func(f *info.FsForecast) (float64, bool) {
+						if f.TimeToFull == nil {
+							return 0, false
+						}
+						return *f.TimeToFull, true
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_overlay_upper_bytes",
+				help:        "Number of bytes used by the upper layer of the overlay root filesystem of the container, which it writes to.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsOverlayValues(s.Filesystem, func(o *info.OverlayStats) float64 {
+						return float64(o.UpperUsage)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_overlay_lower_bytes",
+				help:        "Number of bytes used by the lower layers of the overlay root filesystem of the container, shared with its image.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsOverlayValues(s.Filesystem, func(o *info.OverlayStats) float64 {
+						return float64(o.LowerUsage)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_overlay_lower_layers",
+				help:        "Number of lower layers of the overlay root filesystem of the container.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsOverlayValues(s.Filesystem, func(o *info.OverlayStats) float64 {
+						return float64(o.LowerLayers)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_overlay_upper_growth_bytes_per_second",
+				help:        "Number of bytes per second the upper layer of the overlay root filesystem of the container grew by since the previous measurement.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsOverlayValues(s.Filesystem, func(o *info.OverlayStats) float64 {
+						return o.UpperGrowthRate
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_overlay_copy_up_heavy",
+				help:        "1 if the upper layer of the overlay root filesystem of the container grows faster than the copy up threshold, 0 otherwise.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsOverlayValues(s.Filesystem, func(o *info.OverlayStats) float64 {
+						return boolToFloat(o.CopyUpHeavy)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_allocation_bytes",
+				help:        "Number of bytes the filesystem allocated to a type of block groups with a given profile.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device", "type", "profile"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsAllocationValues(s.Filesystem, func(a *info.FsAllocation) float64 {
+						return float64(a.Total)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_allocation_used_bytes",
+				help:        "Number of bytes used within the block groups of a type and profile the filesystem allocated.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device", "type", "profile"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsAllocationValues(s.Filesystem, func(a *info.FsAllocation) float64 {
+						return float64(a.Used)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_btrfs_referenced_bytes",
+				help:        "Number of bytes the btrfs subvolume of the container refers to, as accounted by its qgroup, including those shared with its image.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return btrfsQgroupValues(s.Filesystem, func(q *info.BtrfsQgroupStats) float64 {
+						return float64(q.Referenced)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_btrfs_exclusive_bytes",
+				help:        "Number of bytes only the btrfs subvolume of the container refers to, as accounted by its qgroup.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return btrfsQgroupValues(s.Filesystem, func(q *info.BtrfsQgroupStats) float64 {
+						return float64(q.Exclusive)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_zfs_used_bytes",
+				help:        "Number of bytes used by the ZFS dataset of the container, including its snapshots.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device", "dataset"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return zfsDatasetValues(s.Filesystem, func(ds *info.ZfsDatasetStats) float64 {
+						return float64(ds.Used)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_zfs_referenced_bytes",
+				help:        "Number of bytes referenced by the ZFS dataset of the container, including those shared with its image.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device", "dataset"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return zfsDatasetValues(s.Filesystem, func(ds *info.ZfsDatasetStats) float64 {
+						return float64(ds.Referenced)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_zfs_snapshots_bytes",
+				help:        "Number of bytes used by the snapshots of the ZFS dataset of the container.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device", "dataset"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return zfsDatasetValues(s.Filesystem, func(ds *info.ZfsDatasetStats) float64 {
+						return float64(ds.UsedBySnapshots)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_zfs_compress_ratio",
+				help:        "Compression ratio of the data referenced by the ZFS dataset of the container.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device", "dataset"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return zfsDatasetValues(s.Filesystem, func(ds *info.ZfsDatasetStats) float64 {
+						return ds.CompressRatio
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_volume_capacity_bytes",
+				help:        "Number of bytes of the filesystem of the volume mounted in the container.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device", "mountpoint"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return volumeValues(s.Volumes, func(v *info.VolumeStats) float64 {
+						return float64(v.Limit)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_volume_usage_bytes",
+				help:        "Number of bytes used on the filesystem of the volume mounted in the container.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device", "mountpoint"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return volumeValues(s.Volumes, func(v *info.VolumeStats) float64 {
+						return float64(v.Usage)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_volume_available_bytes",
+				help:        "Number of bytes available to the container on the filesystem of the volume mounted in it.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device", "mountpoint"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return volumeValues(s.Volumes, func(v *info.VolumeStats) float64 {
+						return float64(v.Available)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_volume_inodes_total",
+				help:        "Number of inodes of the filesystem of the volume mounted in the container.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device", "mountpoint"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return volumeValues(s.Volumes, func(v *info.VolumeStats) float64 {
+						return float64(v.Inodes)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_volume_inodes_free",
+				help:        "Number of free inodes on the filesystem of the volume mounted in the container.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device", "mountpoint"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return volumeValues(s.Volumes, func(v *info.VolumeStats) float64 {
+						return float64(v.InodesFree)
+					}, s.Timestamp)
+				},
 			},
 		}...)
 	}
@@ -972,6 +1349,126 @@ func NewPrometheusCollector(i infoProvider, f ContainerLabelsFunc, includedMetri
 						return float64(fs.WeightedIoTime) / float64(time.Second)
 					}, s.Timestamp)
 				},
+			}, {
+				name:        "container_fs_discards_total",
+				help:        "Cumulative count of discards completed",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsValues(s.Filesystem, func(fs *info.FsStats) float64 {
+						return float64(fs.DiscardsCompleted)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_discards_merged_total",
+				help:        "Cumulative count of discards merged",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsValues(s.Filesystem, func(fs *info.FsStats) float64 {
+						return float64(fs.DiscardsMerged)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_sector_discards_total",
+				help:        "Cumulative count of sectors discarded",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsValues(s.Filesystem, func(fs *info.FsStats) float64 {
+						return float64(fs.SectorsDiscarded)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_discard_seconds_total",
+				help:        "Cumulative count of seconds spent discarding",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsValues(s.Filesystem, func(fs *info.FsStats) float64 {
+						return float64(fs.DiscardTime) / 1000
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_flushes_total",
+				help:        "Cumulative count of flushes completed",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsValues(s.Filesystem, func(fs *info.FsStats) float64 {
+						return float64(fs.FlushesCompleted)
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_flush_seconds_total",
+				help:        "Cumulative count of seconds spent flushing",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsValues(s.Filesystem, func(fs *info.FsStats) float64 {
+						return float64(fs.FlushTime) / 1000
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_io_utilization_ratio",
+				help:        "Fraction of the time the device was busy with I/O between the last two samples of the filesystems of the machine.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsIoRateValues(s.Filesystem, func(r *info.DiskIoRates) float64 {
+						return r.Utilization / 100
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_io_queue_size",
+				help:        "Average number of requests queued or being serviced by the device between the last two samples of the filesystems of the machine.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsIoRateValues(s.Filesystem, func(r *info.DiskIoRates) float64 {
+						return r.QueueSize
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_read_await_seconds",
+				help:        "Average seconds the reads completed between the last two samples of the filesystems of the machine took, from being queued to completing.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsIoRateValues(s.Filesystem, func(r *info.DiskIoRates) float64 {
+						return r.ReadAwait
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_write_await_seconds",
+				help:        "Average seconds the writes completed between the last two samples of the filesystems of the machine took, from being queued to completing.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsIoRateValues(s.Filesystem, func(r *info.DiskIoRates) float64 {
+						return r.WriteAwait
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_discard_await_seconds",
+				help:        "Average seconds the discards completed between the last two samples of the filesystems of the machine took, from being queued to completing.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsIoRateValues(s.Filesystem, func(r *info.DiskIoRates) float64 {
+						return r.DiscardAwait
+					}, s.Timestamp)
+				},
+			}, {
+				name:        "container_fs_flush_await_seconds",
+				help:        "Average seconds the flushes completed between the last two samples of the filesystems of the machine took, from being queued to completing.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{"device"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return fsIoRateValues(s.Filesystem, func(r *info.DiskIoRates) float64 {
+						return r.FlushAwait
+					}, s.Timestamp)
+				},
 			}, {
 				name:        "container_fs_io_cost_usage_seconds_total",
 				help:        "Cumulative IOCost usage in seconds",
@@ -1057,6 +1554,66 @@ func NewPrometheusCollector(i infoProvider, f ContainerLabelsFunc, includedMetri
 					}
 					return values
 				},
+			}, {
+				name:        "container_fs_nfs_read_bytes_total",
+				help:        "Cumulative count of bytes read from the NFS server",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device", "server", "export"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return nfsValues(s, func(n *info.NfsStats) float64 {
+						return float64(n.ReadBytes)
+					})
+				},
+			}, {
+				name:        "container_fs_nfs_write_bytes_total",
+				help:        "Cumulative count of bytes written to the NFS server",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device", "server", "export"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return nfsValues(s, func(n *info.NfsStats) float64 {
+						return float64(n.WriteBytes)
+					})
+				},
+			}, {
+				name:        "container_fs_nfs_requests_total",
+				help:        "Cumulative count of NFS requests by operation",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device", "server", "export", "operation"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return nfsOpValues(s, func(op *info.NfsOpStats) float64 {
+						return float64(op.Requests)
+					})
+				},
+			}, {
+				name:        "container_fs_nfs_retransmissions_total",
+				help:        "Cumulative count of NFS request retransmissions by operation",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device", "server", "export", "operation"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return nfsOpValues(s, func(op *info.NfsOpStats) float64 {
+						return float64(op.Retransmissions)
+					})
+				},
+			}, {
+				name:        "container_fs_nfs_rtt_seconds_total",
+				help:        "Cumulative count of seconds between sending NFS requests and receiving their replies, by operation",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device", "server", "export", "operation"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return nfsOpValues(s, func(op *info.NfsOpStats) float64 {
+						return float64(op.Rtt) / float64(time.Second/time.Millisecond)
+					})
+				},
+			}, {
+				name:        "container_fs_nfs_execute_seconds_total",
+				help:        "Cumulative count of seconds between queueing NFS requests and their completion, by operation",
+				valueType:   prometheus.CounterValue,
+				extraLabels: []string{"device", "server", "export", "operation"},
+				getValues: func(s *info.ContainerStats) metricValues {
+					return nfsOpValues(s, func(op *info.NfsOpStats) float64 {
+						return float64(op.ExecuteTime) / float64(time.Second/time.Millisecond)
+					})
+				},
 			},
 		}...)
 	}
@@ -2167,6 +2724,7 @@ var (
 	versionInfoDesc  = prometheus.NewDesc("cadvisor_version_info", "A metric with a constant '1' value labeled by kernel version, OS version, docker version, cadvisor version & cadvisor revision.", []string{"kernelVersion", "osVersion", "dockerVersion", "cadvisorVersion", "cadvisorRevision"}, nil)
 	creationTimeDesc = prometheus.NewDesc("container_creation_time_seconds", "Container creation time since unix epoch in seconds.", nil, nil)
 	startTimeDesc    = prometheus.NewDesc("container_start_time_seconds", "Start time of the container since unix epoch in seconds.", nil, nil)
+	restartsDesc     = prometheus.NewDesc("container_restarts_total", "Number of times the container has been recreated under the same name.", nil, nil)
 	cpuPeriodDesc    = prometheus.NewDesc("container_spec_cpu_period", "CPU period of the container.", nil, nil)
 	cpuQuotaDesc     = prometheus.NewDesc("container_spec_cpu_quota", "CPU quota of the container.", nil, nil)
 	cpuSharesDesc    = prometheus.NewDesc("container_spec_cpu_shares", "CPU share of the container.", nil, nil)
@@ -2181,6 +2739,7 @@ func (c *PrometheusCollector) Describe(ch chan<- *prometheus.Desc) {
 	}
 	ch <- creationTimeDesc
 	ch <- startTimeDesc
+	ch <- restartsDesc
 	ch <- cpuPeriodDesc
 	ch <- cpuQuotaDesc
 	ch <- cpuSharesDesc
@@ -2207,11 +2766,45 @@ const (
 	LabelName = "name"
 	// LabelImage is the name of the image label.
 	LabelImage = "image"
+	// LabelPodName is the name of the label of the Kubernetes pod name.
+	LabelPodName = "pod_name"
+	// LabelPodNamespace is the name of the label of the Kubernetes pod
+	// namespace.
+	LabelPodNamespace = "namespace"
+	// LabelPodUID is the name of the label of the Kubernetes pod UID.
+	LabelPodUID = "pod_uid"
+	// LabelRootlessUID is the name of the label of the UID of the user
+	// running a rootless container.
+	LabelRootlessUID = "rootless_uid"
 )
 
+// addPodLabels sets the pod labels of the container, if it belongs to a
+// Kubernetes pod.
+func addPodLabels(set map[string]string, container *info.ContainerInfo) {
+	pod, ok := info.PodOf(container.Name, container.Spec.Labels)
+	if !ok {
+		return
+	}
+	set[LabelPodUID] = pod.UID
+	if pod.Name != "" {
+		set[LabelPodName] = pod.Name
+	}
+	if pod.Namespace != "" {
+		set[LabelPodNamespace] = pod.Namespace
+	}
+}
+
+// addRootlessUIDLabel sets the rootless UID label of the container, if a
+// rootless runtime runs it.
+func addRootlessUIDLabel(set map[string]string, container *info.ContainerInfo) {
+	if user := container.Spec.User; user != "" {
+		set[LabelRootlessUID] = user
+	}
+}
+
 // DefaultContainerLabels implements ContainerLabelsFunc. It exports the
-// container name, first alias, image name as well as all its env and label
-// values.
+// container name, first alias, image name, pod_name, namespace, pod_uid,
+// rootless_uid as well as all its env and label values.
 func DefaultContainerLabels(container *info.ContainerInfo) map[string]string {
 	set := map[string]string{LabelID: container.Name}
 	if len(container.Aliases) > 0 {
@@ -2220,6 +2813,8 @@ func DefaultContainerLabels(container *info.ContainerInfo) map[string]string {
 	if image := container.Spec.Image; len(image) > 0 {
 		set[LabelImage] = image
 	}
+	addPodLabels(set, container)
+	addRootlessUIDLabel(set, container)
 	for k, v := range container.Spec.Labels {
 		set[ContainerLabelPrefix+k] = v
 	}
@@ -2230,7 +2825,8 @@ func DefaultContainerLabels(container *info.ContainerInfo) map[string]string {
 }
 
 // BaseContainerLabels returns a ContainerLabelsFunc that exports the container
-// name, first alias, image name as well as all its white listed env and label values.
+// name, first alias, image name, pod_name, namespace, pod_uid, rootless_uid as
+// well as all its white listed env and label values.
 func BaseContainerLabels(whiteList []string) func(container *info.ContainerInfo) map[string]string {
 	whiteListMap := make(map[string]struct{}, len(whiteList))
 	for _, k := range whiteList {
@@ -2245,6 +2841,8 @@ func BaseContainerLabels(whiteList []string) func(container *info.ContainerInfo)
 		if image := container.Spec.Image; len(image) > 0 {
 			set[LabelImage] = image
 		}
+		addPodLabels(set, container)
+		addRootlessUIDLabel(set, container)
 		for k, v := range container.Spec.Labels {
 			if _, ok := whiteListMap[k]; ok {
 				set[ContainerLabelPrefix+k] = v
@@ -2301,6 +2899,9 @@ func (c *PrometheusCollector) collectContainersInfo(ch chan<- prometheus.Metric)
 		}
 		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(startTime.Unix()), values...)
 
+		desc = prometheus.NewDesc("container_restarts_total", "Number of times the container has been recreated under the same name.", labels, nil)
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, float64(cont.Spec.RestartCount), values...)
+
 		if cont.Spec.HasCpu {
 			desc = prometheus.NewDesc("container_spec_cpu_period", "CPU period of the container.", labels, nil)
 			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(cont.Spec.Cpu.Period), values...)
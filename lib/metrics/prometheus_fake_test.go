@@ -34,6 +34,7 @@ func (p testSubcontainersInfoProvider) GetVersionInfo() (*info.VersionInfo, erro
 }
 
 func (p testSubcontainersInfoProvider) GetMachineInfo() (*info.MachineInfo, error) {
+	diskCriticalTemperature := 84.85
 	return &info.MachineInfo{
 		Timestamp:        time.Unix(1395066363, 0),
 		NumCores:         4,
@@ -51,6 +52,27 @@ func (p testSubcontainersInfoProvider) GetMachineInfo() (*info.MachineInfo, erro
 		MachineID:  "machine-id-test",
 		SystemUUID: "system-uuid-test",
 		BootID:     "boot-id-test",
+		DiskMap: map[string]info.DiskInfo{
+			"8:0": {
+				Name: "sda", Major: 8, Minor: 0, Size: 1000204886016, Scheduler: "mq-deadline",
+				Rotational: true, LogicalBlockSize: 512, PhysicalBlockSize: 4096, QueueDepth: 64,
+			},
+			"259:0": {
+				Name: "nvme0n1", Major: 259, Minor: 0, Size: 512110190592, Scheduler: "none",
+				LogicalBlockSize: 512, PhysicalBlockSize: 512, QueueDepth: 1023, Discard: true,
+				CriticalTemperature: &diskCriticalTemperature,
+			},
+			"9:0": {
+				Name: "md0", Major: 9, Minor: 0, Size: 1000069595136, Scheduler: "none",
+				LogicalBlockSize: 512, PhysicalBlockSize: 4096, QueueDepth: 128,
+				Raid: &info.RaidInfo{Level: "raid1", Disks: 2},
+			},
+			"253:0": {
+				Name: "dm-0", Major: 253, Minor: 0, Size: 107374182400, Scheduler: "none",
+				LogicalBlockSize: 512, PhysicalBlockSize: 4096, QueueDepth: 128, Discard: true,
+				DeviceMapper: &info.DeviceMapperInfo{Name: "vg0-root", UUID: "LVM-abc"},
+			},
+		},
 		Topology: []info.Node{
 			{
 				Id:     0,
@@ -273,6 +295,7 @@ func (p testSubcontainersInfoProvider) GetMachineInfo() (*info.MachineInfo, erro
 }
 
 func (p testSubcontainersInfoProvider) GetRequestedContainersInfo(string, info.RequestOptions) (map[string]*info.ContainerInfo, error) {
+	timeToFull := 69.0
 	return map[string]*info.ContainerInfo{
 		"testcontainer": {
 			ContainerReference: info.ContainerReference{
@@ -299,6 +322,7 @@ func (p testSubcontainersInfoProvider) GetRequestedContainersInfo(string, info.R
 				},
 				CreationTime: time.Unix(1257894000, 0),
 				StartTime:    time.Unix(1257895000, 0),
+				RestartCount: 2,
 				Labels: map[string]string{
 					"foo.label": "bar",
 				},
@@ -647,24 +671,85 @@ func (p testSubcontainersInfoProvider) GetRequestedContainersInfo(string, info.R
 							IoInProgress:    42,
 							IoTime:          43,
 							WeightedIoTime:  44,
+							Zfs: &info.ZfsDatasetStats{
+								Dataset:         "tank/docker/abc",
+								Used:            54,
+								Referenced:      55,
+								UsedBySnapshots: 56,
+								CompressRatio:   1.5,
+							},
+							Btrfs: &info.BtrfsQgroupStats{
+								Referenced: 57,
+								Exclusive:  58,
+							},
 						},
 						{
-							Device:          "sda2",
-							InodesFree:      262144,
-							Inodes:          2097152,
-							Limit:           37,
-							Usage:           38,
-							ReadsCompleted:  39,
-							ReadsMerged:     40,
-							SectorsRead:     41,
-							ReadTime:        42,
-							WritesCompleted: 43,
-							WritesMerged:    44,
-							SectorsWritten:  45,
-							WriteTime:       46,
-							IoInProgress:    47,
-							IoTime:          48,
-							WeightedIoTime:  49,
+							Device:            "sda2",
+							InodesFree:        262144,
+							Inodes:            2097152,
+							Limit:             37,
+							Usage:             38,
+							ReadsCompleted:    39,
+							ReadsMerged:       40,
+							SectorsRead:       41,
+							ReadTime:          42,
+							WritesCompleted:   43,
+							WritesMerged:      44,
+							SectorsWritten:    45,
+							WriteTime:         46,
+							IoInProgress:      47,
+							IoTime:            48,
+							WeightedIoTime:    49,
+							DiscardsCompleted: 74,
+							DiscardsMerged:    75,
+							SectorsDiscarded:  76,
+							DiscardTime:       77000,
+							FlushesCompleted:  78,
+							FlushTime:         79000,
+							IoRates: &info.DiskIoRates{
+								Utilization:  80,
+								ReadAwait:    0.081,
+								WriteAwait:   0.082,
+								DiscardAwait: 0.083,
+								FlushAwait:   0.084,
+								QueueSize:    85,
+							},
+							Allocations: []info.FsAllocation{
+								{Type: "data", Profile: "single", Total: 50, Used: 51},
+								{Type: "metadata", Profile: "dup", Total: 52, Used: 53},
+							},
+							Nfs: &info.NfsStats{
+								Server:     "nfs.example.com",
+								Export:     "/srv",
+								ReadBytes:  57,
+								WriteBytes: 58,
+								Ops: []info.NfsOpStats{
+									{Op: "READ", Requests: 59, Retransmissions: 60, Rtt: 61000, ExecuteTime: 62000},
+								},
+							},
+							Forecast: &info.FsForecast{
+								FillRate:   68,
+								TimeToFull: &timeToFull,
+							},
+							Overlay: &info.OverlayStats{
+								UpperUsage:      71,
+								LowerUsage:      72,
+								LowerLayers:     3,
+								UpperGrowthRate: 73,
+							},
+						},
+					},
+					Volumes: []info.VolumeStats{
+						{
+							MountPoint: "/data",
+							Device:     "sdb1",
+							Type:       "vfs",
+							Limit:      63,
+							Usage:      64,
+							Available:  65,
+							HasInodes:  true,
+							Inodes:     66,
+							InodesFree: 67,
 						},
 					},
 					Accelerators: []info.AcceleratorStats{
@@ -840,6 +925,45 @@ func (p *erroringSubcontainersInfoProvider) GetMachineInfo() (*info.MachineInfo,
 	return p.successfulProvider.GetMachineInfo()
 }
 
+// rootStatsInfoProvider serves the stats of the root container, from which
+// some machine metrics are read, along with the machine info of
+// testSubcontainersInfoProvider.
+type rootStatsInfoProvider struct {
+	testSubcontainersInfoProvider
+}
+
+func (p rootStatsInfoProvider) GetRequestedContainersInfo(string, info.RequestOptions) (map[string]*info.ContainerInfo, error) {
+	diskTemperature, dmSuspended := 38.85, false
+	return map[string]*info.ContainerInfo{
+		"/": {
+			ContainerReference: info.ContainerReference{Name: "/"},
+			Stats: []*info.ContainerStats{{
+				Timestamp: time.Unix(1395066363, 0),
+				Zfs: &info.ZfsStats{
+					Pools: []info.ZfsPoolStats{
+						{Name: "tank", Health: "DEGRADED", Size: 4000000, Allocated: 3000000, Free: 1000000, Fragmentation: 12, Capacity: 75},
+					},
+					Arc: &info.ZfsArcStats{
+						Hits:       900,
+						Misses:     100,
+						L2Hits:     30,
+						L2Misses:   70,
+						Size:       2048,
+						TargetSize: 4096,
+						MaxSize:    8192,
+					},
+				},
+				DiskHealth: []info.DiskHealthStats{
+					{Name: "dm-0", DmSuspended: &dmSuspended},
+					{Name: "md0", Raid: &info.RaidStats{State: "clean", Degraded: 1, SyncAction: "idle"}},
+					{Name: "nvme0n1", State: "live", Temperature: &diskTemperature},
+					{Name: "sda", State: "running"},
+				},
+			}},
+		},
+	}, nil
+}
+
 func (p *erroringSubcontainersInfoProvider) GetRequestedContainersInfo(
 	a string, opt info.RequestOptions) (map[string]*info.ContainerInfo, error) {
 	if p.shouldFail {
@@ -0,0 +1,133 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	info "github.com/google/cadvisor/lib/model"
+
+	"k8s.io/klog/v2"
+)
+
+// imageStoreProvider provides the images of the container runtimes.
+type imageStoreProvider interface {
+	// ImageStores returns the images of the runtimes that store them.
+	ImageStores() ([]info.ImageStore, error)
+}
+
+var (
+	imageStoreUsageDesc = prometheus.NewDesc("container_image_store_usage_bytes",
+		"Bytes used by the images of the runtime, counting shared layers once.", []string{"runtime"}, nil)
+	imageStoreImagesDesc = prometheus.NewDesc("container_image_store_images",
+		"Number of images the runtime stores.", []string{"runtime"}, nil)
+	imageSizeDesc = prometheus.NewDesc("container_image_size_bytes",
+		"Bytes used by the image, including the layers it shares with other images.", []string{"runtime", "id", "image"}, nil)
+	imageLayersDesc = prometheus.NewDesc("container_image_layers",
+		"Number of layers of the image.", []string{"runtime", "id", "image"}, nil)
+	imageContainersDesc = prometheus.NewDesc("container_image_containers",
+		"Number of tracked containers running the image.", []string{"runtime", "id", "image"}, nil)
+)
+
+// How long the images of the runtimes are reused across scrapes: listing them
+// asks every runtime for the disk usage of its images.
+const imageStoresTTL = time.Minute
+
+// PrometheusImageCollector implements prometheus.Collector.
+type PrometheusImageCollector struct {
+	provider imageStoreProvider
+	errors   prometheus.Gauge
+
+	// The images last listed without error, and when.
+	lock      sync.Mutex
+	stores    []info.ImageStore
+	fetchedAt time.Time
+}
+
+// NewPrometheusImageCollector returns a new PrometheusImageCollector.
+func NewPrometheusImageCollector(p imageStoreProvider) *PrometheusImageCollector {
+	return &PrometheusImageCollector{
+		provider: p,
+		errors: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "container_image",
+			Name:      "scrape_error",
+			Help:      "1 if there was an error while getting image metrics, 0 otherwise.",
+		}),
+	}
+}
+
+// Describe describes all the image metrics ever exported by cadvisor. It
+// implements prometheus.PrometheusCollector.
+func (collector *PrometheusImageCollector) Describe(ch chan<- *prometheus.Desc) {
+	collector.errors.Describe(ch)
+	ch <- imageStoreUsageDesc
+	ch <- imageStoreImagesDesc
+	ch <- imageSizeDesc
+	ch <- imageLayersDesc
+	ch <- imageContainersDesc
+}
+
+// Collect fetches the images of the runtimes and delivers them as Prometheus
+// metrics. It implements prometheus.PrometheusCollector.
+func (collector *PrometheusImageCollector) Collect(ch chan<- prometheus.Metric) {
+	collector.errors.Set(0)
+	stores, err := collector.imageStores()
+	if err != nil {
+		collector.errors.Set(1)
+		klog.Warningf("Couldn't get images: %s", err)
+	}
+	for _, store := range stores {
+		ch <- prometheus.MustNewConstMetric(imageStoreUsageDesc, prometheus.GaugeValue, float64(store.Usage), store.Runtime)
+		ch <- prometheus.MustNewConstMetric(imageStoreImagesDesc, prometheus.GaugeValue, float64(len(store.Images)), store.Runtime)
+		for _, image := range store.Images {
+			labels := []string{store.Runtime, image.ID, imageName(&image)}
+			ch <- prometheus.MustNewConstMetric(imageSizeDesc, prometheus.GaugeValue, float64(image.Size), labels...)
+			ch <- prometheus.MustNewConstMetric(imageLayersDesc, prometheus.GaugeValue, float64(image.Layers), labels...)
+			ch <- prometheus.MustNewConstMetric(imageContainersDesc, prometheus.GaugeValue, float64(len(image.Containers)), labels...)
+		}
+	}
+	collector.errors.Collect(ch)
+}
+
+// imageStores returns the images of the runtimes, listed at most once per
+// imageStoresTTL. Failed listings are retried on the next scrape.
+func (collector *PrometheusImageCollector) imageStores() ([]info.ImageStore, error) {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+	if !collector.fetchedAt.IsZero() && time.Since(collector.fetchedAt) < imageStoresTTL {
+		return collector.stores, nil
+	}
+	stores, err := collector.provider.ImageStores()
+	if err != nil {
+		return stores, err
+	}
+	collector.stores, collector.fetchedAt = stores, time.Now()
+	return stores, nil
+}
+
+// imageName returns the name an image is best known by: its first tag, else
+// its first digest, else none.
+func imageName(image *info.ImageInfo) string {
+	if len(image.RepoTags) > 0 {
+		return image.RepoTags[0]
+	}
+	if len(image.RepoDigests) > 0 {
+		return image.RepoDigests[0]
+	}
+	return ""
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	info "github.com/google/cadvisor/lib/model"
+)
+
+type testImageStoreProvider struct {
+	stores []info.ImageStore
+	err    error
+	calls  *int
+}
+
+func (p testImageStoreProvider) ImageStores() ([]info.ImageStore, error) {
+	if p.calls != nil {
+		*p.calls++
+	}
+	return p.stores, p.err
+}
+
+func TestPrometheusImageCollector(t *testing.T) {
+	collector := NewPrometheusImageCollector(testImageStoreProvider{stores: []info.ImageStore{{
+		Runtime: "docker",
+		Usage:   1000,
+		Images: []info.ImageInfo{
+			{ID: "sha256:aaa", RepoTags: []string{"nginx:1.27", "nginx:latest"}, Size: 700, Layers: 3, Containers: []string{"/docker/a", "/docker/b"}},
+			{ID: "sha256:bbb", RepoDigests: []string{"redis@sha256:ccc"}, Size: 300, Layers: 1},
+		},
+	}}})
+	expected := `
+# HELP container_image_containers Number of tracked containers running the image.
+# TYPE container_image_containers gauge
+container_image_containers{id="sha256:aaa",image="nginx:1.27",runtime="docker"} 2
+container_image_containers{id="sha256:bbb",image="redis@sha256:ccc",runtime="docker"} 0
+# HELP container_image_layers Number of layers of the image.
+# TYPE container_image_layers gauge
+container_image_layers{id="sha256:aaa",image="nginx:1.27",runtime="docker"} 3
+container_image_layers{id="sha256:bbb",image="redis@sha256:ccc",runtime="docker"} 1
+# HELP container_image_scrape_error 1 if there was an error while getting image metrics, 0 otherwise.
+# TYPE container_image_scrape_error gauge
+container_image_scrape_error 0
+# HELP container_image_size_bytes Bytes used by the image, including the layers it shares with other images.
+# TYPE container_image_size_bytes gauge
+container_image_size_bytes{id="sha256:aaa",image="nginx:1.27",runtime="docker"} 700
+container_image_size_bytes{id="sha256:bbb",image="redis@sha256:ccc",runtime="docker"} 300
+# HELP container_image_store_images Number of images the runtime stores.
+# TYPE container_image_store_images gauge
+container_image_store_images{runtime="docker"} 2
+# HELP container_image_store_usage_bytes Bytes used by the images of the runtime, counting shared layers once.
+# TYPE container_image_store_usage_bytes gauge
+container_image_store_usage_bytes{runtime="docker"} 1000
+`
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
+
+func TestPrometheusImageCollectorWithFailure(t *testing.T) {
+	collector := NewPrometheusImageCollector(testImageStoreProvider{err: errors.New("no runtime")})
+	expected := `
+# HELP container_image_scrape_error 1 if there was an error while getting image metrics, 0 otherwise.
+# TYPE container_image_scrape_error gauge
+container_image_scrape_error 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
+
+func TestPrometheusImageCollectorCachesImages(t *testing.T) {
+	var calls int
+	provider := testImageStoreProvider{stores: []info.ImageStore{{Runtime: "docker", Usage: 1000}}, calls: &calls}
+	collector := NewPrometheusImageCollector(provider)
+
+	testutil.CollectAndCount(collector)
+	testutil.CollectAndCount(collector)
+	assert.Equal(t, 1, calls)
+
+	collector.fetchedAt = collector.fetchedAt.Add(-imageStoresTTL)
+	testutil.CollectAndCount(collector)
+	assert.Equal(t, 2, calls)
+
+	// Failures are not cached.
+	provider.err = errors.New("no runtime")
+	collector = NewPrometheusImageCollector(provider)
+	testutil.CollectAndCount(collector)
+	testutil.CollectAndCount(collector)
+	assert.Equal(t, 4, calls)
+}
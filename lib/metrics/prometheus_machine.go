@@ -36,6 +36,9 @@ const (
 	prometheusThreadLabelName     = "thread_id"
 	prometheusPageSizeLabelName   = "page_size"
 	prometheusTargetNodeLabelName = "target_node_id"
+	prometheusPoolLabelName       = "pool"
+	prometheusStateLabelName      = "state"
+	prometheusDeviceLabelName     = "device"
 
 	nvmMemoryMode    = "memory_mode"
 	nvmAppDirectMode = "app_direct_mode"
@@ -61,11 +64,28 @@ func (metric *machineMetric) desc(baseLabels []string) *prometheus.Desc {
 	return prometheus.NewDesc(metric.name, metric.help, append(baseLabels, metric.extraLabels...), nil)
 }
 
+// machineStatsMetric describes a machine metric read from the latest stats of
+// the root container, for statistics that change faster than the machine
+// info is refreshed.
+type machineStatsMetric struct {
+	name        string
+	help        string
+	valueType   prometheus.ValueType
+	extraLabels []string
+	condition   func(stats *info.ContainerStats) bool
+	getValues   func(stats *info.ContainerStats) metricValues
+}
+
+func (metric *machineStatsMetric) desc(baseLabels []string) *prometheus.Desc {
+	return prometheus.NewDesc(metric.name, metric.help, append(baseLabels, metric.extraLabels...), nil)
+}
+
 // PrometheusMachineCollector implements prometheus.Collector.
 type PrometheusMachineCollector struct {
 	infoProvider   infoProvider
 	errors         prometheus.Gauge
 	machineMetrics []machineMetric
+	statsMetrics   []machineStatsMetric
 }
 
 // NewPrometheusMachineCollector returns a new PrometheusCollector.
@@ -227,6 +247,301 @@ func NewPrometheusMachineCollector(i infoProvider, includedMetrics container.Met
 			},
 		}...)
 	}
+	if includedMetrics.Has(container.DiskIOMetrics) {
+		c.machineMetrics = append(c.machineMetrics, []machineMetric{
+			{
+				name:        "machine_disk_rotational",
+				help:        "1 if the block device is rotational, 0 otherwise.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				condition:   func(machineInfo *info.MachineInfo) bool { return len(machineInfo.DiskMap) != 0 },
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getDiskValues(machineInfo, func(disk *info.DiskInfo) (float64, []string, bool) {
+						return boolToFloat(disk.Rotational), nil, true
+					})
+				},
+			},
+			{
+				name:        "machine_disk_logical_block_size_bytes",
+				help:        "Logical block size of the block device.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				condition:   func(machineInfo *info.MachineInfo) bool { return len(machineInfo.DiskMap) != 0 },
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getDiskValues(machineInfo, func(disk *info.DiskInfo) (float64, []string, bool) {
+						return float64(disk.LogicalBlockSize), nil, disk.LogicalBlockSize != 0
+					})
+				},
+			},
+			{
+				name:        "machine_disk_physical_block_size_bytes",
+				help:        "Physical block size of the block device.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				condition:   func(machineInfo *info.MachineInfo) bool { return len(machineInfo.DiskMap) != 0 },
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getDiskValues(machineInfo, func(disk *info.DiskInfo) (float64, []string, bool) {
+						return float64(disk.PhysicalBlockSize), nil, disk.PhysicalBlockSize != 0
+					})
+				},
+			},
+			{
+				name:        "machine_disk_queue_depth",
+				help:        "Number of requests the block layer queues for the block device.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				condition:   func(machineInfo *info.MachineInfo) bool { return len(machineInfo.DiskMap) != 0 },
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getDiskValues(machineInfo, func(disk *info.DiskInfo) (float64, []string, bool) {
+						return float64(disk.QueueDepth), nil, disk.QueueDepth != 0
+					})
+				},
+			},
+			{
+				name:        "machine_disk_discard_supported",
+				help:        "1 if the block device supports discard requests, 0 otherwise.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				condition:   func(machineInfo *info.MachineInfo) bool { return len(machineInfo.DiskMap) != 0 },
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getDiskValues(machineInfo, func(disk *info.DiskInfo) (float64, []string, bool) {
+						return boolToFloat(disk.Discard), nil, true
+					})
+				},
+			},
+			{
+				name:        "machine_disk_critical_temperature_celsius",
+				help:        "Critical temperature of the block device, for devices with a hwmon sensor.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				condition:   func(machineInfo *info.MachineInfo) bool { return len(machineInfo.DiskMap) != 0 },
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getDiskValues(machineInfo, func(disk *info.DiskInfo) (float64, []string, bool) {
+						if disk.CriticalTemperature == nil {
+							return 0, nil, false
+						}
+						return *disk.CriticalTemperature, nil, true
+					})
+				},
+			},
+			{
+				name:        "machine_disk_raid_disks",
+				help:        "Number of member disks of the md RAID array.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName, prometheusLevelLabelName},
+				condition:   func(machineInfo *info.MachineInfo) bool { return len(machineInfo.DiskMap) != 0 },
+				getValues: func(machineInfo *info.MachineInfo) metricValues {
+					return getDiskValues(machineInfo, func(disk *info.DiskInfo) (float64, []string, bool) {
+						if disk.Raid == nil {
+							return 0, nil, false
+						}
+						return float64(disk.Raid.Disks), []string{disk.Raid.Level}, true
+					})
+				},
+			},
+		}...)
+		c.statsMetrics = append(c.statsMetrics, []machineStatsMetric{
+			{
+				name:        "machine_disk_state",
+				help:        "1 for the state of the block device according to its driver.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName, prometheusStateLabelName},
+				condition:   func(stats *info.ContainerStats) bool { return len(stats.DiskHealth) != 0 },
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return getDiskHealthValues(stats, func(disk *info.DiskHealthStats) (float64, []string, bool) {
+						return 1, []string{disk.State}, disk.State != ""
+					})
+				},
+			},
+			{
+				name:        "machine_disk_temperature_celsius",
+				help:        "Temperature of the block device, for devices with a hwmon sensor.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				condition:   func(stats *info.ContainerStats) bool { return len(stats.DiskHealth) != 0 },
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return getDiskHealthValues(stats, func(disk *info.DiskHealthStats) (float64, []string, bool) {
+						if disk.Temperature == nil {
+							return 0, nil, false
+						}
+						return *disk.Temperature, nil, true
+					})
+				},
+			},
+			{
+				name:        "machine_disk_dm_suspended",
+				help:        "1 if I/O to the device-mapper device is suspended, 0 otherwise.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				condition:   func(stats *info.ContainerStats) bool { return len(stats.DiskHealth) != 0 },
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return getDiskHealthValues(stats, func(disk *info.DiskHealthStats) (float64, []string, bool) {
+						if disk.DmSuspended == nil {
+							return 0, nil, false
+						}
+						return boolToFloat(*disk.DmSuspended), nil, true
+					})
+				},
+			},
+			{
+				name:        "machine_disk_raid_degraded_disks",
+				help:        "Number of member disks missing or failed from the md RAID array.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName},
+				condition:   func(stats *info.ContainerStats) bool { return len(stats.DiskHealth) != 0 },
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return getDiskHealthValues(stats, func(disk *info.DiskHealthStats) (float64, []string, bool) {
+						if disk.Raid == nil {
+							return 0, nil, false
+						}
+						return float64(disk.Raid.Degraded), nil, true
+					})
+				},
+			},
+			{
+				name:        "machine_disk_raid_state",
+				help:        "1 for the state of the md RAID array.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusDeviceLabelName, prometheusStateLabelName},
+				condition:   func(stats *info.ContainerStats) bool { return len(stats.DiskHealth) != 0 },
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return getDiskHealthValues(stats, func(disk *info.DiskHealthStats) (float64, []string, bool) {
+						if disk.Raid == nil {
+							return 0, nil, false
+						}
+						return 1, []string{disk.Raid.State}, true
+					})
+				},
+			},
+		}...)
+	}
+	if includedMetrics.Has(container.DiskUsageMetrics) {
+		c.statsMetrics = append(c.statsMetrics, []machineStatsMetric{
+			{
+				name:        "machine_zfs_pool_health",
+				help:        "1 for the health state of the ZFS pool.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusPoolLabelName, prometheusStateLabelName},
+				condition:   func(stats *info.ContainerStats) bool { return stats.Zfs != nil },
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return getZfsPoolValues(stats, func(pool *info.ZfsPoolStats) (float64, []string) { return 1, []string{pool.Health} })
+				},
+			},
+			{
+				name:        "machine_zfs_pool_size_bytes",
+				help:        "Size of the ZFS pool.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusPoolLabelName},
+				condition:   func(stats *info.ContainerStats) bool { return stats.Zfs != nil },
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return getZfsPoolValues(stats, func(pool *info.ZfsPoolStats) (float64, []string) { return float64(pool.Size), nil })
+				},
+			},
+			{
+				name:        "machine_zfs_pool_allocated_bytes",
+				help:        "Number of bytes allocated in the ZFS pool.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusPoolLabelName},
+				condition:   func(stats *info.ContainerStats) bool { return stats.Zfs != nil },
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return getZfsPoolValues(stats, func(pool *info.ZfsPoolStats) (float64, []string) { return float64(pool.Allocated), nil })
+				},
+			},
+			{
+				name:        "machine_zfs_pool_free_bytes",
+				help:        "Number of bytes free in the ZFS pool.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusPoolLabelName},
+				condition:   func(stats *info.ContainerStats) bool { return stats.Zfs != nil },
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return getZfsPoolValues(stats, func(pool *info.ZfsPoolStats) (float64, []string) { return float64(pool.Free), nil })
+				},
+			},
+			{
+				name:        "machine_zfs_pool_fragmentation_ratio",
+				help:        "Fraction of the free space of the ZFS pool that is fragmented.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusPoolLabelName},
+				condition:   func(stats *info.ContainerStats) bool { return stats.Zfs != nil },
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return getZfsPoolValues(stats, func(pool *info.ZfsPoolStats) (float64, []string) { return float64(pool.Fragmentation) / 100, nil })
+				},
+			},
+			{
+				name:        "machine_zfs_pool_capacity_ratio",
+				help:        "Fraction of the ZFS pool that is allocated.",
+				valueType:   prometheus.GaugeValue,
+				extraLabels: []string{prometheusPoolLabelName},
+				condition:   func(stats *info.ContainerStats) bool { return stats.Zfs != nil },
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return getZfsPoolValues(stats, func(pool *info.ZfsPoolStats) (float64, []string) { return float64(pool.Capacity) / 100, nil })
+				},
+			},
+			{
+				name:      "machine_zfs_arc_hits_total",
+				help:      "Cumulative number of ZFS ARC hits.",
+				valueType: prometheus.CounterValue,
+				condition: hasZfsArc,
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return metricValues{{value: float64(stats.Zfs.Arc.Hits), timestamp: stats.Timestamp}}
+				},
+			},
+			{
+				name:      "machine_zfs_arc_misses_total",
+				help:      "Cumulative number of ZFS ARC misses.",
+				valueType: prometheus.CounterValue,
+				condition: hasZfsArc,
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return metricValues{{value: float64(stats.Zfs.Arc.Misses), timestamp: stats.Timestamp}}
+				},
+			},
+			{
+				name:      "machine_zfs_arc_l2_hits_total",
+				help:      "Cumulative number of ZFS L2ARC hits.",
+				valueType: prometheus.CounterValue,
+				condition: hasZfsArc,
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return metricValues{{value: float64(stats.Zfs.Arc.L2Hits), timestamp: stats.Timestamp}}
+				},
+			},
+			{
+				name:      "machine_zfs_arc_l2_misses_total",
+				help:      "Cumulative number of ZFS L2ARC misses.",
+				valueType: prometheus.CounterValue,
+				condition: hasZfsArc,
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return metricValues{{value: float64(stats.Zfs.Arc.L2Misses), timestamp: stats.Timestamp}}
+				},
+			},
+			{
+				name:      "machine_zfs_arc_size_bytes",
+				help:      "Current size of the ZFS ARC.",
+				valueType: prometheus.GaugeValue,
+				condition: hasZfsArc,
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return metricValues{{value: float64(stats.Zfs.Arc.Size), timestamp: stats.Timestamp}}
+				},
+			},
+			{
+				name:      "machine_zfs_arc_target_size_bytes",
+				help:      "Size the ZFS ARC is adapting to.",
+				valueType: prometheus.GaugeValue,
+				condition: hasZfsArc,
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return metricValues{{value: float64(stats.Zfs.Arc.TargetSize), timestamp: stats.Timestamp}}
+				},
+			},
+			{
+				name:      "machine_zfs_arc_max_size_bytes",
+				help:      "Maximum size of the ZFS ARC.",
+				valueType: prometheus.GaugeValue,
+				condition: hasZfsArc,
+				getValues: func(stats *info.ContainerStats) metricValues {
+					return metricValues{{value: float64(stats.Zfs.Arc.MaxSize), timestamp: stats.Timestamp}}
+				},
+			},
+		}...)
+	}
 	return c
 }
 
@@ -237,6 +552,9 @@ func (collector *PrometheusMachineCollector) Describe(ch chan<- *prometheus.Desc
 	for _, metric := range collector.machineMetrics {
 		ch <- metric.desc([]string{})
 	}
+	for _, metric := range collector.statsMetrics {
+		ch <- metric.desc([]string{})
+	}
 }
 
 // Collect fetches information about machine and delivers them as
@@ -262,23 +580,58 @@ func (collector *PrometheusMachineCollector) collectMachineInfo(ch chan<- promet
 			continue
 		}
 
-		for _, metricValue := range metric.getValues(machineInfo) {
-			labelValues := make([]string, len(baseLabelsValues))
-			copy(labelValues, baseLabelsValues)
-			if len(metric.extraLabels) != 0 {
-				labelValues = append(labelValues, metricValue.labels...)
-			}
+		sendMachineMetric(ch, metric.desc(baseLabelsNames), metric.valueType, baseLabelsValues, len(metric.extraLabels) != 0, metric.getValues(machineInfo))
+	}
+
+	if len(collector.statsMetrics) == 0 {
+		return
+	}
+	stats, err := collector.rootStats()
+	if err != nil {
+		collector.errors.Set(1)
+		klog.Warningf("Couldn't get the stats of the root container: %s", err)
+		return
+	}
+	if stats == nil {
+		return
+	}
+	for _, metric := range collector.statsMetrics {
+		if metric.condition != nil && !metric.condition(stats) {
+			continue
+		}
+		sendMachineMetric(ch, metric.desc(baseLabelsNames), metric.valueType, baseLabelsValues, len(metric.extraLabels) != 0, metric.getValues(stats))
+	}
+}
 
-			prometheusMetric := prometheus.MustNewConstMetric(metric.desc(baseLabelsNames),
-				metric.valueType, metricValue.value, labelValues...)
+// rootStats returns the latest stats of the root container, nil if it has
+// none yet.
+func (collector *PrometheusMachineCollector) rootStats() (*info.ContainerStats, error) {
+	containers, err := collector.infoProvider.GetRequestedContainersInfo("/", info.RequestOptions{IdType: info.TypeName, Count: 1})
+	if err != nil {
+		return nil, err
+	}
+	root, ok := containers["/"]
+	if !ok || len(root.Stats) == 0 {
+		return nil, nil
+	}
+	return root.Stats[len(root.Stats)-1], nil
+}
 
-			if metricValue.timestamp.IsZero() {
-				ch <- prometheusMetric
-			} else {
-				ch <- prometheus.NewMetricWithTimestamp(metricValue.timestamp, prometheusMetric)
-			}
+func sendMachineMetric(ch chan<- prometheus.Metric, desc *prometheus.Desc, valueType prometheus.ValueType, baseLabelsValues []string, hasExtraLabels bool, values metricValues) {
+	for _, metricValue := range values {
+		labelValues := make([]string, len(baseLabelsValues))
+		copy(labelValues, baseLabelsValues)
+		if hasExtraLabels {
+			labelValues = append(labelValues, metricValue.labels...)
 		}
 
+		prometheusMetric := prometheus.MustNewConstMetric(desc, valueType, metricValue.value, labelValues...)
+
+		if metricValue.timestamp.IsZero() {
+			ch <- prometheusMetric
+		} else {
+			ch <- prometheus.NewMetricWithTimestamp(metricValue.timestamp, prometheusMetric)
+		}
 	}
 }
 
@@ -406,3 +759,67 @@ func getDistance(machineInfo *info.MachineInfo) metricValues {
 	}
 	return mValues
 }
+
+func hasZfsArc(stats *info.ContainerStats) bool {
+	return stats.Zfs != nil && stats.Zfs.Arc != nil
+}
+
+func getZfsPoolValues(stats *info.ContainerStats, valueFn func(*info.ZfsPoolStats) (float64, []string)) metricValues {
+	mValues := make(metricValues, 0, len(stats.Zfs.Pools))
+	for i := range stats.Zfs.Pools {
+		value, labels := valueFn(&stats.Zfs.Pools[i])
+		mValues = append(mValues,
+			metricValue{
+				value:     value,
+				labels:    append([]string{stats.Zfs.Pools[i].Name}, labels...),
+				timestamp: stats.Timestamp,
+			})
+	}
+	return mValues
+}
+
+// getDiskHealthValues is getDiskValues for the health signals of the block
+// devices in the stats of the root container.
+func getDiskHealthValues(stats *info.ContainerStats, valueFn func(*info.DiskHealthStats) (float64, []string, bool)) metricValues {
+	mValues := make(metricValues, 0, len(stats.DiskHealth))
+	for i := range stats.DiskHealth {
+		value, labels, ok := valueFn(&stats.DiskHealth[i])
+		if !ok {
+			continue
+		}
+		mValues = append(mValues,
+			metricValue{
+				value:     value,
+				labels:    append([]string{stats.DiskHealth[i].Name}, labels...),
+				timestamp: stats.Timestamp,
+			})
+	}
+	return mValues
+}
+
+// boolToFloat converts a bool into 1 if true and 0 otherwise.
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// getDiskValues returns a value for each block device valueFn returns one
+// for, labelled by the name of the device and the labels valueFn returns.
+func getDiskValues(machineInfo *info.MachineInfo, valueFn func(*info.DiskInfo) (float64, []string, bool)) metricValues {
+	mValues := make(metricValues, 0, len(machineInfo.DiskMap))
+	for _, disk := range machineInfo.DiskMap {
+		value, labels, ok := valueFn(&disk)
+		if !ok {
+			continue
+		}
+		mValues = append(mValues,
+			metricValue{
+				value:     value,
+				labels:    append([]string{disk.Name}, labels...),
+				timestamp: machineInfo.Timestamp,
+			})
+	}
+	return mValues
+}
@@ -32,7 +32,7 @@ const machineMetricsFile = "testdata/prometheus_machine_metrics"
 const machineMetricsFailureFile = "testdata/prometheus_machine_metrics_failure"
 
 func TestPrometheusMachineCollector(t *testing.T) {
-	collector := NewPrometheusMachineCollector(testSubcontainersInfoProvider{}, container.AllMetrics)
+	collector := NewPrometheusMachineCollector(rootStatsInfoProvider{}, container.AllMetrics)
 	registry := prometheus.NewRegistry()
 	registry.MustRegister(collector)
 
@@ -0,0 +1,72 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/google/cadvisor/lib/fs"
+)
+
+// scanStatsProvider provides the stats of the disk usage walks.
+type scanStatsProvider interface {
+	// DirUsageScanStats returns the stats of the walks measuring the disk
+	// usage of directories.
+	DirUsageScanStats() fs.ScanStats
+}
+
+var (
+	scansQueuedDesc = prometheus.NewDesc("cadvisor_du_scans_queued",
+		"Number of walks measuring the disk usage of directories waiting for a worker.", nil, nil)
+	scansRunningDesc = prometheus.NewDesc("cadvisor_du_scans_running",
+		"Number of walks measuring the disk usage of directories in progress.", nil, nil)
+	scanOldestQueuedDesc = prometheus.NewDesc("cadvisor_du_scan_oldest_queued_seconds",
+		"Number of seconds the walk queued first has been waiting for a worker.", nil, nil)
+	scannedFilesDesc = prometheus.NewDesc("cadvisor_du_scanned_files_total",
+		"Cumulative count of files visited by walks measuring the disk usage of directories.", nil, nil)
+	scanDurationDesc = prometheus.NewDesc("cadvisor_du_scan_duration_seconds",
+		"Seconds from requesting walks measuring the disk usage of directories to their completion.", nil, nil)
+)
+
+// PrometheusScanCollector implements prometheus.Collector.
+type PrometheusScanCollector struct {
+	provider scanStatsProvider
+}
+
+// NewPrometheusScanCollector returns a new PrometheusScanCollector.
+func NewPrometheusScanCollector(p scanStatsProvider) *PrometheusScanCollector {
+	return &PrometheusScanCollector{provider: p}
+}
+
+// Describe describes all the disk usage walk metrics ever exported by
+// cadvisor. It implements prometheus.PrometheusCollector.
+func (collector *PrometheusScanCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- scansQueuedDesc
+	ch <- scansRunningDesc
+	ch <- scanOldestQueuedDesc
+	ch <- scannedFilesDesc
+	ch <- scanDurationDesc
+}
+
+// Collect fetches the stats of the disk usage walks and delivers them as
+// Prometheus metrics. It implements prometheus.PrometheusCollector.
+func (collector *PrometheusScanCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := collector.provider.DirUsageScanStats()
+	ch <- prometheus.MustNewConstMetric(scansQueuedDesc, prometheus.GaugeValue, float64(stats.Queued))
+	ch <- prometheus.MustNewConstMetric(scansRunningDesc, prometheus.GaugeValue, float64(stats.Running))
+	ch <- prometheus.MustNewConstMetric(scanOldestQueuedDesc, prometheus.GaugeValue, stats.OldestQueued.Seconds())
+	ch <- prometheus.MustNewConstMetric(scannedFilesDesc, prometheus.CounterValue, float64(stats.Files))
+	ch <- prometheus.MustNewConstHistogram(scanDurationDesc, stats.LatencyCount, stats.LatencySum, stats.LatencyBuckets)
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/cadvisor/lib/fs"
+)
+
+type testScanStatsProvider fs.ScanStats
+
+func (p testScanStatsProvider) DirUsageScanStats() fs.ScanStats {
+	return fs.ScanStats(p)
+}
+
+func TestPrometheusScanCollector(t *testing.T) {
+	collector := NewPrometheusScanCollector(testScanStatsProvider{
+		Queued:         3,
+		Running:        2,
+		OldestQueued:   1500 * time.Millisecond,
+		Files:          12345,
+		LatencyCount:   4,
+		LatencySum:     7.5,
+		LatencyBuckets: map[float64]uint64{1: 1, 5: 3, 10: 4},
+	})
+	expected := `
+# HELP cadvisor_du_scan_duration_seconds Seconds from requesting walks measuring the disk usage of directories to their completion.
+# TYPE cadvisor_du_scan_duration_seconds histogram
+cadvisor_du_scan_duration_seconds_bucket{le="1"} 1
+cadvisor_du_scan_duration_seconds_bucket{le="5"} 3
+cadvisor_du_scan_duration_seconds_bucket{le="10"} 4
+cadvisor_du_scan_duration_seconds_bucket{le="+Inf"} 4
+cadvisor_du_scan_duration_seconds_sum 7.5
+cadvisor_du_scan_duration_seconds_count 4
+# HELP cadvisor_du_scan_oldest_queued_seconds Number of seconds the walk queued first has been waiting for a worker.
+# TYPE cadvisor_du_scan_oldest_queued_seconds gauge
+cadvisor_du_scan_oldest_queued_seconds 1.5
+# HELP cadvisor_du_scanned_files_total Cumulative count of files visited by walks measuring the disk usage of directories.
+# TYPE cadvisor_du_scanned_files_total counter
+cadvisor_du_scanned_files_total 12345
+# HELP cadvisor_du_scans_queued Number of walks measuring the disk usage of directories waiting for a worker.
+# TYPE cadvisor_du_scans_queued gauge
+cadvisor_du_scans_queued 3
+# HELP cadvisor_du_scans_running Number of walks measuring the disk usage of directories in progress.
+# TYPE cadvisor_du_scans_running gauge
+cadvisor_du_scans_running 2
+`
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
@@ -336,6 +336,56 @@ func TestGetMinCoreScalingRatio(t *testing.T) {
 	assert.Contains(t, values, 0.3)
 }
 
+func TestPodLabels(t *testing.T) {
+	labelled := &info.ContainerInfo{
+		ContainerReference: info.ContainerReference{
+			Name:    "/kubepods/burstable/pod068e8fa0-9213-11e7-a01f-507b9d4141fa/40af7cdcbe50",
+			Aliases: []string{"k8s_app_web-0_shop"},
+		},
+		Spec: info.ContainerSpec{
+			Labels: map[string]string{
+				info.PodNameLabel:      "web-0",
+				info.PodNamespaceLabel: "shop",
+				info.PodUIDLabel:       "068e8fa0-9213-11e7-a01f-507b9d4141fa",
+			},
+		},
+	}
+	for _, labelsFunc := range []ContainerLabelsFunc{DefaultContainerLabels, BaseContainerLabels(nil)} {
+		set := labelsFunc(labelled)
+		assert.Equal(t, "web-0", set[LabelPodName])
+		assert.Equal(t, "shop", set[LabelPodNamespace])
+		assert.Equal(t, "068e8fa0-9213-11e7-a01f-507b9d4141fa", set[LabelPodUID])
+	}
+
+	// Containers without labels are grouped by their pod cgroup.
+	unlabelled := &info.ContainerInfo{
+		ContainerReference: info.ContainerReference{
+			Name: "/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod068e8fa0_9213_11e7_a01f_507b9d4141fa.slice/cri-containerd-40af7cdcbe50.scope",
+		},
+	}
+	set := DefaultContainerLabels(unlabelled)
+	assert.Equal(t, "068e8fa0-9213-11e7-a01f-507b9d4141fa", set[LabelPodUID])
+	assert.NotContains(t, set, LabelPodName)
+
+	set = DefaultContainerLabels(&info.ContainerInfo{ContainerReference: info.ContainerReference{Name: "/system.slice/sshd.service"}})
+	assert.NotContains(t, set, LabelPodUID)
+}
+
+func TestUserLabel(t *testing.T) {
+	rootless := &info.ContainerInfo{
+		ContainerReference: info.ContainerReference{
+			Name: "/user.slice/user-1000.slice/user@1000.service/user.slice/libpod-40af7cdcbe50.scope/container",
+		},
+		Spec: info.ContainerSpec{User: "1000"},
+	}
+	for _, labelsFunc := range []ContainerLabelsFunc{DefaultContainerLabels, BaseContainerLabels(nil)} {
+		assert.Equal(t, "1000", labelsFunc(rootless)[LabelRootlessUID])
+	}
+
+	set := DefaultContainerLabels(&info.ContainerInfo{ContainerReference: info.ContainerReference{Name: "/system.slice/docker-40af7cdcbe50.scope"}})
+	assert.NotContains(t, set, LabelRootlessUID)
+}
+
 func TestGetContainerHealthState(t *testing.T) {
 	testCases := []struct {
 		name           string
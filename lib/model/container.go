@@ -81,6 +81,64 @@ type ContainerSpec struct {
 
 	// Image name used for this container.
 	Image string `json:"image,omitempty"`
+
+	// ID of the image used for this container, if the runtime reports it,
+	// see ImageInfo.ID.
+	ImageID string `json:"image_id,omitempty"`
+
+	// Number of times this container was restarted: the count of its
+	// runtime if it keeps one, or the number of times cAdvisor has seen it
+	// recreated under the same name, whichever is larger.
+	RestartCount int `json:"restart_count,omitempty"`
+
+	// The systemd unit this container is the cgroup of, if any.
+	Systemd *SystemdUnitSpec `json:"systemd,omitempty"`
+
+	// The sandbox isolating the container from the host kernel, if any: one
+	// of the Sandbox* constants. The host cgroup of a sandboxed container
+	// accounts for its sandbox, e.g. a VM, rather than for the container.
+	Sandbox string `json:"sandbox,omitempty"`
+
+	// The UID of the user whose rootless runtime, e.g. rootless Podman, runs
+	// the container. Empty for containers of system-wide runtimes.
+	User string `json:"user,omitempty"`
+}
+
+// Sandboxes containers can run in, see ContainerSpec.Sandbox.
+const (
+	// Kata Containers, which run containers in lightweight VMs.
+	SandboxKata = "kata"
+	// gVisor, which runs containers on a user-space kernel.
+	SandboxGVisor = "gvisor"
+)
+
+// SystemdUnitSpec describes a systemd unit.
+type SystemdUnitSpec struct {
+	// Name of the unit, e.g. "sshd.service".
+	Unit string `json:"unit"`
+	// Slice the unit runs in, e.g. "system.slice".
+	Slice       string `json:"slice,omitempty"`
+	Description string `json:"description,omitempty"`
+	// ActiveState and SubState as reported by systemctl, e.g. "active" and
+	// "running".
+	ActiveState string `json:"active_state,omitempty"`
+	SubState    string `json:"sub_state,omitempty"`
+	// Time at which the unit last entered the active state.
+	ActiveSince time.Time `json:"active_since,omitempty"`
+	// Number of times systemd has automatically restarted the unit.
+	Restarts uint32 `json:"restarts,omitempty"`
+	MainPID  uint32 `json:"main_pid,omitempty"`
+
+	// Resource-control properties of the unit (see systemd.resource-control(5)).
+	// Zero when not set or unlimited.
+	CPUWeight uint64 `json:"cpu_weight,omitempty"`
+	// Units: microseconds of CPU time per second.
+	CPUQuotaPerSec uint64 `json:"cpu_quota_per_sec_usec,omitempty"`
+	IOWeight       uint64 `json:"io_weight,omitempty"`
+	// Units: bytes.
+	MemoryHigh uint64 `json:"memory_high,omitempty"`
+	MemoryMax  uint64 `json:"memory_max,omitempty"`
+	TasksMax   uint64 `json:"tasks_max,omitempty"`
 }
 
 // Container reference contains enough information to uniquely identify a container
@@ -909,6 +967,268 @@ type FsStats struct {
 	// last update of this field.  This can provide an easy measure of both
 	// I/O completion time and the backlog that may be accumulating.
 	WeightedIoTime uint64 `json:"weighted_io_time"`
+
+	// Number of discards completed, and of discards merged, from Linux 4.18.
+	DiscardsCompleted uint64 `json:"discards_completed,omitempty"`
+	DiscardsMerged    uint64 `json:"discards_merged,omitempty"`
+
+	// Number of sectors discarded.
+	SectorsDiscarded uint64 `json:"sectors_discarded,omitempty"`
+
+	// Number of milliseconds spent by all discards.
+	DiscardTime uint64 `json:"discard_time,omitempty"`
+
+	// Number of flushes completed, from Linux 5.5.
+	FlushesCompleted uint64 `json:"flushes_completed,omitempty"`
+
+	// Number of milliseconds spent by all flushes.
+	FlushTime uint64 `json:"flush_time,omitempty"`
+
+	// Rates of the I/O of the device between the last two samples. Only
+	// derived for the filesystems of the root container, those of the
+	// machine.
+	IoRates *DiskIoRates `json:"io_rates,omitempty"`
+
+	// How the filesystem allocates its space to each type of block group,
+	// for filesystems that report it, such as btrfs.
+	Allocations []FsAllocation `json:"allocations,omitempty"`
+
+	// Usage of the container's ZFS dataset, when the container runtime stores
+	// it in one.
+	Zfs *ZfsDatasetStats `json:"zfs,omitempty"`
+
+	// Usage of the container's btrfs subvolume from its qgroup, when the
+	// container runtime stores it in one and quotas are enabled.
+	Btrfs *BtrfsQgroupStats `json:"btrfs,omitempty"`
+
+	// Client statistics of NFS mounts.
+	Nfs *NfsStats `json:"nfs,omitempty"`
+
+	// Usage of the layers of the container's overlay root filesystem.
+	Overlay *OverlayStats `json:"overlay,omitempty"`
+
+	// Forecast of the usage from its recent history, once there is enough of
+	// it.
+	Forecast *FsForecast `json:"forecast,omitempty"`
+}
+
+// OverlayStats is the usage of the layers of an overlay root filesystem.
+type OverlayStats struct {
+	// Number of bytes used by the upper layer, written by the container.
+	UpperUsage uint64 `json:"upper_usage"`
+
+	// Number of bytes used by the lower layers, shared with the image and
+	// other containers.
+	LowerUsage uint64 `json:"lower_usage"`
+
+	// Number of lower layers.
+	LowerLayers int `json:"lower_layers"`
+
+	// Number of bytes per second the upper layer grew by between the last
+	// two measurements.
+	UpperGrowthRate float64 `json:"upper_growth_rate"`
+
+	// Whether the upper layer grows faster than the copy-up threshold, as
+	// when the container modifies many files of its lower layers.
+	CopyUpHeavy bool `json:"copy_up_heavy"`
+}
+
+// FsForecast is a linear forecast of the usage of a filesystem, fitted to the
+// samples of the stats cache.
+type FsForecast struct {
+	// Number of bytes per second the usage grows by, negative if it shrinks.
+	FillRate float64 `json:"fill_rate"`
+
+	// Number of seconds until the available bytes are used up at that rate,
+	// if the usage grows and the bytes available are known.
+	TimeToFull *float64 `json:"time_to_full,omitempty"`
+}
+
+// DiskIoRates are averages of the I/O of the block device of a filesystem
+// over the interval between two samples of its counters, as iostat reports.
+type DiskIoRates struct {
+	// Percentage of the interval the device was busy with I/O.
+	Utilization float64 `json:"utilization"`
+
+	// Average number of seconds the reads, writes, discards and flushes
+	// completed during the interval took, from being queued to completing.
+	// Zero if none completed.
+	ReadAwait    float64 `json:"read_await"`
+	WriteAwait   float64 `json:"write_await"`
+	DiscardAwait float64 `json:"discard_await"`
+	FlushAwait   float64 `json:"flush_await"`
+
+	// Average number of requests queued or being serviced.
+	QueueSize float64 `json:"queue_size"`
+}
+
+// FsAllocation is the space a filesystem allocated to a type of block groups
+// replicated with a given profile.
+type FsAllocation struct {
+	// Type of the block groups: "data", "metadata" or "system".
+	Type string `json:"type"`
+
+	// Profile the block groups are replicated with, e.g. "single", "dup" or
+	// "raid1".
+	Profile string `json:"profile"`
+
+	// Bytes allocated to the block groups.
+	Total uint64 `json:"total"`
+
+	// Bytes used within the block groups.
+	Used uint64 `json:"used"`
+}
+
+// VolumeStats are the stats of the filesystem of a volume mounted in a
+// container, e.g. a bind mount or a Kubernetes emptyDir.
+type VolumeStats struct {
+	// Path the volume is mounted at in the container.
+	MountPoint string `json:"mount_point"`
+
+	// The block device or remote export the filesystem is on.
+	Device string `json:"device"`
+
+	// Type of the filesystem.
+	Type string `json:"type"`
+
+	// Number of bytes of the filesystem.
+	Limit uint64 `json:"capacity"`
+
+	// Number of bytes used on the filesystem, by all its users.
+	Usage uint64 `json:"usage"`
+
+	// Number of bytes available to non-root users.
+	Available uint64 `json:"available"`
+
+	// HasInodes when true, indicates that Inodes and InodesFree are set.
+	HasInodes bool `json:"has_inodes"`
+
+	// Number of inodes of the filesystem.
+	Inodes uint64 `json:"inodes"`
+
+	// Number of free inodes on the filesystem.
+	InodesFree uint64 `json:"inodes_free"`
+
+	// Client statistics, for NFS volumes.
+	Nfs *NfsStats `json:"nfs,omitempty"`
+}
+
+// BtrfsQgroupStats is the usage of a btrfs subvolume, as accounted by its
+// qgroup.
+type BtrfsQgroupStats struct {
+	// Bytes of the extents the subvolume refers to, including those it shares
+	// with other subvolumes such as the image it was snapshotted from.
+	Referenced uint64 `json:"referenced"`
+
+	// Bytes of the extents no other subvolume refers to.
+	Exclusive uint64 `json:"exclusive"`
+}
+
+// ZfsDatasetStats is the usage of a ZFS dataset.
+type ZfsDatasetStats struct {
+	// Name of the dataset, e.g. "tank/docker/<id>".
+	Dataset string `json:"dataset"`
+
+	// Bytes used by the dataset and its descendants, snapshots included.
+	Used uint64 `json:"used"`
+
+	// Bytes of data the dataset refers to, which it may share with the image
+	// it was cloned from.
+	Referenced uint64 `json:"referenced"`
+
+	// Bytes used by the snapshots of the dataset.
+	UsedBySnapshots uint64 `json:"used_by_snapshots"`
+
+	// Ratio of the logical to the physical size of the referenced data.
+	CompressRatio float64 `json:"compress_ratio"`
+}
+
+// ZfsStats are the statistics of ZFS on the machine.
+type ZfsStats struct {
+	// Imported pools.
+	Pools []ZfsPoolStats `json:"pools,omitempty"`
+
+	// ARC statistics.
+	Arc *ZfsArcStats `json:"arc,omitempty"`
+}
+
+// ZfsPoolStats are the health and usage of a ZFS pool.
+type ZfsPoolStats struct {
+	// Pool name
+	Name string `json:"name"`
+
+	// Health, e.g. "ONLINE", "DEGRADED" or "FAULTED"
+	Health string `json:"health"`
+
+	// Size in bytes
+	Size uint64 `json:"size"`
+
+	// Allocated bytes
+	Allocated uint64 `json:"allocated"`
+
+	// Free bytes
+	Free uint64 `json:"free"`
+
+	// Percentage of the free space that is fragmented
+	Fragmentation uint64 `json:"fragmentation"`
+
+	// Percentage of the size that is allocated
+	Capacity uint64 `json:"capacity"`
+}
+
+// ZfsArcStats are the statistics of the ZFS Adaptive Replacement Cache.
+type ZfsArcStats struct {
+	// Cumulative number of ARC hits and misses
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+
+	// Cumulative number of L2ARC hits and misses
+	L2Hits   uint64 `json:"l2_hits"`
+	L2Misses uint64 `json:"l2_misses"`
+
+	// Current size in bytes
+	Size uint64 `json:"size"`
+
+	// Size in bytes the ARC is adapting to
+	TargetSize uint64 `json:"target_size"`
+
+	// Maximum size in bytes
+	MaxSize uint64 `json:"max_size"`
+}
+
+// NfsStats are the client statistics of an NFS mount.
+type NfsStats struct {
+	// Server and export of the mount, e.g. "nfs.example.com" and "/srv".
+	Server string `json:"server"`
+	Export string `json:"export"`
+
+	// Bytes read from and written to the server.
+	ReadBytes  uint64 `json:"read_bytes"`
+	WriteBytes uint64 `json:"write_bytes"`
+
+	// Statistics of each type of RPC the client sent at least once.
+	Ops []NfsOpStats `json:"ops,omitempty"`
+}
+
+// NfsOpStats are the statistics of one type of NFS RPC.
+type NfsOpStats struct {
+	// Type of the RPC, e.g. "READ" or "GETATTR".
+	Op string `json:"op"`
+
+	// Number of requests sent.
+	Requests uint64 `json:"requests"`
+
+	// Number of times requests were sent again after a timeout or a lost
+	// connection.
+	Retransmissions uint64 `json:"retransmissions"`
+
+	// Cumulative milliseconds between sending the requests and receiving
+	// the replies.
+	Rtt uint64 `json:"rtt"`
+
+	// Cumulative milliseconds between queueing the requests and their
+	// completion.
+	ExecuteTime uint64 `json:"execute_time"`
 }
 
 type AcceleratorStats struct {
@@ -1043,6 +1363,9 @@ type ContainerStats struct {
 	// Filesystem statistics
 	Filesystem []FsStats `json:"filesystem,omitempty"`
 
+	// Filesystems of the volumes mounted in the container
+	Volumes []VolumeStats `json:"volumes,omitempty"`
+
 	// Task load stats
 	TaskStats LoadStats `json:"task_stats,omitempty"`
 
@@ -1073,6 +1396,45 @@ type ContainerStats struct {
 	OOMEvents uint64 `json:"oom_events,omitempty"`
 
 	Health Health `json:"health,omitempty"`
+
+	// Statistics of ZFS, for the root container when the ZFS module is
+	// loaded.
+	Zfs *ZfsStats `json:"zfs,omitempty"`
+
+	// Health of the block devices of the machine, for the root container.
+	DiskHealth []DiskHealthStats `json:"disk_health,omitempty"`
+}
+
+// DiskHealthStats are the health signals of a block device, which change too
+// often to be part of its DiskInfo.
+type DiskHealthStats struct {
+	// Device name
+	Name string `json:"name"`
+
+	// State of the device according to its driver, e.g. "running" or
+	// "offline" for SCSI disks and "live" for NVMe controllers
+	State string `json:"state,omitempty"`
+
+	// Temperature in degrees Celsius, for devices whose driver registers a
+	// hwmon sensor
+	Temperature *float64 `json:"temperature,omitempty"`
+
+	// Whether I/O to the device-mapper device is suspended
+	DmSuspended *bool `json:"dm_suspended,omitempty"`
+
+	// md RAID array
+	Raid *RaidStats `json:"raid,omitempty"`
+}
+
+type RaidStats struct {
+	// State of the array, e.g. "clean", "active" or "inactive"
+	State string `json:"state"`
+
+	// Number of member disks missing or failed
+	Degraded uint64 `json:"degraded"`
+
+	// Current sync action, e.g. "idle", "resync" or "recover"
+	SyncAction string `json:"sync_action,omitempty"`
 }
 
 func timeEq(t1, t2 time.Time, tolerance time.Duration) bool {
@@ -1122,6 +1484,12 @@ func (a *ContainerStats) StatsEq(b *ContainerStats) bool {
 	if !reflect.DeepEqual(a.Filesystem, b.Filesystem) {
 		return false
 	}
+	if !reflect.DeepEqual(a.Volumes, b.Volumes) {
+		return false
+	}
+	if !reflect.DeepEqual(a.Zfs, b.Zfs) {
+		return false
+	}
 	if !reflect.DeepEqual(a.TaskStats, b.TaskStats) {
 		return false
 	}
@@ -1138,6 +1506,10 @@ func (a *ContainerStats) StatsEq(b *ContainerStats) bool {
 // occurred, their specific type, and the actual event. Event types are
 // differentiated by the EventType field of Event.
 type Event struct {
+	// a unique ID of the event, assigned when it is added to the event
+	// manager
+	ID string `json:"id,omitempty"`
+
 	// the absolute container name for which the event occurred
 	ContainerName string `json:"container_name"`
 
@@ -1161,6 +1533,7 @@ const (
 	EventOomKill           EventType = "oomKill"
 	EventContainerCreation EventType = "containerCreation"
 	EventContainerDeletion EventType = "containerDeletion"
+	EventRestartLoop       EventType = "restartLoop"
 )
 
 // Extra information about an event. Only one type will be set.
@@ -1170,6 +1543,9 @@ type EventData struct {
 
 	// Information about a container deletion event.
 	ContainerDeletion *ContainerDeletionEventData `json:"container_deletion,omitempty"`
+
+	// Information about a container restart loop event.
+	RestartLoop *RestartLoopEventData `json:"restart_loop,omitempty"`
 }
 
 // Information related to an OOM kill instance
@@ -1182,6 +1558,10 @@ type OomKillEventData struct {
 
 	// the constraint that triggered the OOM
 	Constraint string `json:"constraint"`
+
+	// The number of OOM kills the event stands for when the source cannot
+	// tell them apart, e.g. the memory.events OOM source. Omitted for one.
+	Count int `json:"count,omitempty"`
 }
 
 // Information related to a container deletion event
@@ -1189,4 +1569,24 @@ type ContainerDeletionEventData struct {
 	// ExitCode is the exit code of the container.
 	// A value of -1 indicates the exit code was not available or not applicable.
 	ExitCode int `json:"exit_code"`
+
+	// Signal is the signal that terminated the container's main process.
+	// Zero if it exited on its own or the signal is unknown.
+	Signal int `json:"signal,omitempty"`
+
+	// Runtime is how long the container ran before it exited.
+	// Zero if the start time is unknown.
+	Runtime time.Duration `json:"runtime,omitempty"`
+}
+
+// Information related to a container restart loop event
+type RestartLoopEventData struct {
+	// Restarts is the number of times the container was recreated within Window.
+	Restarts int `json:"restarts"`
+
+	// Window is the period over which restarts were counted.
+	Window time.Duration `json:"window"`
+
+	// ExitCode is the exit code of the container's most recent run, -1 if unknown.
+	ExitCode int `json:"exit_code"`
 }
@@ -0,0 +1,85 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+)
+
+// ImageStore describes the images a container runtime stores.
+type ImageStore struct {
+	// The runtime storing the images, named as the namespace of its
+	// containers, e.g. "docker".
+	Runtime string `json:"runtime"`
+
+	// Bytes the images use, counting the layers they share once. Zero if the
+	// runtime does not report it.
+	Usage uint64 `json:"usage"`
+
+	// The images, sorted by ID.
+	Images []ImageInfo `json:"images"`
+}
+
+// ImageInfo describes a container image.
+type ImageInfo struct {
+	// The ID of the image, the digest of its config, e.g. "sha256:...".
+	ID string `json:"id"`
+
+	// The names the image is tagged with, e.g. "nginx:1.27".
+	RepoTags []string `json:"repo_tags,omitempty"`
+
+	// The digests the image is known by in registries, e.g.
+	// "nginx@sha256:...".
+	RepoDigests []string `json:"repo_digests,omitempty"`
+
+	// Bytes the image uses, including the layers it shares with other images.
+	Size uint64 `json:"size"`
+
+	// Bytes of the layers the image shares with other images, if the runtime
+	// reports it.
+	SharedSize uint64 `json:"shared_size,omitempty"`
+
+	// Time at which the image was built.
+	Created time.Time `json:"created,omitempty"`
+
+	// Number of layers of the image's root filesystem.
+	Layers int `json:"layers"`
+
+	// The names of the tracked containers running the image. Filled in by
+	// the manager.
+	Containers []string `json:"containers,omitempty"`
+}
+
+// HasName returns whether the image is known by the given name, a tag or a
+// digest, as containers refer to their image.
+func (i *ImageInfo) HasName(name string) bool {
+	if name == "" {
+		return false
+	}
+	if name == i.ID {
+		return true
+	}
+	for _, tag := range i.RepoTags {
+		if name == tag {
+			return true
+		}
+	}
+	for _, digest := range i.RepoDigests {
+		if name == digest {
+			return true
+		}
+	}
+	return false
+}
@@ -142,6 +142,46 @@ type DiskInfo struct {
 
 	// I/O Scheduler - one of "none", "noop", "cfq", "deadline"
 	Scheduler string `json:"scheduler"`
+
+	// Whether the device is rotational, e.g. a spinning disk
+	Rotational bool `json:"rotational"`
+
+	// Logical and physical block size in bytes
+	LogicalBlockSize  uint64 `json:"logical_block_size,omitempty"`
+	PhysicalBlockSize uint64 `json:"physical_block_size,omitempty"`
+
+	// Number of requests the block layer queues for the device
+	QueueDepth uint64 `json:"queue_depth,omitempty"`
+
+	// Whether the device supports discard (TRIM) requests
+	Discard bool `json:"discard"`
+
+	// Critical temperature in degrees Celsius, for devices whose driver
+	// registers a hwmon sensor
+	CriticalTemperature *float64 `json:"critical_temperature,omitempty"`
+
+	// Device-mapper device, e.g. an LVM logical volume or dm-crypt mapping
+	DeviceMapper *DeviceMapperInfo `json:"device_mapper,omitempty"`
+
+	// md RAID array
+	Raid *RaidInfo `json:"raid,omitempty"`
+}
+
+type DeviceMapperInfo struct {
+	// Name of the mapping
+	Name string `json:"name"`
+
+	// UUID of the mapping, prefixed by the subsystem that created it, e.g.
+	// "LVM-" or "CRYPT-"
+	UUID string `json:"uuid,omitempty"`
+}
+
+type RaidInfo struct {
+	// RAID level, e.g. "raid1"
+	Level string `json:"level"`
+
+	// Number of member disks of the array
+	Disks uint64 `json:"disks"`
 }
 
 type NetInfo struct {
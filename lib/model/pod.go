@@ -0,0 +1,237 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"math"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Labels the kubelet sets on the containers of pods, whatever the runtime.
+const (
+	PodNameLabel       = "io.kubernetes.pod.name"
+	PodNamespaceLabel  = "io.kubernetes.pod.namespace"
+	PodUIDLabel        = "io.kubernetes.pod.uid"
+	ContainerNameLabel = "io.kubernetes.container.name"
+)
+
+// Matches the cgroups the kubelet creates for pods, e.g. "pod<uid>" with the
+// cgroupfs driver or "kubepods-burstable-pod<uid>.slice" with the systemd
+// driver, which escapes the dashes of the UID as underscores.
+var podCgroupRegexp = regexp.MustCompile(`^(?:kubepods-(?:burstable-|besteffort-)?)?pod([0-9a-f]{8}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{12})(?:\.slice)?$`)
+
+const (
+	// The CPU period pod CPU quotas are normalized to, in microseconds.
+	podCpuPeriod = 100000
+	// Memory limits above this are unlimited: cgroup v1 reports them rounded
+	// down to a page and cgroup v2 as "max".
+	maxMemoryLimit = uint64(1 << 62)
+)
+
+// PodReference identifies a Kubernetes pod.
+type PodReference struct {
+	UID string `json:"uid"`
+	// The name and namespace of the pod, if its containers are labelled with
+	// them.
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// PodInfo is the aggregated view of the containers of a pod.
+type PodInfo struct {
+	PodReference `json:",inline"`
+
+	// The containers of the pod, including its sandbox.
+	Containers []ContainerReference `json:"containers"`
+
+	// The sum of the resource requests and limits of the containers of the pod.
+	Spec ContainerSpec `json:"spec"`
+
+	// The sum of the stats of the containers of the pod, most recent last.
+	Stats []*ContainerStats `json:"stats,omitempty"`
+}
+
+// PodOf returns the pod the container with the given cgroup name and labels
+// belongs to: the one named in its labels, or else the one whose cgroup is the
+// parent of the container's.
+func PodOf(name string, labels map[string]string) (PodReference, bool) {
+	pod := PodReference{
+		UID:       labels[PodUIDLabel],
+		Name:      labels[PodNameLabel],
+		Namespace: labels[PodNamespaceLabel],
+	}
+	if pod.UID == "" {
+		matches := podCgroupRegexp.FindStringSubmatch(path.Base(path.Dir(name)))
+		if matches == nil {
+			return PodReference{}, false
+		}
+		pod.UID = strings.ReplaceAll(matches[1], "_", "-")
+	}
+	return pod, true
+}
+
+// IsPodSandbox returns whether the labels are those of the sandbox, or pause
+// container, of a pod.
+func IsPodSandbox(labels map[string]string) bool {
+	return labels[ContainerNameLabel] == "POD" ||
+		labels["io.kubernetes.docker.type"] == "podsandbox" ||
+		labels["io.cri-containerd.kind"] == "sandbox"
+}
+
+// PodSpec sums the CPU and memory requests and limits of the given container
+// specs into those of their pod, the way the kubelet sizes the pod's cgroup:
+// CPU shares and memory reservations add up, and CPU quotas and memory limits
+// add up unless one container is unlimited, in which case the pod is too.
+// Pod sandboxes are left out.
+func PodSpec(specs []ContainerSpec) ContainerSpec {
+	pod := ContainerSpec{
+		Cpu:    CpuSpec{Period: podCpuPeriod},
+		Memory: MemorySpec{Limit: math.MaxUint64},
+	}
+	cpuUnlimited, memoryUnlimited := false, false
+	var memoryLimit uint64
+	for _, spec := range specs {
+		if IsPodSandbox(spec.Labels) {
+			continue
+		}
+		if pod.CreationTime.IsZero() || spec.CreationTime.Before(pod.CreationTime) {
+			pod.CreationTime = spec.CreationTime
+		}
+		if spec.HasCpu {
+			pod.HasCpu = true
+			pod.Cpu.Limit += spec.Cpu.Limit
+			if spec.Cpu.Quota == 0 || spec.Cpu.Period == 0 {
+				cpuUnlimited = true
+			} else {
+				pod.Cpu.Quota += spec.Cpu.Quota * podCpuPeriod / spec.Cpu.Period
+			}
+		}
+		if spec.HasMemory {
+			pod.HasMemory = true
+			// Unset soft limits and memory.min read as huge or zero values.
+			if spec.Memory.Reservation < maxMemoryLimit {
+				pod.Memory.Reservation += spec.Memory.Reservation
+			}
+			if spec.Memory.Limit >= maxMemoryLimit {
+				memoryUnlimited = true
+			} else {
+				memoryLimit += spec.Memory.Limit
+			}
+		}
+	}
+	if cpuUnlimited {
+		pod.Cpu.Quota = 0
+	}
+	if pod.HasMemory && !memoryUnlimited {
+		pod.Memory.Limit = memoryLimit
+	}
+	return pod
+}
+
+// PodStats sums the stats of the containers of a pod. The stats of each
+// container are given most recent last, as stored. Containers are housekept
+// independently, so their samples are aligned: the samples of the pod are
+// taken at the times of those of the container whose latest sample is the
+// oldest, each summing the latest sample of every container at or before that
+// time. Times before some container's first sample are left out.
+func PodStats(containerStats [][]*ContainerStats) []*ContainerStats {
+	if len(containerStats) == 0 {
+		return nil
+	}
+	var ticks []*ContainerStats
+	for _, stats := range containerStats {
+		if len(stats) == 0 {
+			return nil
+		}
+		if ticks == nil || stats[len(stats)-1].Timestamp.Before(ticks[len(ticks)-1].Timestamp) {
+			ticks = stats
+		}
+	}
+	pod := make([]*ContainerStats, 0, len(ticks))
+	for _, tick := range ticks {
+		sum := &ContainerStats{}
+		complete := true
+		for _, stats := range containerStats {
+			// The first sample after the tick.
+			i := sort.Search(len(stats), func(i int) bool { return stats[i].Timestamp.After(tick.Timestamp) })
+			if i == 0 {
+				complete = false
+				break
+			}
+			addStats(sum, stats[i-1])
+		}
+		if complete {
+			sum.Timestamp = tick.Timestamp
+			pod = append(pod, sum)
+		}
+	}
+	return pod
+}
+
+// addStats adds the CPU, memory, network and process stats of s to sum.
+func addStats(sum, s *ContainerStats) {
+	if s.Timestamp.After(sum.Timestamp) {
+		sum.Timestamp = s.Timestamp
+	}
+	if s.Cpu != nil {
+		if sum.Cpu == nil {
+			sum.Cpu = &CpuStats{}
+		}
+		sum.Cpu.Usage.Total += s.Cpu.Usage.Total
+		sum.Cpu.Usage.User += s.Cpu.Usage.User
+		sum.Cpu.Usage.System += s.Cpu.Usage.System
+		sum.Cpu.CFS.Periods += s.Cpu.CFS.Periods
+		sum.Cpu.CFS.ThrottledPeriods += s.Cpu.CFS.ThrottledPeriods
+		sum.Cpu.CFS.ThrottledTime += s.Cpu.CFS.ThrottledTime
+	}
+	if s.Memory != nil {
+		if sum.Memory == nil {
+			sum.Memory = &MemoryStats{}
+		}
+		sum.Memory.Usage += s.Memory.Usage
+		sum.Memory.Cache += s.Memory.Cache
+		sum.Memory.RSS += s.Memory.RSS
+		sum.Memory.Swap += s.Memory.Swap
+		sum.Memory.MappedFile += s.Memory.MappedFile
+		sum.Memory.WorkingSet += s.Memory.WorkingSet
+		sum.Memory.Failcnt += s.Memory.Failcnt
+	}
+	if s.Network != nil {
+		if sum.Network == nil {
+			sum.Network = &NetworkStats{}
+		}
+		sum.Network.RxBytes += s.Network.RxBytes
+		sum.Network.RxPackets += s.Network.RxPackets
+		sum.Network.RxErrors += s.Network.RxErrors
+		sum.Network.RxDropped += s.Network.RxDropped
+		sum.Network.TxBytes += s.Network.TxBytes
+		sum.Network.TxPackets += s.Network.TxPackets
+		sum.Network.TxErrors += s.Network.TxErrors
+		sum.Network.TxDropped += s.Network.TxDropped
+	}
+	if s.Processes != nil {
+		if sum.Processes == nil {
+			sum.Processes = &ProcessStats{}
+		}
+		sum.Processes.ProcessCount += s.Processes.ProcessCount
+		sum.Processes.FdCount += s.Processes.FdCount
+		sum.Processes.SocketCount += s.Processes.SocketCount
+		sum.Processes.ThreadsCurrent += s.Processes.ThreadsCurrent
+	}
+	sum.OOMEvents += s.OOMEvents
+}
@@ -109,4 +109,8 @@ type FsInfo struct {
 	Labels     []string  `json:"labels"`
 	Inodes     *uint64   `json:"inodes,omitempty"`
 	InodesFree *uint64   `json:"inodes_free,omitempty"`
+	// How the filesystem allocates its space, if it reports it.
+	Allocations []FsAllocation `json:"allocations,omitempty"`
+	// Forecast of the usage, once there is enough history of it.
+	Forecast *FsForecast `json:"forecast,omitempty"`
 }
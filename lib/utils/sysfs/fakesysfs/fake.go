@@ -86,6 +86,9 @@ type FakeSysFs struct {
 	distancesErr error
 
 	onlineCPUs map[string]interface{}
+
+	blockDeviceAttributes      map[string]string
+	blockDeviceHwmonAttributes map[string]string
 }
 
 func (fs *FakeSysFs) GetNodesPaths() ([]string, error) {
@@ -146,6 +149,20 @@ func (fs *FakeSysFs) IsBlockDeviceHidden(name string) (bool, error) {
 	return false, nil
 }
 
+func (fs *FakeSysFs) GetBlockDeviceAttribute(name string, attribute string) (string, error) {
+	if value, ok := fs.blockDeviceAttributes[name+"/"+attribute]; ok {
+		return value, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func (fs *FakeSysFs) GetBlockDeviceHwmonAttribute(name string, attribute string) (string, error) {
+	if value, ok := fs.blockDeviceHwmonAttributes[name+"/"+attribute]; ok {
+		return value, nil
+	}
+	return "", os.ErrNotExist
+}
+
 func (fs *FakeSysFs) GetNetworkDevices() ([]os.FileInfo, error) {
 	return []os.FileInfo{&fs.info}, nil
 }
@@ -224,6 +241,18 @@ func (fs *FakeSysFs) SetHugePagesNr(hugePagesNr map[string]string, err error) {
 	fs.hugePagesNrErr = err
 }
 
+// SetBlockDeviceAttributes sets the attributes of block devices, keyed by
+// device name and attribute, e.g. "sda/queue/rotational".
+func (fs *FakeSysFs) SetBlockDeviceAttributes(attributes map[string]string) {
+	fs.blockDeviceAttributes = attributes
+}
+
+// SetBlockDeviceHwmonAttributes sets the hwmon attributes of block devices,
+// keyed by device name and attribute, e.g. "sda/temp1_input".
+func (fs *FakeSysFs) SetBlockDeviceHwmonAttributes(attributes map[string]string) {
+	fs.blockDeviceHwmonAttributes = attributes
+}
+
 func (fs *FakeSysFs) SetEntryName(name string) {
 	fs.info.EntryName = name
 }
@@ -139,6 +139,19 @@ type SysFs interface {
 	IsCPUOnline(dir string) bool
 }
 
+// BlockDeviceAttributeSysFs is implemented by a SysFs that can read the
+// optional attributes of block devices.
+type BlockDeviceAttributeSysFs interface {
+	// Get an attribute of the block device, from a file relative to its
+	// sysfs directory such as "queue/rotational".
+	GetBlockDeviceAttribute(name string, attribute string) (string, error)
+	// Get an attribute of the hwmon sensor the driver of the block device
+	// registers, such as "temp1_input", if it registers one.
+	GetBlockDeviceHwmonAttribute(name string, attribute string) (string, error)
+}
+
+var _ BlockDeviceAttributeSysFs = &realSysFs{}
+
 type realSysFs struct {
 	cpuPath string
 }
@@ -272,6 +285,33 @@ func (fs *realSysFs) GetBlockDeviceScheduler(name string) (string, error) {
 	return string(sched), nil
 }
 
+func (fs *realSysFs) GetBlockDeviceAttribute(name string, attribute string) (string, error) {
+	value, err := os.ReadFile(path.Join(blockDir, name, attribute))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(value)), nil
+}
+
+func (fs *realSysFs) GetBlockDeviceHwmonAttribute(name string, attribute string) (string, error) {
+	// NVMe controllers register their sensor as device/hwmonN, drivers of
+	// other devices such as drivetemp as device/hwmon/hwmonN.
+	for _, pattern := range []string{"device/hwmon*", "device/hwmon/hwmon*"} {
+		matches, err := filepath.Glob(path.Join(blockDir, name, pattern, attribute))
+		if err != nil {
+			return "", err
+		}
+		if len(matches) > 0 {
+			value, err := os.ReadFile(matches[0])
+			if err != nil {
+				return "", err
+			}
+			return strings.TrimSpace(string(value)), nil
+		}
+	}
+	return "", fmt.Errorf("no hwmon attribute %q for block device %q: %w", attribute, name, os.ErrNotExist)
+}
+
 func (fs *realSysFs) GetBlockDeviceSize(name string) (string, error) {
 	size, err := os.ReadFile(path.Join(blockDir, name, "/size"))
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	"os"
 	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -99,12 +100,109 @@ func GetBlockDeviceInfo(sysfs sysfs.SysFs) (map[string]info.DiskInfo, error) {
 				diskInfo.Scheduler = string(matches[1])
 			}
 		}
+		getBlockDeviceAttributes(sysfs, name, &diskInfo)
 		device := fmt.Sprintf("%d:%d", diskInfo.Major, diskInfo.Minor)
 		diskMap[device] = diskInfo
 	}
 	return diskMap, nil
 }
 
+// getBlockDeviceAttributes fills in the optional attributes of the block
+// device, leaving those the kernel or driver does not expose unset.
+func getBlockDeviceAttributes(sysFs sysfs.SysFs, name string, diskInfo *info.DiskInfo) {
+	attributes, ok := sysFs.(sysfs.BlockDeviceAttributeSysFs)
+	if !ok {
+		return
+	}
+	diskInfo.Rotational = uintAttribute(attributes, name, "queue/rotational") == 1
+	diskInfo.LogicalBlockSize = uintAttribute(attributes, name, "queue/logical_block_size")
+	diskInfo.PhysicalBlockSize = uintAttribute(attributes, name, "queue/physical_block_size")
+	diskInfo.QueueDepth = uintAttribute(attributes, name, "queue/nr_requests")
+	diskInfo.Discard = uintAttribute(attributes, name, "queue/discard_max_bytes") > 0
+	diskInfo.CriticalTemperature = temperatureAttribute(attributes, name, "temp1_crit")
+
+	if dmName := stringAttribute(attributes, name, "dm/name"); dmName != "" {
+		diskInfo.DeviceMapper = &info.DeviceMapperInfo{
+			Name: dmName,
+			UUID: stringAttribute(attributes, name, "dm/uuid"),
+		}
+	}
+	if level := stringAttribute(attributes, name, "md/level"); level != "" {
+		diskInfo.Raid = &info.RaidInfo{
+			Level: level,
+			Disks: uintAttribute(attributes, name, "md/raid_disks"),
+		}
+	}
+}
+
+// GetBlockDeviceHealth reads the health signals of the given block devices,
+// which unlike their DiskInfo change while the machine runs.
+func GetBlockDeviceHealth(sysFs sysfs.SysFs, disks map[string]info.DiskInfo) []info.DiskHealthStats {
+	attributes, ok := sysFs.(sysfs.BlockDeviceAttributeSysFs)
+	if !ok || len(disks) == 0 {
+		return nil
+	}
+	health := make([]info.DiskHealthStats, 0, len(disks))
+	for _, disk := range disks {
+		diskHealth := info.DiskHealthStats{
+			Name:        disk.Name,
+			State:       stringAttribute(attributes, disk.Name, "device/state"),
+			Temperature: temperatureAttribute(attributes, disk.Name, "temp1_input"),
+		}
+		if disk.DeviceMapper != nil {
+			suspended := uintAttribute(attributes, disk.Name, "dm/suspended") == 1
+			diskHealth.DmSuspended = &suspended
+		}
+		if disk.Raid != nil {
+			diskHealth.Raid = &info.RaidStats{
+				State:      stringAttribute(attributes, disk.Name, "md/array_state"),
+				Degraded:   uintAttribute(attributes, disk.Name, "md/degraded"),
+				SyncAction: stringAttribute(attributes, disk.Name, "md/sync_action"),
+			}
+		}
+		health = append(health, diskHealth)
+	}
+	sort.Slice(health, func(i, j int) bool { return health[i].Name < health[j].Name })
+	return health
+}
+
+func uintAttribute(sysFs sysfs.BlockDeviceAttributeSysFs, name string, attribute string) uint64 {
+	value, err := sysFs.GetBlockDeviceAttribute(name, attribute)
+	if err != nil {
+		return 0
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		klog.V(4).Infof("Failed to parse %s of block device %s: %v", attribute, name, err)
+		return 0
+	}
+	return n
+}
+
+func stringAttribute(sysFs sysfs.BlockDeviceAttributeSysFs, name string, attribute string) string {
+	value, err := sysFs.GetBlockDeviceAttribute(name, attribute)
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
+// temperatureAttribute converts the hwmon attribute, in millidegrees Celsius,
+// to degrees.
+func temperatureAttribute(sysFs sysfs.BlockDeviceAttributeSysFs, name string, attribute string) *float64 {
+	value, err := sysFs.GetBlockDeviceHwmonAttribute(name, attribute)
+	if err != nil {
+		return nil
+	}
+	millidegrees, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		klog.V(4).Infof("Failed to parse hwmon %s of block device %s: %v", attribute, name, err)
+		return nil
+	}
+	degrees := float64(millidegrees) / 1000
+	return &degrees
+}
+
 // Get information about network devices present on the system.
 func GetNetworkDevices(sysfs sysfs.SysFs) ([]info.NetInfo, error) {
 	devs, err := sysfs.GetNetworkDevices()
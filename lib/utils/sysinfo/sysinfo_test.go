@@ -1360,6 +1360,94 @@ func TestGetBlockDeviceInfo(t *testing.T) {
 	}
 }
 
+func TestGetBlockDeviceInfoAttributes(t *testing.T) {
+	fakeSys := fakesysfs.FakeSysFs{}
+	fakeSys.SetBlockDeviceAttributes(map[string]string{
+		"sda/queue/rotational":          "1",
+		"sda/queue/logical_block_size":  "512",
+		"sda/queue/physical_block_size": "4096",
+		"sda/queue/nr_requests":         "64",
+		"sda/queue/discard_max_bytes":   "2147450880",
+		"sda/device/state":              "running",
+		"sda/md/level":                  "raid1",
+		"sda/md/array_state":            "clean",
+		"sda/md/raid_disks":             "2",
+		"sda/md/degraded":               "1",
+		"sda/md/sync_action":            "recover",
+		"sda/dm/name":                   "vg0-root",
+		"sda/dm/uuid":                   "LVM-abc",
+		"sda/dm/suspended":              "0",
+	})
+	fakeSys.SetBlockDeviceHwmonAttributes(map[string]string{
+		"sda/temp1_input": "38850",
+		"sda/temp1_crit":  "84850",
+	})
+	disks, err := GetBlockDeviceInfo(&fakeSys)
+	assert.NoError(t, err)
+
+	criticalTemperature := 84.85
+	assert.Equal(t, info.DiskInfo{
+		Name:                "sda",
+		Major:               8,
+		Minor:               0,
+		Size:                1234567 * 512,
+		Scheduler:           "cfq",
+		Rotational:          true,
+		LogicalBlockSize:    512,
+		PhysicalBlockSize:   4096,
+		QueueDepth:          64,
+		Discard:             true,
+		CriticalTemperature: &criticalTemperature,
+		DeviceMapper:        &info.DeviceMapperInfo{Name: "vg0-root", UUID: "LVM-abc"},
+		Raid:                &info.RaidInfo{Level: "raid1", Disks: 2},
+	}, disks["8:0"])
+
+	temperature, suspended := 38.85, false
+	assert.Equal(t, []info.DiskHealthStats{
+		{
+			Name:        "sda",
+			State:       "running",
+			Temperature: &temperature,
+			DmSuspended: &suspended,
+			Raid:        &info.RaidStats{State: "clean", Degraded: 1, SyncAction: "recover"},
+		},
+	}, GetBlockDeviceHealth(&fakeSys, disks))
+}
+
+func TestGetBlockDeviceInfoWithoutAttributes(t *testing.T) {
+	fakeSys := fakesysfs.FakeSysFs{}
+	disks, err := GetBlockDeviceInfo(&fakeSys)
+	assert.NoError(t, err)
+
+	disk := disks["8:0"]
+	assert.False(t, disk.Rotational)
+	assert.Zero(t, disk.LogicalBlockSize)
+	assert.Nil(t, disk.CriticalTemperature)
+	assert.Nil(t, disk.DeviceMapper)
+	assert.Nil(t, disk.Raid)
+
+	assert.Equal(t, []info.DiskHealthStats{{Name: "sda"}}, GetBlockDeviceHealth(&fakeSys, disks))
+}
+
+// The attributes are optional, a SysFs implemented outside of cAdvisor need
+// not read them.
+type basicSysFs struct {
+	sysfs.SysFs
+}
+
+func TestGetBlockDeviceInfoWithBasicSysFs(t *testing.T) {
+	fakeSys := &fakesysfs.FakeSysFs{}
+	fakeSys.SetBlockDeviceAttributes(map[string]string{"sda/queue/rotational": "1", "sda/md/level": "raid1"})
+	disks, err := GetBlockDeviceInfo(basicSysFs{fakeSys})
+	assert.NoError(t, err)
+
+	disk := disks["8:0"]
+	assert.Equal(t, "sda", disk.Name)
+	assert.False(t, disk.Rotational)
+	assert.Nil(t, disk.Raid)
+	assert.Nil(t, GetBlockDeviceHealth(basicSysFs{fakeSys}, disks))
+}
+
 func TestGetNetworkDevices(t *testing.T) {
 	fakeSys := fakesysfs.FakeSysFs{}
 	fakeSys.SetEntryName("eth0")
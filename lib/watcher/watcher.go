@@ -22,12 +22,16 @@ type ContainerEventType int
 const (
 	ContainerAdd ContainerEventType = iota
 	ContainerDelete
+	// The metadata of an existing container, e.g. its labels, changed.
+	ContainerUpdate
 )
 
 type ContainerWatchSource int
 
 const (
 	Raw ContainerWatchSource = iota
+	// The event stream of a container runtime.
+	Runtime
 )
 
 // ContainerEvent represents a
@@ -0,0 +1,198 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package oomparser
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	inotify "k8s.io/utils/inotify"
+
+	"k8s.io/klog/v2"
+)
+
+// MemoryEventsWatcher detects OOM kills from the oom_kill counter cgroup v2
+// keeps for every cgroup, watching each container's memory.events file with
+// inotify. Unlike OomParser it needs no access to /dev/kmsg, but it only sees
+// the containers it has been told to watch and cannot tell which process was
+// killed.
+//
+// memory.events.local is preferred where the kernel provides it, so a kill is
+// attributed only to the container it happened in rather than to all of its
+// ancestors as well.
+//
+// Implementation is thread-safe.
+type MemoryEventsWatcher struct {
+	cgroupRoot string
+	watcher    *inotify.Watcher
+
+	// Lock for files and containers.
+	lock sync.Mutex
+	// Watched memory.events files, keyed by path.
+	files map[string]*memoryEventsFile
+	// Watched file paths, keyed by container name.
+	containers map[string]string
+}
+
+type memoryEventsFile struct {
+	containerName string
+	oomKills      uint64
+}
+
+// NewMemoryEventsWatcher returns a watcher for containers in the cgroup v2
+// hierarchy mounted at cgroupRoot.
+func NewMemoryEventsWatcher(cgroupRoot string) (*MemoryEventsWatcher, error) {
+	if _, err := os.Stat(filepath.Join(cgroupRoot, "cgroup.controllers")); err != nil {
+		return nil, fmt.Errorf("%q is not a cgroup v2 hierarchy: %v", cgroupRoot, err)
+	}
+	w, err := inotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	return &MemoryEventsWatcher{
+		cgroupRoot: cgroupRoot,
+		watcher:    w,
+		files:      make(map[string]*memoryEventsFile),
+		containers: make(map[string]string),
+	}, nil
+}
+
+// AddContainer starts watching the named container. OOM kills that happened
+// before it was added are not reported.
+func (w *MemoryEventsWatcher) AddContainer(containerName string) error {
+	dir := filepath.Join(w.cgroupRoot, containerName)
+	file := filepath.Join(dir, "memory.events.local")
+	if _, err := os.Stat(file); err != nil {
+		file = filepath.Join(dir, "memory.events")
+	}
+	oomKills, err := readOomKills(file)
+	if err != nil {
+		return err
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if _, ok := w.containers[containerName]; ok {
+		return nil
+	}
+	if err := w.watcher.AddWatch(file, inotify.InModify); err != nil {
+		return err
+	}
+	w.containers[containerName] = file
+	w.files[file] = &memoryEventsFile{
+		containerName: containerName,
+		oomKills:      oomKills,
+	}
+	return nil
+}
+
+// RemoveContainer stops watching the named container.
+func (w *MemoryEventsWatcher) RemoveContainer(containerName string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	file, ok := w.containers[containerName]
+	if !ok {
+		return
+	}
+	delete(w.containers, containerName)
+	delete(w.files, file)
+	// The kernel drops the watch itself when the cgroup is removed, so a
+	// failure here is expected and harmless.
+	_ = w.watcher.RemoveWatch(file)
+}
+
+// StreamOoms writes an OomInstance to outStream whenever OOM kills happen in a
+// watched container, with the number of kills since the last one as Count.
+// It will block and should be called from a goroutine.
+func (w *MemoryEventsWatcher) StreamOoms(outStream chan<- *OomInstance) {
+	for {
+		select {
+		case event, ok := <-w.watcher.Event:
+			if !ok {
+				return
+			}
+			if event.Mask&inotify.InModify == 0 {
+				continue
+			}
+			if instance := w.check(event.Name, time.Now()); instance != nil {
+				outStream <- instance
+			}
+		case err, ok := <-w.watcher.Error:
+			if !ok {
+				return
+			}
+			klog.Warningf("Error while watching memory.events for OOM kills: %v", err)
+		}
+	}
+}
+
+// Close stops the watcher; StreamOoms returns once it has.
+func (w *MemoryEventsWatcher) Close() error {
+	return w.watcher.Close()
+}
+
+// check re-reads a watched memory.events file and returns an OomInstance
+// counting the OOM kills since it was last read, or nil if there were none.
+func (w *MemoryEventsWatcher) check(file string, now time.Time) *OomInstance {
+	oomKills, err := readOomKills(file)
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	watched, ok := w.files[file]
+	if !ok {
+		return nil
+	}
+	if err != nil {
+		klog.V(4).Infof("Failed to read %q: %v", file, err)
+		return nil
+	}
+	if oomKills <= watched.oomKills {
+		return nil
+	}
+	instance := &OomInstance{
+		TimeOfDeath:         now,
+		ContainerName:       watched.containerName,
+		VictimContainerName: watched.containerName,
+		Constraint:          "CONSTRAINT_MEMCG",
+		Count:               int(oomKills - watched.oomKills),
+	}
+	watched.oomKills = oomKills
+	return instance
+}
+
+// readOomKills returns the oom_kill counter from a memory.events file.
+func readOomKills(file string) (uint64, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return 0, err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := bytes.Fields(scanner.Bytes())
+		if len(fields) != 2 || string(fields[0]) != "oom_kill" {
+			continue
+		}
+		return strconv.ParseUint(string(fields[1]), 10, 64)
+	}
+	return 0, fmt.Errorf("no oom_kill counter in %q", file)
+}
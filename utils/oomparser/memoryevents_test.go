@@ -0,0 +1,103 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package oomparser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeMemoryEvents(t *testing.T, file string, oomKills int) {
+	content := fmt.Sprintf("low 0\nhigh 0\nmax 3\noom 2\noom_kill %d\noom_group_kill 0\n", oomKills)
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+}
+
+func newFakeCgroupRoot(t *testing.T, containers ...string) string {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "cgroup.controllers"), []byte("cpu memory\n"), 0o644))
+	for _, c := range containers {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, c), 0o755))
+	}
+	return root
+}
+
+func TestReadOomKills(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "memory.events")
+	writeMemoryEvents(t, file, 7)
+	count, err := readOomKills(file)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), count)
+
+	require.NoError(t, os.WriteFile(file, []byte("low 0\n"), 0o644))
+	_, err = readOomKills(file)
+	assert.Error(t, err)
+}
+
+func TestNewMemoryEventsWatcherRequiresCgroupV2(t *testing.T) {
+	_, err := NewMemoryEventsWatcher(t.TempDir())
+	assert.Error(t, err)
+}
+
+func TestMemoryEventsWatcherStreamOoms(t *testing.T) {
+	root := newFakeCgroupRoot(t, "/kubepods/pod1/ctr", "/other")
+	ctrFile := filepath.Join(root, "/kubepods/pod1/ctr", "memory.events.local")
+	writeMemoryEvents(t, ctrFile, 1)
+	// memory.events is used when memory.events.local is not available.
+	otherFile := filepath.Join(root, "/other", "memory.events")
+	writeMemoryEvents(t, otherFile, 0)
+
+	w, err := NewMemoryEventsWatcher(root)
+	require.NoError(t, err)
+	defer w.Close()
+	require.NoError(t, w.AddContainer("/kubepods/pod1/ctr"))
+	require.NoError(t, w.AddContainer("/other"))
+	assert.Error(t, w.AddContainer("/missing"))
+
+	outStream := make(chan *OomInstance, 10)
+	go w.StreamOoms(outStream)
+
+	// Kills that happened before the container was added are not reported,
+	// and those since are reported at once.
+	writeMemoryEvents(t, ctrFile, 3)
+	select {
+	case instance := <-outStream:
+		assert.Equal(t, "/kubepods/pod1/ctr", instance.ContainerName)
+		assert.Equal(t, "/kubepods/pod1/ctr", instance.VictimContainerName)
+		assert.Equal(t, "CONSTRAINT_MEMCG", instance.Constraint)
+		assert.Equal(t, 2, instance.Count)
+		assert.Equal(t, 2, instance.Kills())
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for OOM instance")
+	}
+
+	w.RemoveContainer("/kubepods/pod1/ctr")
+	writeMemoryEvents(t, ctrFile, 4)
+	writeMemoryEvents(t, otherFile, 1)
+	select {
+	case instance := <-outStream:
+		assert.Equal(t, "/other", instance.ContainerName)
+		assert.Equal(t, 1, instance.Kills())
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for OOM instance")
+	}
+}
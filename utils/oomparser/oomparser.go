@@ -58,6 +58,18 @@ type OomInstance struct {
 	// the constraint that triggered the OOM.  One of CONSTRAINT_NONE,
 	// CONSTRAINT_CPUSET, CONSTRAINT_MEMORY_POLICY, CONSTRAINT_MEMCG
 	Constraint string
+	// the number of OOM kills the instance stands for, set by sources that
+	// cannot tell kills apart, such as MemoryEventsWatcher. Zero stands for
+	// one kill.
+	Count int
+}
+
+// Kills returns the number of OOM kills the instance stands for.
+func (i *OomInstance) Kills() int {
+	if i.Count == 0 {
+		return 1
+	}
+	return i.Count
 }
 
 // gets the container name from a line and adds it to the oomInstance.
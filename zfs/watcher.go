@@ -19,24 +19,25 @@ import (
 	"sync/atomic"
 	"time"
 
-	zfs "github.com/mistifyio/go-zfs"
 	"k8s.io/klog/v2"
+
+	zfsfs "github.com/google/cadvisor/lib/fs/zfs"
 )
 
 // usageCache is a typed wrapper around atomic.Value that eliminates the need
 // for type assertions at every call site. It stores filesystem name strings
-// mapped to usage values (uint64).
+// mapped to their dataset stats.
 type usageCache struct {
 	v atomic.Value
 }
 
 // Load retrieves the current cache map.
-func (c *usageCache) Load() map[string]uint64 {
-	return c.v.Load().(map[string]uint64)
+func (c *usageCache) Load() map[string]zfsfs.DatasetStats {
+	return c.v.Load().(map[string]zfsfs.DatasetStats)
 }
 
 // Store saves a new cache map.
-func (c *usageCache) Store(m map[string]uint64) {
+func (c *usageCache) Store(m map[string]zfsfs.DatasetStats) {
 	c.v.Store(m)
 }
 
@@ -47,6 +48,8 @@ type ZfsWatcher struct {
 	cache      usageCache
 	period     time.Duration
 	stopChan   chan struct{}
+	// runner runs the zfs command, overridable for tests.
+	runner zfsfs.Runner
 }
 
 // NewThinPoolWatcher returns a new ThinPoolWatcher for the given devicemapper
@@ -56,8 +59,9 @@ func NewZfsWatcher(filesystem string) (*ZfsWatcher, error) {
 		filesystem: filesystem,
 		period:     15 * time.Second,
 		stopChan:   make(chan struct{}),
+		runner:     zfsfs.ExecRunner,
 	}
-	w.cache.Store(map[string]uint64{})
+	w.cache.Store(map[string]zfsfs.DatasetStats{})
 	return w, nil
 }
 
@@ -93,30 +97,34 @@ func (w *ZfsWatcher) Stop() {
 
 // GetUsage gets the cached usage value of the given filesystem.
 func (w *ZfsWatcher) GetUsage(filesystem string) (uint64, error) {
+	ds, err := w.GetDataset(filesystem)
+	if err != nil {
+		return 0, err
+	}
+	return ds.Used, nil
+}
+
+// GetDataset gets the cached dataset stats of the given filesystem.
+func (w *ZfsWatcher) GetDataset(filesystem string) (zfsfs.DatasetStats, error) {
 	cache := w.cache.Load()
 	v, ok := cache[filesystem]
 	if !ok {
-		return 0, fmt.Errorf("no cached value for usage of filesystem %v", filesystem)
+		return zfsfs.DatasetStats{}, fmt.Errorf("no cached value for usage of filesystem %v", filesystem)
 	}
 	return v, nil
 }
 
-// Refresh performs a zfs get
+// Refresh performs a zfs list of the children of the filesystem
 func (w *ZfsWatcher) Refresh() error {
-	parent, err := zfs.GetDataset(w.filesystem)
-	if err != nil {
-		klog.Errorf("encountered error getting zfs filesystem: %s: %v", w.filesystem, err)
-		return err
-	}
-	children, err := parent.Children(0)
+	children, err := zfsfs.ListDatasets(w.runner, w.filesystem)
 	if err != nil {
 		klog.Errorf("encountered error getting children of zfs filesystem: %s: %v", w.filesystem, err)
 		return err
 	}
 
-	newCache := make(map[string]uint64)
+	newCache := make(map[string]zfsfs.DatasetStats)
 	for _, ds := range children {
-		newCache[ds.Name] = ds.Used
+		newCache[ds.Name] = ds
 	}
 
 	w.cache.Store(newCache)
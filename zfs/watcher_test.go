@@ -0,0 +1,52 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zfs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	zfsfs "github.com/google/cadvisor/lib/fs/zfs"
+)
+
+func TestRefresh(t *testing.T) {
+	w, err := NewZfsWatcher("tank/docker")
+	require.NoError(t, err)
+	w.runner = func(name string, arg ...string) ([]byte, error) {
+		return []byte("tank/docker\t3072\t0\t0\t1.00\n" +
+			"tank/docker/abc\t2048\t1024\t512\t1.50\n"), nil
+	}
+
+	require.NoError(t, w.Refresh())
+	usage, err := w.GetUsage("tank/docker/abc")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2048), usage)
+	ds, err := w.GetDataset("tank/docker/abc")
+	require.NoError(t, err)
+	assert.Equal(t, zfsfs.DatasetStats{Name: "tank/docker/abc", Used: 2048, Referenced: 1024, UsedBySnapshots: 512, CompressRatio: 1.5}, ds)
+	_, err = w.GetUsage("tank/docker")
+	assert.Error(t, err)
+
+	// A failed refresh keeps the previous values.
+	w.runner = func(name string, arg ...string) ([]byte, error) {
+		return nil, errors.New("zfs not found")
+	}
+	assert.Error(t, w.Refresh())
+	_, err = w.GetDataset("tank/docker/abc")
+	assert.NoError(t, err)
+}